@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -274,6 +275,11 @@ var (
 	Pi_capture_cloud_storage_region     string
 )
 
+var (
+	Pi_target_cloud_instance_id string
+	Pi_target_network_id        string
+)
+
 var ISDelegegatedVPC string
 
 // For Image
@@ -1361,6 +1367,18 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_CAPTURE_CLOUD_STORAGE_REGION for testing Pi_capture_cloud_storage_region resource else it is set to default value 'us-south'")
 	}
 
+	Pi_target_cloud_instance_id = os.Getenv("PI_TARGET_CLOUD_INSTANCE_ID")
+	if Pi_target_cloud_instance_id == "" {
+		Pi_target_cloud_instance_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_TARGET_CLOUD_INSTANCE_ID for testing ibm_pi_instance_migration resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_target_network_id = os.Getenv("PI_TARGET_NETWORK_ID")
+	if Pi_target_network_id == "" {
+		Pi_target_network_id = "terraform-test-network"
+		fmt.Println("[INFO] Set the environment variable PI_TARGET_NETWORK_ID for testing ibm_pi_instance_migration resource else it is set to default value 'terraform-test-network'")
+	}
+
 	Pi_shared_processor_pool_id = os.Getenv("PI_SHARED_PROCESSOR_POOL_ID")
 	if Pi_shared_processor_pool_id == "" {
 		Pi_shared_processor_pool_id = "tf-pi-shared-processor-pool"
@@ -2488,6 +2506,23 @@ func TestAccProviderFactories() map[string]func() (*schema.Provider, error) {
 	}
 }
 
+// TestAccProtoV5ProviderFactories serves the SDKv2 and plugin framework
+// providers muxed together. Tests of ephemeral resources and provider
+// functions must use it instead of TestAccProviderFactories.
+func TestAccProtoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	factory := func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := provider.ProtoV5ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	}
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		ProviderName:          factory,
+		ProviderNameAlternate: factory,
+	}
+}
+
 func Region() string {
 	region, _ := schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south")()
 
@@ -85,7 +85,7 @@ func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, funct
 				err := RefreshToken(sess)
 				if err != nil {
 					for count := sess.Config.MaxRetries; *count >= 0; *count-- {
-						if err == nil || !isRetryable(err) {
+						if err == nil || !isRetryable(err, DefaultRetryableStatusCodes) {
 							break
 						}
 						err = RefreshToken(sess)
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientSessionLazyInitRunsOnce(t *testing.T) {
+	session := &clientSession{lazy: map[string]*lazyInit{}}
+	var calls int32
+	vpcErr := errors.New("bad vpc endpoint")
+	session.lazily("VpcV1API", func() {
+		atomic.AddInt32(&calls, 1)
+		session.vpcErr = vpcErr
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := session.VpcV1API(); err != vpcErr {
+				t.Errorf("expected %v, got %v", vpcErr, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected the VPC client to be built once, built %d times", calls)
+	}
+}
+
+func TestClientSessionLazyInitIsolatesErrors(t *testing.T) {
+	session := &clientSession{lazy: map[string]*lazyInit{}}
+	var transitGatewayBuilt bool
+	session.lazily("VpcV1API", func() {
+		session.vpcErr = errors.New("bad vpc endpoint")
+	})
+	session.lazily("TransitGatewayV1API", func() {
+		transitGatewayBuilt = true
+	})
+
+	if _, err := session.TransitGatewayV1API(); err != nil {
+		t.Fatalf("unexpected error from an unrelated client: %v", err)
+	}
+	if !transitGatewayBuilt {
+		t.Fatal("expected the transit gateway client to be built on first use")
+	}
+	if session.vpcErr != nil {
+		t.Fatal("the VPC client should not be built until VpcV1API is called")
+	}
+}
+
+func TestClientSessionWithoutConstructor(t *testing.T) {
+	session := &clientSession{lazy: map[string]*lazyInit{}}
+	session.vpcErr = errEmptyBluemixCredentials
+
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %v, got %v", errEmptyBluemixCredentials, err)
+	}
+}
+
+func TestClientSessionDeletionProtectionDefault(t *testing.T) {
+	session := &clientSession{}
+	if session.DeletionProtectionDefault("ibm_cos_bucket") {
+		t.Fatal("expected deletion protection to be off by default")
+	}
+
+	session.defaultDeletionProtection = true
+	if !session.DeletionProtectionDefault("ibm_is_subnet") {
+		t.Fatal("expected the default to apply to all resource types")
+	}
+
+	session.deletionProtectionResourceTypes = []string{"ibm_cos_bucket", "ibm_is_vpc"}
+	if !session.DeletionProtectionDefault("ibm_is_vpc") {
+		t.Fatal("expected the default to apply to ibm_is_vpc")
+	}
+	if session.DeletionProtectionDefault("ibm_is_subnet") {
+		t.Fatal("expected the default not to apply to ibm_is_subnet")
+	}
+}
+
+func TestClientSessionVPCQuotaPreflight(t *testing.T) {
+	session := &clientSession{}
+	if mode := session.VPCQuotaPreflight(); mode != "error" {
+		t.Fatalf("expected the preflight to fail the plan by default, got %q", mode)
+	}
+
+	session.vpcQuotaPreflight = "warn"
+	if mode := session.VPCQuotaPreflight(); mode != "warn" {
+		t.Fatalf("expected warn, got %q", mode)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EndpointKeys lists the endpoint variables that can be customized through an
+// endpoints file or the provider endpoints block.
+var EndpointKeys = []string{
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_CIS_API_ENDPOINT",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+	"IBMCLOUD_CR_API_ENDPOINT",
+	"IBMCLOUD_DL_API_ENDPOINT",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_GT_API_ENDPOINT",
+	"IBMCLOUD_IAM_API_ENDPOINT",
+	"IBMCLOUD_IS_NG_API_ENDPOINT",
+	"IBMCLOUD_KP_API_ENDPOINT",
+	"IBMCLOUD_LOGS_API_ENDPOINT",
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"IBMCLOUD_PROJECT_API_ENDPOINT",
+	"IBMCLOUD_PUSH_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"IBMCLOUD_TG_API_ENDPOINT",
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+}
+
+// EndpointAttribute returns the name of the attribute of the provider
+// endpoints block that sets the given endpoint variable, for example
+// "is_ng_api" for IBMCLOUD_IS_NG_API_ENDPOINT.
+func EndpointAttribute(key string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(key, "IBMCLOUD_"), "_ENDPOINT"))
+}
+
+var (
+	endpointsMu sync.RWMutex
+	// endpointsBlock holds the endpoints set in the provider block, so that
+	// FileFallBack sees them alongside the endpoints file.
+	endpointsBlock map[string]interface{}
+)
+
+// LoadEndpoints reads and validates the endpoints file, if any, and merges the
+// given endpoints over it. Both use the endpoints file format, mapping an
+// endpoint variable to a visibility to a region to a URL. A nil map is
+// returned when neither the file nor the endpoints are set.
+func LoadEndpoints(endpointsFile string, endpoints map[string]interface{}) (map[string]interface{}, error) {
+	fileMap, err := readEndpointsFile(endpointsFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEndpoints(endpoints); err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid endpoints block: %s", err)
+	}
+	return mergeEndpoints(fileMap, endpoints), nil
+}
+
+func readEndpointsFile(endpointsFile string) (map[string]interface{}, error) {
+	f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile)
+	if f == "" {
+		return nil, nil
+	}
+	bytes, err := os.ReadFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read Endpoints File %s: %s", f, err)
+	}
+	var fileMap map[string]interface{}
+	if err := json.Unmarshal(bytes, &fileMap); err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to unmarshal Endpoints File %s: %s", f, err)
+	}
+	if err := validateEndpoints(fileMap); err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid Endpoints File %s: %s", f, err)
+	}
+	return fileMap, nil
+}
+
+// validateEndpoints checks that every entry maps a visibility to a region to a
+// URL, which is the shape fileFallBack relies on.
+func validateEndpoints(endpoints map[string]interface{}) error {
+	for key, val := range endpoints {
+		visibilities, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s must map a visibility to its regional endpoints", key)
+		}
+		for visibility, v := range visibilities {
+			regions, ok := v.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s.%s must map a region to an endpoint", key, visibility)
+			}
+			for region, r := range regions {
+				if _, ok := r.(string); !ok {
+					return fmt.Errorf("%s.%s.%s must be a string", key, visibility, region)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// mergeEndpoints returns the regional endpoints of base overridden by those of
+// override. Neither map is modified.
+func mergeEndpoints(base, override map[string]interface{}) map[string]interface{} {
+	if len(override) == 0 {
+		return base
+	}
+	merged := map[string]interface{}{}
+	for _, m := range []map[string]interface{}{base, override} {
+		for key, val := range m {
+			visibilities, ok := merged[key].(map[string]interface{})
+			if !ok {
+				visibilities = map[string]interface{}{}
+				merged[key] = visibilities
+			}
+			for visibility, v := range val.(map[string]interface{}) {
+				regions, ok := visibilities[visibility].(map[string]interface{})
+				if !ok {
+					regions = map[string]interface{}{}
+					visibilities[visibility] = regions
+				}
+				for region, r := range v.(map[string]interface{}) {
+					regions[region] = r
+				}
+			}
+		}
+	}
+	return merged
+}
+
+func setEndpointsBlock(endpoints map[string]interface{}) {
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+	endpointsBlock = endpoints
+}
+
+// FileFallBack returns the endpoint for the given key, visibility and region
+// from the endpoints file and the provider endpoints block, or defaultValue
+// when neither sets one.
+func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) string {
+	fileMap, err := readEndpointsFile(endpointsFile)
+	if err != nil {
+		// The file is validated when the provider is configured, so this only
+		// happens if it changed since.
+		log.Printf("[WARN] %s, using the default endpoint for %s", err, key)
+	}
+	endpointsMu.RLock()
+	defer endpointsMu.RUnlock()
+	return fileFallBack(mergeEndpoints(fileMap, endpointsBlock), visibility, key, region, defaultValue)
+}
+
+func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
+	if val, ok := fileMap[key]; ok {
+		if v, ok := val.(map[string]interface{})[visibility]; ok {
+			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
+				return r.(string)
+			}
+		}
+	}
+	return defaultValue
+}
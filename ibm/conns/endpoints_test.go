@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeEndpointsFile(t *testing.T, content string) string {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	f := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(f, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestLoadEndpointsMergesBlockOverFile(t *testing.T) {
+	f := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"public": {"us-south": "https://file.example.com/v1", "eu-de": "https://file-de.example.com/v1"}
+		}
+	}`)
+	endpoints := map[string]interface{}{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": map[string]interface{}{
+			"public": map[string]interface{}{"us-south": "http://localhost:8080/v1"},
+		},
+		"IBMCLOUD_TG_API_ENDPOINT": map[string]interface{}{
+			"private": map[string]interface{}{"us-south": "http://localhost:8081/v1"},
+		},
+	}
+
+	fileMap, err := LoadEndpoints(f, endpoints)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, tc := range []struct {
+		visibility, key, region, expected string
+	}{
+		{"public", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "http://localhost:8080/v1"},
+		{"public", "IBMCLOUD_IS_NG_API_ENDPOINT", "eu-de", "https://file-de.example.com/v1"},
+		{"private", "IBMCLOUD_TG_API_ENDPOINT", "us-south", "http://localhost:8081/v1"},
+		{"public", "IBMCLOUD_TG_API_ENDPOINT", "us-south", "default"},
+	} {
+		if got := fileFallBack(fileMap, tc.visibility, tc.key, tc.region, "default"); got != tc.expected {
+			t.Errorf("%s %s %s: expected %q, got %q", tc.key, tc.visibility, tc.region, tc.expected, got)
+		}
+	}
+}
+
+func TestLoadEndpointsInvalidFile(t *testing.T) {
+	for name, content := range map[string]string{
+		"malformed json": `{"IBMCLOUD_IS_NG_API_ENDPOINT": `,
+		"wrong shape":    `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": "https://example.com"}}`,
+	} {
+		f := writeEndpointsFile(t, content)
+		if _, err := LoadEndpoints(f, nil); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := LoadEndpoints(filepath.Join(t.TempDir(), "missing.json"), nil); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestClientSessionValidatesEndpointsWithoutCredentials(t *testing.T) {
+	f := writeEndpointsFile(t, `{"IBMCLOUD_IS_NG_API_ENDPOINT": `)
+	c := &Config{EndpointsFile: f}
+	if _, err := c.ClientSession(); err == nil {
+		t.Fatal("expected an error for a malformed endpoints file")
+	}
+}
+
+func TestLoadEndpointsWithoutFile(t *testing.T) {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+
+	fileMap, err := LoadEndpoints("", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fileMap != nil {
+		t.Fatalf("expected no endpoints, got %v", fileMap)
+	}
+}
+
+func TestEndpointAttribute(t *testing.T) {
+	if got := EndpointAttribute("IBMCLOUD_IS_NG_API_ENDPOINT"); got != "is_ng_api" {
+		t.Fatalf("expected is_ng_api, got %s", got)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Operation identifies the resource or data source operation on whose
+// behalf API calls are made, such as the create of an ibm_is_vpc.
+type Operation struct {
+	Resource   string
+	Name       string
+	DataSource bool
+}
+
+func (op Operation) String() string {
+	return fmt.Sprintf("%s %s", op.Resource, op.Name)
+}
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx that carries op. API calls made with
+// the returned context are attributed to op in HTTP traces. Calls made
+// without it, such as the SDK methods that do not take a context, are
+// attributed to the operation in progress, see TrackOperation.
+func WithOperation(ctx context.Context, op Operation) context.Context {
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+// OperationFromContext returns the operation carried by ctx, if any.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(Operation)
+	return op, ok
+}
+
+// runningOperations counts the operations in progress in the provider, which
+// Terraform runs concurrently.
+var runningOperations = struct {
+	sync.Mutex
+	counts map[Operation]int
+}{counts: map[Operation]int{}}
+
+// TrackOperation records op as in progress until the returned function is
+// called.
+func TrackOperation(op Operation) func() {
+	runningOperations.Lock()
+	runningOperations.counts[op]++
+	runningOperations.Unlock()
+
+	return func() {
+		runningOperations.Lock()
+		defer runningOperations.Unlock()
+		if runningOperations.counts[op]--; runningOperations.counts[op] <= 0 {
+			delete(runningOperations.counts, op)
+		}
+	}
+}
+
+// RunningOperations returns the operations in progress, sorted by resource and
+// name. An API call made without the context of its operation was made by one
+// of them.
+func RunningOperations() []Operation {
+	runningOperations.Lock()
+	defer runningOperations.Unlock()
+
+	ops := make([]Operation, 0, len(runningOperations.counts))
+	for op := range runningOperations.counts {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Resource != ops[j].Resource {
+			return ops[i].Resource < ops[j].Resource
+		}
+		if ops[i].Name != ops[j].Name {
+			return ops[i].Name < ops[j].Name
+		}
+		return !ops[i].DataSource && ops[j].DataSource
+	})
+	return ops
+}
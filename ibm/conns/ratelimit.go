@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	gohttp "net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is the number of requests per second that may be sent to an API
+// host, in bursts of up to Burst requests. A zero RequestsPerSecond means no
+// limit.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter holds a token bucket per API host, shared by all the clients of
+// a ClientSession.
+type rateLimiter struct {
+	defaultLimit RateLimit
+	hostLimits   map[string]RateLimit
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newRateLimiter(defaultLimit RateLimit, hostLimits map[string]RateLimit) *rateLimiter {
+	return &rateLimiter{
+		defaultLimit: defaultLimit,
+		hostLimits:   hostLimits,
+		limiters:     map[string]*rate.Limiter{},
+	}
+}
+
+// limiter returns the token bucket of host, or nil if requests to host are
+// not limited.
+func (l *rateLimiter) limiter(host string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limiter, ok := l.limiters[host]; ok {
+		return limiter
+	}
+
+	limit, ok := l.hostLimits[host]
+	if !ok {
+		limit = l.defaultLimit
+	}
+	var limiter *rate.Limiter
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	l.limiters[host] = limiter
+	return limiter
+}
+
+// rateLimitedTransport waits for the token bucket of the API host before
+// sending each request.
+type rateLimitedTransport struct {
+	limiter   *rateLimiter
+	transport gohttp.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	host := req.URL.Hostname()
+	if limiter := t.limiter.limiter(host); limiter != nil {
+		start := time.Now()
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Waited %s for the rate limit of %s before %s %s", time.Since(start), host, req.Method, req.URL.Path)
+	}
+	return t.transport.RoundTrip(req)
+}
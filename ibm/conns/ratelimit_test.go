@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterPerHost(t *testing.T) {
+	limiter := newRateLimiter(RateLimit{RequestsPerSecond: 10, Burst: 2}, map[string]RateLimit{
+		"iam.cloud.ibm.com":           {RequestsPerSecond: 1},
+		"us-south.iaas.cloud.ibm.com": {},
+	})
+
+	vpc := limiter.limiter("eu-de.iaas.cloud.ibm.com")
+	if vpc == nil || vpc.Limit() != 10 || vpc.Burst() != 2 {
+		t.Fatalf("expected the default rate limit, got %v", vpc)
+	}
+	if limiter.limiter("eu-de.iaas.cloud.ibm.com") != vpc {
+		t.Fatal("expected clients of the same host to share a token bucket")
+	}
+	if iam := limiter.limiter("iam.cloud.ibm.com"); iam == nil || iam.Limit() != 1 || iam.Burst() != 1 {
+		t.Fatalf("expected the rate limit of the host, got %v", iam)
+	}
+	if limiter.limiter("us-south.iaas.cloud.ibm.com") != nil {
+		t.Fatal("expected a zero rate to disable the limit of the host")
+	}
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	client := &http.Client{
+		Transport: &rateLimitedTransport{
+			limiter: newRateLimiter(RateLimit{}, map[string]RateLimit{
+				serverURL.Hostname(): {RequestsPerSecond: 20},
+			}),
+			transport: http.DefaultTransport,
+		},
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected 3 requests at 20 per second to take at least 100ms, took %s", elapsed)
+	}
+}
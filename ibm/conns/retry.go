@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes that are retried
+// unless the provider retry block says otherwise.
+var DefaultRetryableStatusCodes = []int{429, 500, 502, 503, 504}
+
+// RetryServiceNames are the services whose retry policy can be overridden in
+// the provider retry block.
+var RetryServiceNames = []string{
+	"app_configuration",
+	"appid",
+	"atracker",
+	"backup_recovery",
+	"bluemix",
+	"catalog_management",
+	"cbr",
+	"cis",
+	"cloud_shell",
+	"code_engine",
+	"config_aggregator",
+	"container_registry",
+	"cos",
+	"databases",
+	"db2",
+	"directlink",
+	"enterprise",
+	"event_notifications",
+	"event_streams",
+	"global_catalog",
+	"global_search",
+	"global_tagging",
+	"iam",
+	"logs",
+	"logs_routing",
+	"metrics_router",
+	"mqcloud",
+	"partner_center_sell",
+	"power",
+	"private_dns",
+	"project",
+	"push_notifications",
+	"resource_controller",
+	"resource_manager",
+	"satellite",
+	"scc",
+	"schematics",
+	"sds",
+	"secrets_manager",
+	"softlayer",
+	"tekton_pipeline",
+	"toolchain",
+	"transit_gateway",
+	"uko",
+	"usage_reports",
+	"vmware",
+	"vpc",
+}
+
+// RetryPolicy describes how failed API calls are retried. The delay between
+// attempts doubles from MinDelay up to MaxDelay, unless the response carries a
+// Retry-After header.
+type RetryPolicy struct {
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+	// Jitter randomizes each delay between half and all of its value, so
+	// that concurrent operations that were throttled together do not retry
+	// together.
+	Jitter               bool
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider retry
+// block is not set.
+func DefaultRetryPolicy(maxRetries int) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:           maxRetries,
+		MinDelay:             1 * time.Second,
+		MaxDelay:             30 * time.Second,
+		Jitter:               true,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+}
+
+// retryPolicy returns the retry policy of the named service.
+func (c *Config) retryPolicy(service string) RetryPolicy {
+	if policy, ok := c.RetryServices[service]; ok {
+		return policy
+	}
+	return c.Retry
+}
+
+// Apply enables retries on baseService with the policy in place of the
+// defaults of the go SDK core.
+func (p RetryPolicy) Apply(baseService *core.BaseService) {
+	if p.MaxRetries <= 0 {
+		baseService.DisableRetries()
+		return
+	}
+
+	baseService.EnableRetries(p.MaxRetries, p.MaxDelay)
+	if baseService.Client == nil {
+		return
+	}
+	roundTripper, ok := baseService.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok || roundTripper.Client == nil {
+		return
+	}
+	roundTripper.Client.RetryMax = p.MaxRetries
+	roundTripper.Client.RetryWaitMin = p.MinDelay
+	roundTripper.Client.RetryWaitMax = p.MaxDelay
+	roundTripper.Client.CheckRetry = p.CheckRetry
+	roundTripper.Client.Backoff = p.Backoff
+}
+
+// RoundTripper wraps transport with the retries of the policy, for the clients
+// that are not built on the go SDK core.
+func (p RetryPolicy) RoundTripper(transport http.RoundTripper) http.RoundTripper {
+	return p.RoundTripperWithTimeout(transport, 0)
+}
+
+// RoundTripperWithTimeout is RoundTripper with a timeout on every attempt, for
+// the clients whose own timeout would otherwise span all the attempts.
+func (p RetryPolicy) RoundTripperWithTimeout(transport http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	if p.MaxRetries <= 0 {
+		return transport
+	}
+	client := retryablehttp.NewClient()
+	client.HTTPClient = &http.Client{Transport: transport, Timeout: timeout}
+	client.Logger = nil
+	client.RetryMax = p.MaxRetries
+	client.RetryWaitMin = p.MinDelay
+	client.RetryWaitMax = p.MaxDelay
+	client.CheckRetry = p.CheckRetry
+	client.Backoff = p.Backoff
+	client.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	return &retryablehttp.RoundTripper{Client: client}
+}
+
+// CheckRetry is a retryablehttp.CheckRetry that retries connection errors
+// and the retryable status codes of the policy.
+func (p RetryPolicy) CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+	return p.IsRetryableStatusCode(resp.StatusCode), nil
+}
+
+// IsRetryableStatusCode reports whether responses with the given status code
+// are retried.
+func (p RetryPolicy) IsRetryableStatusCode(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff is a retryablehttp.Backoff that waits for the Retry-After of
+// throttled responses and backs off exponentially otherwise.
+func (p RetryPolicy) Backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			log.Printf("[DEBUG] Request returned %d, retrying after %s as requested by the server", resp.StatusCode, wait)
+			return wait
+		}
+	}
+
+	wait := p.Delay(min, max, attemptNum)
+	if resp != nil {
+		log.Printf("[DEBUG] Request returned %d, retrying in %s", resp.StatusCode, wait)
+	}
+	return wait
+}
+
+// Delay returns the delay before retry attemptNum, counting from zero.
+func (p RetryPolicy) Delay(min, max time.Duration, attemptNum int) time.Duration {
+	wait := max
+	if attemptNum < 32 {
+		if d := min << uint(attemptNum); d > 0 && d < max {
+			wait = d
+		}
+	}
+	if p.Jitter && wait > 1 {
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)))
+	}
+	return wait
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepBeforeRetry waits before retry attemptNum of a call that is retried
+// outside of an HTTP client.
+func (p RetryPolicy) sleepBeforeRetry(attemptNum int) {
+	time.Sleep(p.Delay(p.MinDelay, p.MaxDelay, attemptNum))
+}
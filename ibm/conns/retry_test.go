@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{}
+	for attempt, expected := range []time.Duration{
+		1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	} {
+		if wait := policy.Delay(time.Second, 10*time.Second, attempt); wait != expected {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, expected, wait)
+		}
+	}
+	if wait := policy.Delay(time.Second, 10*time.Second, 100); wait != 10*time.Second {
+		t.Errorf("expected the delay of late attempts to be capped, got %s", wait)
+	}
+
+	policy.Jitter = true
+	for i := 0; i < 100; i++ {
+		if wait := policy.Delay(time.Second, 10*time.Second, 2); wait < 2*time.Second || wait > 4*time.Second {
+			t.Fatalf("expected a delay between 2s and 4s, got %s", wait)
+		}
+	}
+}
+
+func TestRetryPolicyCheckRetry(t *testing.T) {
+	policy := RetryPolicy{RetryableStatusCodes: []int{429, 503}}
+	ctx := context.Background()
+
+	for statusCode, expected := range map[int]bool{
+		200: false,
+		404: false,
+		429: true,
+		500: false,
+		503: true,
+	} {
+		retry, err := policy.CheckRetry(ctx, &http.Response{StatusCode: statusCode}, nil)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", statusCode, err)
+		}
+		if retry != expected {
+			t.Errorf("%d: expected %t, got %t", statusCode, expected, retry)
+		}
+	}
+
+	retry, _ := policy.CheckRetry(ctx, nil, errors.New("connection reset by peer"))
+	if !retry {
+		t.Error("expected connection errors to be retried")
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if retry, err := policy.CheckRetry(cancelled, &http.Response{StatusCode: 429}, nil); retry || err == nil {
+		t.Error("expected cancelled requests not to be retried")
+	}
+}
+
+func TestRetryPolicyBackoffRetryAfter(t *testing.T) {
+	policy := RetryPolicy{Jitter: true}
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": {"7"}},
+	}
+	if wait := policy.Backoff(time.Second, 30*time.Second, 0, resp); wait != 7*time.Second {
+		t.Fatalf("expected to wait for Retry-After, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if wait := policy.Backoff(time.Second, 30*time.Second, 0, resp); wait < 55*time.Second || wait > time.Minute {
+		t.Fatalf("expected to wait until the Retry-After date, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "soon")
+	if wait := policy.Backoff(time.Second, 30*time.Second, 0, resp); wait > time.Second {
+		t.Fatalf("expected an invalid Retry-After to be ignored, got %s", wait)
+	}
+}
+
+func TestRetryPolicyRoundTripper(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryableStatusCodes: []int{503}}
+	client := &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("expected 200 after 3 attempts, got %d after %d", resp.StatusCode, attempts)
+	}
+
+	attempts = 0
+	policy.MaxRetries = 0
+	client = &http.Client{Transport: policy.RoundTripper(http.DefaultTransport)}
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || attempts != 1 {
+		t.Errorf("expected a single attempt without retries, got %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestBluemixHTTPClient(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			// times out
+			time.Sleep(200 * time.Millisecond)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		case 3:
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &Config{
+		BluemixTimeout: 100 * time.Millisecond,
+		Retry:          RetryPolicy{MaxRetries: 5, MinDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryableStatusCodes: []int{409, 429}},
+	}
+	client := c.bluemixHTTPClient(&bluemix.Config{HTTPTimeout: c.BluemixTimeout})
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || attempts != 4 {
+		t.Errorf("expected 200 after 4 attempts, got %d after %d", resp.StatusCode, attempts)
+	}
+
+	c.Retry.MaxRetries = 0
+	client = c.bluemixHTTPClient(&bluemix.Config{HTTPTimeout: c.BluemixTimeout})
+	if client.Timeout != c.BluemixTimeout {
+		t.Errorf("expected the bluemix timeout without retries, got %s", client.Timeout)
+	}
+}
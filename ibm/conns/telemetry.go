@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	tracerName = "github.com/IBM-Cloud/terraform-provider-ibm"
+
+	// tracingShutdownTimeout bounds the export of the spans that are left
+	// when the provider stops.
+	tracingShutdownTimeout = 5 * time.Second
+)
+
+// TracingEndpointEnvVars are the environment variables that enable the export
+// of OpenTelemetry spans. The spans are sent over OTLP/HTTP to the endpoint
+// that they hold. The other OTEL_EXPORTER_OTLP_* and OTEL_* variables, such as
+// OTEL_EXPORTER_OTLP_HEADERS and OTEL_SERVICE_NAME, are honoured as well.
+var TracingEndpointEnvVars = []string{
+	"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+	"OTEL_EXPORTER_OTLP_ENDPOINT",
+}
+
+var (
+	tracingOnce     sync.Once
+	tracerProvider  trace.TracerProvider = noop.NewTracerProvider()
+	shutdownTracing                      = func(context.Context) error { return nil }
+)
+
+// tracer returns the tracer of the provider, which does not record anything
+// unless one of the TracingEndpointEnvVars is set.
+func tracer() trace.Tracer {
+	tracingOnce.Do(startTracing)
+	return tracerProvider.Tracer(tracerName, trace.WithInstrumentationVersion(version.Version))
+}
+
+func startTracing() {
+	endpoint := ""
+	for _, envVar := range TracingEndpointEnvVars {
+		if endpoint = os.Getenv(envVar); endpoint != "" {
+			break
+		}
+	}
+	if endpoint == "" {
+		return
+	}
+
+	ctx := context.Background()
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		log.Printf("[WARN] Error creating the OpenTelemetry exporter of %s, spans are not exported: %s", endpoint, err)
+		return
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName("terraform-provider-ibm"),
+			semconv.ServiceVersion(version.Version),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		log.Printf("[WARN] Error describing the OpenTelemetry resource: %s", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	tracerProvider = provider
+	shutdownTracing = provider.Shutdown
+	log.Printf("[INFO] Exporting OpenTelemetry spans to %s", endpoint)
+}
+
+// StartOperationSpan starts the span of op and returns a copy of ctx that
+// carries both. The API calls and the waits made with the returned context
+// are recorded as children of the span.
+func StartOperationSpan(ctx context.Context, op Operation) (context.Context, trace.Span) {
+	return tracer().Start(WithOperation(ctx, op), fmt.Sprintf("%s %s", op.Resource, op.Name),
+		trace.WithAttributes(
+			attribute.String("ibm.resource.type", op.Resource),
+			attribute.String("ibm.operation", op.Name),
+			attribute.Bool("ibm.data_source", op.DataSource),
+		),
+	)
+}
+
+// EndOperationSpan ends the span of an operation, which is exported with its
+// children in the next batch. An operation that failed is recorded with the
+// ID and summary of its TerraformProblem.
+func EndOperationSpan(span trace.Span, errorID, errorSummary string) {
+	if errorSummary != "" {
+		span.SetAttributes(attribute.String("ibm.error.id", errorID))
+		span.SetStatus(codes.Error, errorSummary)
+	}
+	span.End()
+}
+
+// ShutdownTracing exports the spans that are left and stops tracing. It is
+// called once the provider stops serving.
+func ShutdownTracing() {
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] Error exporting OpenTelemetry spans: %s", err)
+	}
+}
+
+// StartWaitSpan starts the span of a wait for a resource to reach one of the
+// target states, as a child of the span carried by ctx.
+func StartWaitSpan(ctx context.Context, pending, target []string, timeout time.Duration) (context.Context, trace.Span) {
+	return tracer().Start(ctx, fmt.Sprintf("wait for %s", strings.Join(target, ", ")),
+		trace.WithAttributes(
+			attribute.StringSlice("ibm.wait.pending", pending),
+			attribute.StringSlice("ibm.wait.target", target),
+			attribute.String("ibm.wait.timeout", timeout.String()),
+		),
+	)
+}
+
+// EndSpan ends a span with the error, if any, of what it records.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// spanTransport records every request sent through it as a span, which is a
+// child of the span carried by the context of the request.
+type spanTransport struct {
+	transport gohttp.RoundTripper
+}
+
+func (t *spanTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if !trace.SpanFromContext(req.Context()).SpanContext().IsValid() {
+		return t.transport.RoundTrip(req)
+	}
+
+	_, span := tracer().Start(req.Context(), fmt.Sprintf("%s %s", req.Method, req.URL.Hostname()),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(redactURL(req.URL)),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+	)
+	resp, err := t.transport.RoundTrip(req)
+	if resp != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if id := transactionID(resp.Header); id != "" {
+			span.SetAttributes(attribute.String("ibm.transaction_id", id))
+		}
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	EndSpan(span, err)
+	return resp, err
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestOperationSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider, previousShutdown := tracerProvider, shutdownTracing
+	t.Cleanup(func() {
+		tracingOnce = sync.Once{}
+		tracerProvider, shutdownTracing = previousProvider, previousShutdown
+	})
+	tracingOnce.Do(func() {})
+	tracerProvider, shutdownTracing = provider, provider.Shutdown
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &spanTransport{transport: http.DefaultTransport}}
+
+	// Requests made outside of an operation are not recorded
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if spans := exporter.GetSpans(); len(spans) != 0 {
+		t.Fatalf("expected no span, got %d", len(spans))
+	}
+
+	ctx, span := StartOperationSpan(context.Background(), Operation{Resource: "ibm_is_vpc", Name: "read"})
+	if op, ok := OperationFromContext(ctx); !ok || op.Resource != "ibm_is_vpc" {
+		t.Fatal("expected the context to carry the operation")
+	}
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/vpcs?apikey=abc", nil)
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	_, wait := StartWaitSpan(ctx, []string{"pending"}, []string{"available"}, 0)
+	EndSpan(wait, errors.New("timeout while waiting"))
+	EndOperationSpan(span, "terraform-1234", "Error reading VPC")
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	httpSpan, waitSpan, operationSpan := spans[0], spans[1], spans[2]
+
+	if operationSpan.Name != "ibm_is_vpc read" || operationSpan.Status.Code != codes.Error {
+		t.Errorf("unexpected operation span %s: %v", operationSpan.Name, operationSpan.Status)
+	}
+	if !hasAttribute(operationSpan.Attributes, attribute.String("ibm.error.id", "terraform-1234")) {
+		t.Errorf("expected the error ID on the operation span, got %v", operationSpan.Attributes)
+	}
+	for _, child := range []tracetest.SpanStub{httpSpan, waitSpan} {
+		if child.Parent.SpanID() != operationSpan.SpanContext.SpanID() {
+			t.Errorf("expected %s to be a child of the operation span", child.Name)
+		}
+		if child.Status.Code != codes.Error {
+			t.Errorf("expected %s to have failed", child.Name)
+		}
+	}
+	if !hasAttribute(httpSpan.Attributes, attribute.Int("http.response.status_code", http.StatusNotFound)) ||
+		!hasAttribute(httpSpan.Attributes, attribute.String("ibm.transaction_id", "req-1")) ||
+		!hasAttribute(httpSpan.Attributes, attribute.String("url.full", server.URL+"/v1/vpcs?apikey=REDACTED")) {
+		t.Errorf("unexpected HTTP span attributes %v", httpSpan.Attributes)
+	}
+	if waitSpan.Name != "wait for available" {
+		t.Errorf("unexpected wait span %s", waitSpan.Name)
+	}
+}
+
+func hasAttribute(attributes []attribute.KeyValue, expected attribute.KeyValue) bool {
+	for _, kv := range attributes {
+		if kv.Key == expected.Key && kv.Value.Emit() == expected.Value.Emit() {
+			return true
+		}
+	}
+	return false
+}
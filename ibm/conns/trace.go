@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redacted = "REDACTED"
+
+	// maxTracedBodySize is the size of the bodies that are recorded in full.
+	// Larger bodies are recorded as their size only.
+	maxTracedBodySize = 64 * 1024
+)
+
+// transactionIDHeaders are the response headers that IBM Cloud APIs return
+// the transaction ID of a request in, by order of preference.
+var transactionIDHeaders = []string{
+	"Transaction-Id",
+	"X-Transaction-Id",
+	"X-Correlation-Id",
+	"X-Request-Id",
+}
+
+// sensitiveNames are the substrings of the header, query parameter and body
+// field names whose values are redacted from HTTP traces. Names are compared
+// in lower case without "-" and "_".
+var sensitiveNames = []string{
+	"authorization",
+	"cookie",
+	"apikey",
+	"password",
+	"passwd",
+	"passphrase",
+	"secret",
+	"token",
+	"privatekey",
+	"credential",
+	"payload",
+}
+
+// sensitiveFieldNames are the names of the header, query parameter and body
+// fields whose values are redacted from HTTP traces, such as the data of a
+// key-value secret. They are compared like sensitiveNames, but in full, so
+// that fields such as metadata are kept.
+var sensitiveFieldNames = []string{
+	"data",
+}
+
+// httpTrace is a record of an HTTP trace file. The file holds one JSON
+// encoded record per line.
+type httpTrace struct {
+	Time       time.Time `json:"time"`
+	Resource   string    `json:"resource,omitempty"`
+	Operation  string    `json:"operation,omitempty"`
+	DataSource bool      `json:"data_source,omitempty"`
+	// RunningOperations are the operations in progress when a call made
+	// without the context of its operation could come from any of them.
+	RunningOperations []string          `json:"running_operations,omitempty"`
+	Method            string            `json:"method"`
+	URL               string            `json:"url"`
+	Status            int               `json:"status,omitempty"`
+	LatencyMS         int64             `json:"latency_ms"`
+	TransactionID     string            `json:"transaction_id,omitempty"`
+	Error             string            `json:"error,omitempty"`
+	RequestHeaders    map[string]string `json:"request_headers,omitempty"`
+	ResponseHeaders   map[string]string `json:"response_headers,omitempty"`
+	RequestBody       interface{}       `json:"request_body,omitempty"`
+	ResponseBody      interface{}       `json:"response_body,omitempty"`
+}
+
+// httpTracer appends the traces of the API calls made by all the clients of
+// a ClientSession to a file.
+type httpTracer struct {
+	mu     sync.Mutex
+	out    io.Writer
+	bodies bool
+}
+
+func newHTTPTracer(path string, bodies bool) (*httpTracer, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error opening HTTP trace file %s: %s", path, err)
+	}
+	return &httpTracer{out: file, bodies: bodies}, nil
+}
+
+func (t *httpTracer) write(trace httpTrace) {
+	line, err := json.Marshal(trace)
+	if err != nil {
+		log.Printf("[WARN] Error encoding HTTP trace of %s %s: %s", trace.Method, trace.URL, err)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err := t.out.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Error writing HTTP trace of %s %s: %s", trace.Method, trace.URL, err)
+	}
+}
+
+// tracedTransport records every request sent through it with its response.
+type tracedTransport struct {
+	tracer    *httpTracer
+	transport gohttp.RoundTripper
+}
+
+func (t *tracedTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	trace := httpTrace{
+		Time:           time.Now().UTC(),
+		Method:         req.Method,
+		URL:            redactURL(req.URL),
+		RequestHeaders: redactHeaders(req.Header),
+	}
+	op, ok := OperationFromContext(req.Context())
+	if !ok {
+		// The call was made without a context, by one of the running
+		// operations
+		running := RunningOperations()
+		if len(running) == 1 {
+			op, ok = running[0], true
+		} else {
+			for _, r := range running {
+				trace.RunningOperations = append(trace.RunningOperations, r.String())
+			}
+		}
+	}
+	if ok {
+		trace.Resource = op.Resource
+		trace.Operation = op.Name
+		trace.DataSource = op.DataSource
+	}
+	if t.tracer.bodies {
+		trace.RequestBody = requestBody(req)
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	trace.LatencyMS = time.Since(start).Milliseconds()
+
+	if err != nil {
+		trace.Error = err.Error()
+	}
+	if resp != nil {
+		trace.Status = resp.StatusCode
+		trace.TransactionID = transactionID(resp.Header)
+		trace.ResponseHeaders = redactHeaders(resp.Header)
+		if t.tracer.bodies {
+			trace.ResponseBody = responseBody(resp)
+		}
+	}
+	if trace.TransactionID == "" {
+		trace.TransactionID = transactionID(req.Header)
+	}
+
+	t.tracer.write(trace)
+	return resp, err
+}
+
+func transactionID(header gohttp.Header) string {
+	for _, name := range transactionIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// requestBody returns the redacted body of req without consuming it. Bodies
+// that cannot be read again are not recorded.
+func requestBody(req *gohttp.Request) interface{} {
+	if req.Body == nil || req.Body == gohttp.NoBody {
+		return nil
+	}
+	if req.GetBody == nil {
+		return "[body not recorded]"
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "[body not recorded]"
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "[body not recorded]"
+	}
+	return redactBody(req.Header.Get("Content-Type"), data)
+}
+
+// responseBody returns the redacted body of resp, which is replaced with a
+// copy so that the caller can still read it.
+func responseBody(resp *gohttp.Response) interface{} {
+	if resp.Body == nil || resp.Body == gohttp.NoBody {
+		return nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return "[body not recorded]"
+	}
+	return redactBody(resp.Header.Get("Content-Type"), data)
+}
+
+// redactBody decodes JSON and form bodies and redacts their sensitive
+// fields. Other bodies are recorded as their size only.
+func redactBody(contentType string, data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if len(data) <= maxTracedBodySize {
+		switch {
+		case strings.HasSuffix(mediaType, "json"):
+			var body interface{}
+			if err := json.Unmarshal(data, &body); err == nil {
+				return redactJSON(body)
+			}
+		case mediaType == "application/x-www-form-urlencoded":
+			if values, err := url.ParseQuery(string(data)); err == nil {
+				return redactValues(values).Encode()
+			}
+		}
+	}
+	return fmt.Sprintf("[%d bytes of %s]", len(data), mediaType)
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if isSensitive(key) {
+			values[key] = []string{redacted}
+		}
+	}
+	return values
+}
+
+func redactHeaders(header gohttp.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+	redactedHeader := make(map[string]string, len(header))
+	for name, values := range header {
+		if isSensitive(name) {
+			redactedHeader[name] = redacted
+		} else {
+			redactedHeader[name] = strings.Join(values, ", ")
+		}
+	}
+	return redactedHeader
+}
+
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	if u.RawQuery != "" {
+		redactedURL.RawQuery = redactValues(u.Query()).Encode()
+	}
+	return redactedURL.Redacted()
+}
+
+func isSensitive(name string) bool {
+	name = strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(name))
+	for _, sensitive := range sensitiveFieldNames {
+		if name == sensitive {
+			return true
+		}
+	}
+	for _, sensitive := range sensitiveNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTracedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Transaction-Id", "txn-1234")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"r006-1","access_token":"eyJhbGciOi","keys":[{"name":"k","private_key":"-----BEGIN"}]}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	client := &http.Client{
+		Transport: &tracedTransport{
+			tracer:    &httpTracer{out: &out, bodies: true},
+			transport: http.DefaultTransport,
+		},
+	}
+
+	ctx := WithOperation(context.Background(), Operation{Resource: "ibm_is_vpc", Name: "create"})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/vpcs?version=2024-01-01&apikey=abc", strings.NewReader(`{"name":"vpc","password":"hunter2"}`))
+	req.Header.Set("Authorization", "Bearer eyJhbGciOi")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "eyJhbGciOi") {
+		t.Fatal("expected the response body to be left intact for the caller")
+	}
+
+	for _, secret := range []string{"eyJhbGciOi", "hunter2", "BEGIN", "apikey=abc"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("expected %q to be redacted from %s", secret, out.String())
+		}
+	}
+
+	var trace httpTrace
+	if err := json.Unmarshal(out.Bytes(), &trace); err != nil {
+		t.Fatalf("expected a JSON line, got %q: %v", out.String(), err)
+	}
+	if trace.Resource != "ibm_is_vpc" || trace.Operation != "create" {
+		t.Errorf("expected the operation to be recorded, got %s %s", trace.Resource, trace.Operation)
+	}
+	if trace.Method != http.MethodPost || trace.Status != http.StatusCreated || trace.TransactionID != "txn-1234" {
+		t.Errorf("unexpected trace %+v", trace)
+	}
+	if trace.RequestHeaders["Authorization"] != redacted {
+		t.Errorf("expected the Authorization header to be redacted, got %q", trace.RequestHeaders["Authorization"])
+	}
+	if !strings.Contains(trace.URL, "version=2024-01-01") {
+		t.Errorf("expected the query to be kept, got %s", trace.URL)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	form := redactBody("application/x-www-form-urlencoded", []byte("grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey&apikey=secret-key"))
+	if strings.Contains(form.(string), "secret-key") || !strings.Contains(form.(string), "grant_type") {
+		t.Errorf("unexpected form body %v", form)
+	}
+
+	if binary := redactBody("application/octet-stream", []byte{1, 2, 3}); binary != "[3 bytes of application/octet-stream]" {
+		t.Errorf("unexpected binary body %v", binary)
+	}
+}
+
+func TestRedactBodyKVSecret(t *testing.T) {
+	body := redactBody("application/json", []byte(`{"id":"secret-id","secret_type":"kv","metadata":{"name":"kv"},"data":{"db_user":"admin","db_pass":"hunter2"}}`))
+	encoded, _ := json.Marshal(body)
+	for _, secret := range []string{"admin", "hunter2"} {
+		if strings.Contains(string(encoded), secret) {
+			t.Errorf("expected %q to be redacted from %s", secret, encoded)
+		}
+	}
+	fields := body.(map[string]interface{})
+	if fields["data"] != redacted {
+		t.Errorf("expected the data of the secret to be redacted, got %v", fields["data"])
+	}
+	if fields["id"] != "secret-id" || fields["metadata"] == redacted {
+		t.Errorf("expected the other fields to be kept, got %s", encoded)
+	}
+}
+
+func TestTracedTransportWithoutContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	client := &http.Client{
+		Transport: &tracedTransport{
+			tracer:    &httpTracer{out: &out},
+			transport: http.DefaultTransport,
+		},
+	}
+	get := func() httpTrace {
+		out.Reset()
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		var trace httpTrace
+		if err := json.Unmarshal(out.Bytes(), &trace); err != nil {
+			t.Fatalf("expected a JSON line, got %q: %v", out.String(), err)
+		}
+		return trace
+	}
+
+	endVPC := TrackOperation(Operation{Resource: "ibm_is_vpc", Name: "create"})
+	if trace := get(); trace.Resource != "ibm_is_vpc" || trace.Operation != "create" || trace.RunningOperations != nil {
+		t.Errorf("expected the call to be attributed to the running operation, got %+v", trace)
+	}
+
+	endSubnet := TrackOperation(Operation{Resource: "ibm_is_subnet", Name: "read", DataSource: true})
+	trace := get()
+	if trace.Resource != "" || trace.Operation != "" {
+		t.Errorf("expected no operation while several are running, got %s %s", trace.Resource, trace.Operation)
+	}
+	if strings.Join(trace.RunningOperations, ",") != "ibm_is_subnet read,ibm_is_vpc create" {
+		t.Errorf("expected the running operations, got %v", trace.RunningOperations)
+	}
+
+	endSubnet()
+	endVPC()
+	if trace := get(); trace.Resource != "" || trace.RunningOperations != nil {
+		t.Errorf("expected no operation once they ended, got %+v", trace)
+	}
+}
@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,6 +15,16 @@ func IsResourceTimeoutError(err error) bool {
 	timeoutErr, ok := err.(*resource.TimeoutError)
 	return ok && timeoutErr.LastError == nil
 }
+
+// WaitForStateContext waits like stateConf.WaitForStateContext, and records
+// the wait as a span of the operation carried by ctx.
+func WaitForStateContext(ctx context.Context, stateConf *resource.StateChangeConf) (interface{}, error) {
+	ctx, span := StartWaitSpan(ctx, stateConf.Pending, stateConf.Target, stateConf.Timeout)
+	result, err := stateConf.WaitForStateContext(ctx)
+	EndSpan(span, err)
+	return result, err
+}
+
 func GetPrivateServiceURLForRegion(region string) (string, error) {
 	var endpoints = map[string]string{
 		"us-south":   "https://private.us.icr.io",  // us-south
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package crn parses, builds and matches IBM Cloud Resource Names, which have
+// the form
+//
+//	crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+//
+// The resource segment may itself contain colons.
+package crn
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	prefix   = "crn"
+	segments = 10
+
+	// AccountScopePrefix prefixes the account ID in the scope of a CRN.
+	AccountScopePrefix = "a/"
+)
+
+// CRN holds the segments of a Cloud Resource Name.
+type CRN struct {
+	Version         string
+	CName           string
+	CType           string
+	ServiceName     string
+	Location        string
+	Scope           string
+	ServiceInstance string
+	ResourceType    string
+	Resource        string
+}
+
+// Parse splits s into the segments of a CRN. It fails if s does not start
+// with "crn:" or has fewer than ten segments.
+func Parse(s string) (CRN, error) {
+	parts := strings.SplitN(s, ":", segments)
+	if len(parts) != segments || parts[0] != prefix {
+		return CRN{}, fmt.Errorf("%q is not a valid CRN, expected crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource", s)
+	}
+	return CRN{
+		Version:         parts[1],
+		CName:           parts[2],
+		CType:           parts[3],
+		ServiceName:     parts[4],
+		Location:        parts[5],
+		Scope:           parts[6],
+		ServiceInstance: parts[7],
+		ResourceType:    parts[8],
+		Resource:        parts[9],
+	}, nil
+}
+
+// New returns a public CRN of the IBM Cloud (bluemix) environment. account is
+// the ID of the account that owns the resource and may be empty.
+func New(serviceName, location, account, serviceInstance, resourceType, resource string) CRN {
+	scope := account
+	if account != "" && !strings.Contains(account, "/") {
+		scope = AccountScopePrefix + account
+	}
+	return CRN{
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     serviceName,
+		Location:        location,
+		Scope:           scope,
+		ServiceInstance: serviceInstance,
+		ResourceType:    resourceType,
+		Resource:        resource,
+	}
+}
+
+func (c CRN) String() string {
+	return strings.Join([]string{
+		prefix,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Location,
+		c.Scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, ":")
+}
+
+// Account returns the account ID of a CRN scoped to an account, or an empty
+// string for other scopes.
+func (c CRN) Account() string {
+	if strings.HasPrefix(c.Scope, AccountScopePrefix) {
+		return strings.TrimPrefix(c.Scope, AccountScopePrefix)
+	}
+	return ""
+}
+
+// ServiceInstanceCRN returns the CRN of the service instance that holds the
+// resource identified by c.
+func (c CRN) ServiceInstanceCRN() CRN {
+	c.ResourceType = ""
+	c.Resource = ""
+	return c
+}
+
+// WithResource returns the CRN of the resource of the given type in the
+// service instance identified by c.
+func (c CRN) WithResource(resourceType, resource string) CRN {
+	c.ResourceType = resourceType
+	c.Resource = resource
+	return c
+}
+
+// Match reports whether crn matches pattern, segment by segment. An empty
+// pattern segment matches any value, and "*" matches any sequence of
+// characters within a segment, as in "crn:v1:bluemix:public:is:us-*:::vpc:".
+func Match(crn, pattern string) (bool, error) {
+	c, err := Parse(crn)
+	if err != nil {
+		return false, err
+	}
+	p, err := Parse(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	values := c.segments()
+	for i, segment := range p.segments() {
+		if segment == "" {
+			continue
+		}
+		if !segmentPattern(segment).MatchString(values[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c CRN) segments() []string {
+	return []string{
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Location,
+		c.Scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}
+}
+
+func segmentPattern(segment string) *regexp.Regexp {
+	parts := strings.Split(segment, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"testing"
+)
+
+const (
+	vpcCRN    = "crn:v1:bluemix:public:is:us-south:a/6db1b0d0b5c54ee5c201552547febcd8::vpc:r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"
+	bucketCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:bucket:my-bucket:meta:rl:us-south:public"
+)
+
+func TestParse(t *testing.T) {
+	c, err := Parse(bucketCRN)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := CRN{
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     "cloud-object-storage",
+		Location:        "global",
+		Scope:           "a/6db1b0d0b5c54ee5c201552547febcd8",
+		ServiceInstance: "c822a30e-bfff-4867-85ec-b805eeab1835",
+		ResourceType:    "bucket",
+		Resource:        "my-bucket:meta:rl:us-south:public",
+	}
+	if c != expected {
+		t.Fatalf("expected %+v, got %+v", expected, c)
+	}
+	if c.Account() != "6db1b0d0b5c54ee5c201552547febcd8" {
+		t.Errorf("unexpected account %q", c.Account())
+	}
+	if c.String() != bucketCRN {
+		t.Errorf("expected %q, got %q", bucketCRN, c.String())
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"r006-4727d842-f94f-4a2d-824a-9bc9b02c523b",
+		"crn:v1:bluemix:public:is:us-south",
+		"arn:v1:bluemix:public:is:us-south:a/6db1b0d0b5c54ee5c201552547febcd8::vpc:r006",
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	c := New("is", "us-south", "6db1b0d0b5c54ee5c201552547febcd8", "", "vpc", "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b")
+	if c.String() != vpcCRN {
+		t.Fatalf("expected %q, got %q", vpcCRN, c.String())
+	}
+
+	c = New("is", "us-south", "o/my-org", "", "", "")
+	if c.Scope != "o/my-org" {
+		t.Fatalf("expected the scope to be kept, got %q", c.Scope)
+	}
+}
+
+func TestServiceInstanceCRN(t *testing.T) {
+	c, err := Parse(bucketCRN)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "crn:v1:bluemix:public:cloud-object-storage:global:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835::"
+	if got := c.ServiceInstanceCRN().String(); got != expected {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestWithResource(t *testing.T) {
+	c, err := Parse(bucketCRN)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "crn:v1:bluemix:public:cloud-object-storage:global:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:bucket:other-bucket"
+	if got := c.ServiceInstanceCRN().WithResource("bucket", "other-bucket").String(); got != expected {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	for _, tc := range []struct {
+		pattern  string
+		expected bool
+	}{
+		{"crn:v1:bluemix:public:is:us-south:::vpc:", true},
+		{"crn:v1:bluemix:public:is:us-*:::vpc:", true},
+		{"crn:::::::::", true},
+		{"crn:v1:bluemix:public:is:eu-*:::vpc:", false},
+		{"crn:v1:bluemix:public:is:us-south:::subnet:", false},
+		{"crn:v1:bluemix:public:is:us-south:a/*:::", true},
+		{"crn:v1:bluemix:public:is:us-south:::vpc:r006-*", true},
+		{"crn:v1:bluemix:public:is:us.south:::vpc:", false},
+	} {
+		matched, err := Match(vpcCRN, tc.pattern)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.pattern, err)
+		}
+		if matched != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.pattern, tc.expected, matched)
+		}
+	}
+
+	if _, err := Match(vpcCRN, "us-south"); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// FrameworkErrorDiagnostic returns a plugin framework error diagnostic that
+// carries err. The provider reports it as a TerraformProblem, the same way as
+// errors returned from SDKv2 resources.
+func FrameworkErrorDiagnostic(err error) fwdiag.Diagnostic {
+	return frameworkErrorDiagnostic{err: err}
+}
+
+type frameworkErrorDiagnostic struct {
+	err error
+}
+
+func (d frameworkErrorDiagnostic) Severity() fwdiag.Severity {
+	return fwdiag.SeverityError
+}
+
+func (d frameworkErrorDiagnostic) Summary() string {
+	return d.err.Error()
+}
+
+func (d frameworkErrorDiagnostic) Detail() string {
+	return ""
+}
+
+func (d frameworkErrorDiagnostic) Equal(other fwdiag.Diagnostic) bool {
+	o, ok := other.(frameworkErrorDiagnostic)
+	return ok && o.err == d.err
+}
+
+// Unwrap returns the error carried by the diagnostic.
+func (d frameworkErrorDiagnostic) Unwrap() error {
+	return d.err
+}
@@ -5,6 +5,7 @@ package flex
 
 import (
 	"bytes"
+	"context"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
@@ -2814,7 +2815,7 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
 
-func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
+func UpdateGlobalTagsUsingCRN(ctx context.Context, oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
@@ -2901,7 +2902,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating database tags %v : %s\n%s", add, err, resp)
 		}
-		response, errored := WaitForTagsAvailable(meta, resourceID, resourceType, tagType, news, 30*time.Second)
+		response, errored := WaitForTagsAvailable(ctx, meta, resourceID, resourceType, tagType, news, 30*time.Second)
 		if errored != nil {
 			log.Printf(`[ERROR] Error waiting for resource tags %s : %v
 %v`, resourceID, errored, response)
@@ -2911,7 +2912,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	return nil
 }
 
-func WaitForTagsAvailable(meta interface{}, resourceID, resourceType, tagType string, desired *schema.Set, timeout time.Duration) (interface{}, error) {
+func WaitForTagsAvailable(ctx context.Context, meta interface{}, resourceID, resourceType, tagType string, desired *schema.Set, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for tag attachment (%s) to be successful.", resourceID)
 
 	stateConf := &resource.StateChangeConf{
@@ -2922,7 +2923,7 @@ func WaitForTagsAvailable(meta interface{}, resourceID, resourceType, tagType st
 		Delay:      3 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func tagsRefreshFunc(meta interface{}, resourceID, resourceType, tagType string, desired *schema.Set) resource.StateRefreshFunc {
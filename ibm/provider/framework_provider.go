@@ -0,0 +1,511 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+// frameworkResources, frameworkDataSources, frameworkEphemeralResources and
+// frameworkFunctions list what is served by the plugin framework provider.
+// New services that need features unavailable in SDKv2, such as ephemeral
+// resources or provider functions, are added here.
+var (
+	frameworkResources          = []func() resource.Resource{}
+	frameworkDataSources        = []func() datasource.DataSource{}
+	frameworkEphemeralResources = []func() ephemeral.EphemeralResource{
+		// Secrets Manager
+		secretsmanager.EphemeralIbmSmArbitrarySecret,
+		secretsmanager.EphemeralIbmSmIamCredentialsSecret,
+		secretsmanager.EphemeralIbmSmImportedCertificate,
+		secretsmanager.EphemeralIbmSmKvSecret,
+		secretsmanager.EphemeralIbmSmPrivateCertificate,
+		secretsmanager.EphemeralIbmSmPublicCertificate,
+		secretsmanager.EphemeralIbmSmUsernamePasswordSecret,
+
+		// Kubernetes Service
+		kubernetes.EphemeralIBMContainerClusterConfig,
+	}
+	frameworkFunctions = []func() function.Function{
+		newBuildCRNFunction,
+		newCRNMatchesFunction,
+		newParseCRNFunction,
+	}
+)
+
+var (
+	_ fwprovider.Provider                       = &frameworkProvider{}
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+)
+
+// frameworkProvider is the plugin framework half of the provider. It is
+// muxed with the SDKv2 provider and shares its conns.ClientSession.
+type frameworkProvider struct {
+	primary *schema.Provider
+}
+
+// ProtoV5ProviderServerFactory returns a factory for the provider server that
+// muxes the SDKv2 provider with the plugin framework provider.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	// The SDKv2 provider is listed first so that it is configured before the
+	// plugin framework provider, which reuses its client session.
+	primary := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		func() tfprotov5.ProviderServer { return schema.NewGRPCProviderServer(primary) },
+		providerserver.NewProtocol5(NewFrameworkProvider(primary)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// NewFrameworkProvider returns the plugin framework provider to be muxed with
+// primary, the SDKv2 provider returned by Provider. primary must be configured
+// before the framework provider, which reuses its client session.
+func NewFrameworkProvider(primary *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{
+		primary: primary,
+	}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "ibm"
+	resp.Version = version.Version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	// The mux server requires both providers to declare the same schema.
+	attributes, blocks, diags := frameworkProviderSchema(p.primary.Schema, "")
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	resp.Schema = fwschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	meta := p.primary.Meta()
+	if meta == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The IBM Cloud client session is not available to the plugin framework provider.",
+		)
+		return
+	}
+	resp.ResourceData = meta
+	resp.DataSourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(frameworkResources))
+	for _, f := range frameworkResources {
+		wrapped = append(wrapped, func() resource.Resource {
+			return wrapFrameworkResource(f())
+		})
+	}
+	return wrapped
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	wrapped := make([]func() datasource.DataSource, 0, len(frameworkDataSources))
+	for _, f := range frameworkDataSources {
+		wrapped = append(wrapped, func() datasource.DataSource {
+			return wrapFrameworkDataSource(f())
+		})
+	}
+	return wrapped
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	wrapped := make([]func() ephemeral.EphemeralResource, 0, len(frameworkEphemeralResources))
+	for _, f := range frameworkEphemeralResources {
+		wrapped = append(wrapped, func() ephemeral.EphemeralResource {
+			return wrapFrameworkEphemeralResource(f())
+		})
+	}
+	return wrapped
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	wrapped := make([]func() function.Function, 0, len(frameworkFunctions))
+	for _, f := range frameworkFunctions {
+		wrapped = append(wrapped, func() function.Function {
+			return wrapFrameworkFunction(f())
+		})
+	}
+	return wrapped
+}
+
+// frameworkProviderSchema converts the SDKv2 provider schema to the attributes
+// and blocks of the plugin framework provider schema. prefix is the path of
+// the enclosing block, used in the diagnostics of unsupported attributes.
+func frameworkProviderSchema(sdkSchema map[string]*schema.Schema, prefix string) (map[string]fwschema.Attribute, map[string]fwschema.Block, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	attributes := map[string]fwschema.Attribute{}
+	blocks := map[string]fwschema.Block{}
+	for name, s := range sdkSchema {
+		attributePath := name
+		if prefix != "" {
+			attributePath = prefix + "." + name
+		}
+		switch s.Type {
+		case schema.TypeString:
+			attributes[name] = fwschema.StringAttribute{
+				Optional:           s.Optional,
+				Required:           s.Required,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case schema.TypeInt:
+			attributes[name] = fwschema.Int64Attribute{
+				Optional:           s.Optional,
+				Required:           s.Required,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case schema.TypeFloat:
+			attributes[name] = fwschema.Float64Attribute{
+				Optional:           s.Optional,
+				Required:           s.Required,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case schema.TypeBool:
+			attributes[name] = fwschema.BoolAttribute{
+				Optional:           s.Optional,
+				Required:           s.Required,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case schema.TypeMap:
+			attributes[name] = fwschema.MapAttribute{
+				ElementType:        types.StringType,
+				Optional:           s.Optional,
+				Required:           s.Required,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case schema.TypeList:
+			elem, ok := s.Elem.(*schema.Resource)
+			if !ok {
+				diags.Append(unsupportedProviderAttributeDiagnostic(attributePath, "only lists of blocks are supported"))
+				continue
+			}
+			nestedAttributes, nestedBlocks, nestedDiags := frameworkProviderSchema(elem.Schema, attributePath)
+			diags.Append(nestedDiags...)
+			blocks[name] = fwschema.ListNestedBlock{
+				NestedObject: fwschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		case schema.TypeSet:
+			var elementType attr.Type
+			if elem, ok := s.Elem.(*schema.Schema); ok && elem.Type == schema.TypeString {
+				elementType = types.StringType
+			} else if ok && elem.Type == schema.TypeInt {
+				elementType = types.Int64Type
+			} else {
+				diags.Append(unsupportedProviderAttributeDiagnostic(attributePath, "only sets of strings or integers are supported"))
+				continue
+			}
+			attributes[name] = fwschema.SetAttribute{
+				ElementType:        elementType,
+				Optional:           s.Optional,
+				Required:           s.Required,
+				Sensitive:          s.Sensitive,
+				Description:        s.Description,
+				DeprecationMessage: s.Deprecated,
+			}
+		default:
+			diags.Append(unsupportedProviderAttributeDiagnostic(attributePath, fmt.Sprintf("unsupported type %s", s.Type)))
+		}
+	}
+	return attributes, blocks, diags
+}
+
+func unsupportedProviderAttributeDiagnostic(attributePath, detail string) fwdiag.Diagnostic {
+	return fwdiag.NewErrorDiagnostic(
+		"Unsupported provider schema attribute",
+		fmt.Sprintf("The provider attribute %s cannot be served by the plugin framework provider: %s.", attributePath, detail),
+	)
+}
+
+// wrapFrameworkDiagnostics reports the errors in diags as TerraformProblems,
+// like wrapError does for SDKv2 resources. Errors added with
+// flex.FrameworkErrorDiagnostic keep their cause.
+func wrapFrameworkDiagnostics(diags fwdiag.Diagnostics, resourceName, operationName string, isDataSource bool) fwdiag.Diagnostics {
+	if !diags.HasError() {
+		return diags
+	}
+
+	var wrapped fwdiag.Diagnostics
+	for _, d := range diags {
+		if d.Severity() != fwdiag.SeverityError {
+			wrapped.Append(d)
+			continue
+		}
+
+		var err error
+		if u, ok := d.(interface{ Unwrap() error }); ok {
+			err = u.Unwrap()
+		} else if d.Detail() != "" {
+			err = fmt.Errorf("%s: %s", d.Summary(), d.Detail())
+		} else {
+			err = errors.New(d.Summary())
+		}
+
+		tfError := terraformProblem(err, resourceName, operationName, isDataSource)
+		if dp, ok := d.(fwdiag.DiagnosticWithPath); ok {
+			wrapped.AddAttributeError(dp.Path(), tfError.Error(), tfError.GetConsoleMessage())
+		} else {
+			wrapped.AddError(tfError.Error(), tfError.GetConsoleMessage())
+		}
+	}
+	return wrapped
+}
+
+type wrappedFrameworkResource struct {
+	resource.Resource
+	name string
+}
+
+// endFrameworkOperationSpan ends the span of an operation with the first
+// error of diags, if any.
+func endFrameworkOperationSpan(span trace.Span, diags fwdiag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity() == fwdiag.SeverityError {
+			conns.EndOperationSpan(span, problemID(d.Detail(), d.Summary()), d.Summary())
+			return
+		}
+	}
+	conns.EndOperationSpan(span, "", "")
+}
+
+func wrapFrameworkResource(r resource.Resource) resource.Resource {
+	resp := resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "ibm"}, &resp)
+	return &wrappedFrameworkResource{Resource: r, name: resp.TypeName}
+}
+
+func (w *wrappedFrameworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := conns.StartOperationSpan(ctx, conns.Operation{Resource: w.name, Name: "create"})
+	w.Resource.Create(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "create", false)
+	endFrameworkOperationSpan(span, resp.Diagnostics)
+}
+
+func (w *wrappedFrameworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := conns.StartOperationSpan(ctx, conns.Operation{Resource: w.name, Name: "read"})
+	w.Resource.Read(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "read", false)
+	endFrameworkOperationSpan(span, resp.Diagnostics)
+}
+
+func (w *wrappedFrameworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := conns.StartOperationSpan(ctx, conns.Operation{Resource: w.name, Name: "update"})
+	w.Resource.Update(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "update", false)
+	endFrameworkOperationSpan(span, resp.Diagnostics)
+}
+
+func (w *wrappedFrameworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := conns.StartOperationSpan(ctx, conns.Operation{Resource: w.name, Name: "delete"})
+	w.Resource.Delete(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "delete", false)
+	endFrameworkOperationSpan(span, resp.Diagnostics)
+}
+
+func (w *wrappedFrameworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r, ok := w.Resource.(resource.ResourceWithConfigure); ok {
+		r.Configure(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r, ok := w.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			fmt.Sprintf("The %s resource does not support import.", w.name),
+		)
+		return
+	}
+	r.ImportState(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "import", false)
+}
+
+func (w *wrappedFrameworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r, ok := w.Resource.(resource.ResourceWithModifyPlan); ok {
+		r.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if r, ok := w.Resource.(resource.ResourceWithUpgradeState); ok {
+		return r.UpgradeState(ctx)
+	}
+	return nil
+}
+
+func (w *wrappedFrameworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r, ok := w.Resource.(resource.ResourceWithValidateConfig); ok {
+		r.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r, ok := w.Resource.(resource.ResourceWithConfigValidators); ok {
+		return r.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+type wrappedFrameworkDataSource struct {
+	datasource.DataSource
+	name string
+}
+
+func wrapFrameworkDataSource(d datasource.DataSource) datasource.DataSource {
+	resp := datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "ibm"}, &resp)
+	return &wrappedFrameworkDataSource{DataSource: d, name: resp.TypeName}
+}
+
+func (w *wrappedFrameworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := conns.StartOperationSpan(ctx, conns.Operation{Resource: w.name, Name: "read", DataSource: true})
+	w.DataSource.Read(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "read", true)
+	endFrameworkOperationSpan(span, resp.Diagnostics)
+}
+
+func (w *wrappedFrameworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if d, ok := w.DataSource.(datasource.DataSourceWithConfigure); ok {
+		d.Configure(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	if d, ok := w.DataSource.(datasource.DataSourceWithValidateConfig); ok {
+		d.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if d, ok := w.DataSource.(datasource.DataSourceWithConfigValidators); ok {
+		return d.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+type wrappedFrameworkEphemeralResource struct {
+	ephemeral.EphemeralResource
+	name string
+}
+
+func wrapFrameworkEphemeralResource(e ephemeral.EphemeralResource) ephemeral.EphemeralResource {
+	resp := ephemeral.MetadataResponse{}
+	e.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "ibm"}, &resp)
+	return &wrappedFrameworkEphemeralResource{EphemeralResource: e, name: resp.TypeName}
+}
+
+func (w *wrappedFrameworkEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := conns.StartOperationSpan(ctx, conns.Operation{Resource: w.name, Name: "open"})
+	w.EphemeralResource.Open(ctx, req, resp)
+	resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "open", false)
+	endFrameworkOperationSpan(span, resp.Diagnostics)
+}
+
+func (w *wrappedFrameworkEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	if e, ok := w.EphemeralResource.(ephemeral.EphemeralResourceWithRenew); ok {
+		e.Renew(ctx, req, resp)
+		resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "renew", false)
+	}
+}
+
+func (w *wrappedFrameworkEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if e, ok := w.EphemeralResource.(ephemeral.EphemeralResourceWithClose); ok {
+		e.Close(ctx, req, resp)
+		resp.Diagnostics = wrapFrameworkDiagnostics(resp.Diagnostics, w.name, "close", false)
+	}
+}
+
+func (w *wrappedFrameworkEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if e, ok := w.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		e.Configure(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	if e, ok := w.EphemeralResource.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		e.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (w *wrappedFrameworkEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if e, ok := w.EphemeralResource.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		return e.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+type wrappedFrameworkFunction struct {
+	function.Function
+	name string
+}
+
+func wrapFrameworkFunction(f function.Function) function.Function {
+	resp := function.MetadataResponse{}
+	f.Metadata(context.Background(), function.MetadataRequest{}, &resp)
+	return &wrappedFrameworkFunction{Function: f, name: resp.Name}
+}
+
+func (w *wrappedFrameworkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	w.Function.Run(ctx, req, resp)
+	if resp.Error == nil {
+		return
+	}
+
+	tfError := terraformProblem(errors.New(resp.Error.Text), fmt.Sprintf("provider::ibm::%s", w.name), "run", false)
+	if resp.Error.FunctionArgument != nil {
+		resp.Error = function.NewArgumentFuncError(*resp.Error.FunctionArgument, tfError.GetConsoleMessage())
+	} else {
+		resp.Error = function.NewFuncError(tfError.GetConsoleMessage())
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFrameworkProviderSchemaMatchesSDKProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	factory, err := ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The mux server reports an error diagnostic when the provider schemas of
+	// the SDKv2 and the plugin framework providers differ.
+	resp, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	if resp.Provider == nil {
+		t.Fatal("expected a provider schema")
+	}
+}
+
+func TestFrameworkProviderSchemaUnsupportedTypes(t *testing.T) {
+	cases := map[string]*schema.Schema{
+		"list of strings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"set of blocks": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+		},
+		"set of floats": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeFloat},
+		},
+	}
+	for name, s := range cases {
+		t.Run(name, func(t *testing.T) {
+			attributes, blocks, diags := frameworkProviderSchema(map[string]*schema.Schema{"endpoints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"attribute": s,
+				}},
+			}}, "")
+			if !diags.HasError() {
+				t.Fatal("expected an error diagnostic")
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "endpoints.attribute") {
+				t.Errorf("expected the diagnostic to name endpoints.attribute, got %q", detail)
+			}
+			if len(attributes) != 0 || len(blocks) != 1 {
+				t.Errorf("expected only the endpoints block, got %d attributes and %d blocks", len(attributes), len(blocks))
+			}
+		})
+	}
+}
+
+func TestFrameworkProviderSchemaSupportedTypes(t *testing.T) {
+	attributes, blocks, diags := frameworkProviderSchema(map[string]*schema.Schema{
+		"string": {Type: schema.TypeString, Optional: true},
+		"int":    {Type: schema.TypeInt, Optional: true},
+		"float":  {Type: schema.TypeFloat, Optional: true},
+		"bool":   {Type: schema.TypeBool, Optional: true},
+		"map":    {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"set":    {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"block": {Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"nested": {Type: schema.TypeString, Optional: true},
+		}}},
+	}, "")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(attributes) != 6 || len(blocks) != 1 {
+		t.Fatalf("expected 6 attributes and 1 block, got %d attributes and %d blocks", len(attributes), len(blocks))
+	}
+}
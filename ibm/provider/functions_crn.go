@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
+)
+
+var crnAttributeTypes = map[string]attr.Type{
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"scope":            types.StringType,
+	"account":          types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+var (
+	_ function.Function = &parseCRNFunction{}
+	_ function.Function = &buildCRNFunction{}
+	_ function.Function = &crnMatchesFunction{}
+)
+
+type parseCRNFunction struct{}
+
+func newParseCRNFunction() function.Function {
+	return &parseCRNFunction{}
+}
+
+func (f *parseCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_crn"
+}
+
+func (f *parseCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parses a CRN into its segments",
+		Description: "Returns an object with the version, cname, ctype, service_name, region, scope, account, service_instance, resource_type and resource segments of the given CRN. account is empty unless the CRN is scoped to an account.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: crnAttributeTypes,
+		},
+	}
+}
+
+func (f *parseCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	c, err := crn.Parse(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	value, diags := types.ObjectValue(crnAttributeTypes, map[string]attr.Value{
+		"version":          types.StringValue(c.Version),
+		"cname":            types.StringValue(c.CName),
+		"ctype":            types.StringValue(c.CType),
+		"service_name":     types.StringValue(c.ServiceName),
+		"region":           types.StringValue(c.Location),
+		"scope":            types.StringValue(c.Scope),
+		"account":          types.StringValue(c.Account()),
+		"service_instance": types.StringValue(c.ServiceInstance),
+		"resource_type":    types.StringValue(c.ResourceType),
+		"resource":         types.StringValue(c.Resource),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, value)
+}
+
+type buildCRNFunction struct{}
+
+func newBuildCRNFunction() function.Function {
+	return &buildCRNFunction{}
+}
+
+func (f *buildCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_crn"
+}
+
+func (f *buildCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds a CRN from its segments",
+		Description: "Returns the public IBM Cloud CRN (crn:v1:bluemix:public:...) of the given segments. Pass an empty string for segments that do not apply.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "service_name",
+				Description: "The name of the service, for example is.",
+			},
+			function.StringParameter{
+				Name:        "region",
+				Description: "The region, zone or global.",
+			},
+			function.StringParameter{
+				Name:        "account",
+				Description: "The ID of the account. A scope such as o/<org> is used as is.",
+			},
+			function.StringParameter{
+				Name:        "service_instance",
+				Description: "The ID of the service instance.",
+			},
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "The type of the resource.",
+			},
+			function.StringParameter{
+				Name:        "resource",
+				Description: "The ID of the resource.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serviceName, region, account, serviceInstance, resourceType, resource string
+	resp.Error = req.Arguments.Get(ctx, &serviceName, &region, &account, &serviceInstance, &resourceType, &resource)
+	if resp.Error != nil {
+		return
+	}
+
+	c := crn.New(serviceName, region, account, serviceInstance, resourceType, resource)
+	resp.Error = resp.Result.Set(ctx, c.String())
+}
+
+type crnMatchesFunction struct{}
+
+func newCRNMatchesFunction() function.Function {
+	return &crnMatchesFunction{}
+}
+
+func (f *crnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_matches"
+}
+
+func (f *crnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether a CRN matches a pattern",
+		Description: "Returns true if every segment of the CRN matches the corresponding segment of the pattern. An empty pattern segment matches any value, and * matches any characters within a segment.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to check.",
+			},
+			function.StringParameter{
+				Name:        "pattern",
+				Description: "The pattern, for example crn:v1:bluemix:public:is:us-*:::vpc:.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *crnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s, pattern string
+	resp.Error = req.Arguments.Get(ctx, &s, &pattern)
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := crn.Parse(s); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	matched, err := crn.Match(s, pattern)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, matched)
+}
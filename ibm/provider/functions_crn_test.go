@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testVpcCRN = "crn:v1:bluemix:public:is:us-south:a/0123456789abcdef::vpc:r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"
+
+func testRunFunction(f function.Function, result attr.Value, arguments ...string) function.RunResponse {
+	values := make([]attr.Value, 0, len(arguments))
+	for _, argument := range arguments {
+		values = append(values, types.StringValue(argument))
+	}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(values)}, &resp)
+	return resp
+}
+
+func TestParseCRNFunction(t *testing.T) {
+	resp := testRunFunction(newParseCRNFunction(), types.ObjectUnknown(crnAttributeTypes), testVpcCRN)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	expected, diags := types.ObjectValue(crnAttributeTypes, map[string]attr.Value{
+		"version":          types.StringValue("v1"),
+		"cname":            types.StringValue("bluemix"),
+		"ctype":            types.StringValue("public"),
+		"service_name":     types.StringValue("is"),
+		"region":           types.StringValue("us-south"),
+		"scope":            types.StringValue("a/0123456789abcdef"),
+		"account":          types.StringValue("0123456789abcdef"),
+		"service_instance": types.StringValue(""),
+		"resource_type":    types.StringValue("vpc"),
+		"resource":         types.StringValue("r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+}
+
+func TestParseCRNFunctionOtherScope(t *testing.T) {
+	resp := testRunFunction(newParseCRNFunction(), types.ObjectUnknown(crnAttributeTypes), "crn:v1:bluemix:public:cloudantnosqldb:us-south:o/org-id:instance-id::")
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	attributes := resp.Result.Value().(types.Object).Attributes()
+	if scope := attributes["scope"].(types.String).ValueString(); scope != "o/org-id" {
+		t.Errorf("expected scope o/org-id, got %q", scope)
+	}
+	if account := attributes["account"].(types.String).ValueString(); account != "" {
+		t.Errorf("expected an empty account, got %q", account)
+	}
+}
+
+func TestParseCRNFunctionInvalid(t *testing.T) {
+	for _, s := range []string{"", "not-a-crn", "crn:v1:bluemix:public:is:us-south", "arn:aws:iam::123456789012:user/name"} {
+		t.Run(s, func(t *testing.T) {
+			resp := testRunFunction(newParseCRNFunction(), types.ObjectUnknown(crnAttributeTypes), s)
+			if resp.Error == nil {
+				t.Fatal("expected an error")
+			}
+			if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+				t.Errorf("expected the error to be reported on the crn argument, got %v", resp.Error.FunctionArgument)
+			}
+		})
+	}
+}
+
+func TestBuildCRNFunction(t *testing.T) {
+	cases := []struct {
+		name      string
+		arguments []string
+		expected  string
+	}{
+		{
+			name:      "account scope",
+			arguments: []string{"is", "us-south", "0123456789abcdef", "", "vpc", "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"},
+			expected:  testVpcCRN,
+		},
+		{
+			name:      "other scope",
+			arguments: []string{"cloudantnosqldb", "us-south", "o/org-id", "instance-id", "", ""},
+			expected:  "crn:v1:bluemix:public:cloudantnosqldb:us-south:o/org-id:instance-id::",
+		},
+		{
+			name:      "empty segments",
+			arguments: []string{"globalcatalog", "global", "", "", "", ""},
+			expected:  "crn:v1:bluemix:public:globalcatalog:global::::",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := testRunFunction(newBuildCRNFunction(), types.StringUnknown(), c.arguments...)
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.StringValue(c.expected)) {
+				t.Errorf("expected %q, got %s", c.expected, resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestBuildCRNFunctionRoundTrip(t *testing.T) {
+	resp := testRunFunction(newParseCRNFunction(), types.ObjectUnknown(crnAttributeTypes), testVpcCRN)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	attributes := resp.Result.Value().(types.Object).Attributes()
+	arguments := []string{}
+	for _, name := range []string{"service_name", "region", "account", "service_instance", "resource_type", "resource"} {
+		arguments = append(arguments, attributes[name].(types.String).ValueString())
+	}
+
+	resp = testRunFunction(newBuildCRNFunction(), types.StringUnknown(), arguments...)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if !resp.Result.Value().Equal(types.StringValue(testVpcCRN)) {
+		t.Errorf("expected %q, got %s", testVpcCRN, resp.Result.Value())
+	}
+}
+
+func TestCRNMatchesFunction(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		expected bool
+	}{
+		{testVpcCRN, true},
+		{"crn:v1:bluemix:public:is:us-south:::vpc:", true},
+		{"crn:v1:bluemix:public:is:us-*:::vpc:", true},
+		{"crn:v1:bluemix:public:is:*:::*:r006-*", true},
+		{"crn:::::::::", true},
+		{"crn:v1:bluemix:public:is:eu-*:::vpc:", false},
+		{"crn:v1:bluemix:public:is:us-south:::subnet:", false},
+		{"crn:v1:bluemix:public:iam:us-south:::vpc:", false},
+		{"crn:v1:bluemix:public:is:us-south:a/other-account::vpc:", false},
+	}
+	for _, c := range cases {
+		t.Run(c.pattern, func(t *testing.T) {
+			resp := testRunFunction(newCRNMatchesFunction(), types.BoolUnknown(), testVpcCRN, c.pattern)
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.BoolValue(c.expected)) {
+				t.Errorf("expected %t, got %s", c.expected, resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestCRNMatchesFunctionInvalid(t *testing.T) {
+	cases := []struct {
+		name     string
+		crn      string
+		pattern  string
+		argument int64
+	}{
+		{"invalid crn", "not-a-crn", "crn:v1:bluemix:public:is:us-south:::vpc:", 0},
+		{"invalid pattern", testVpcCRN, "crn:v1:bluemix", 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := testRunFunction(newCRNMatchesFunction(), types.BoolUnknown(), c.crn, c.pattern)
+			if resp.Error == nil {
+				t.Fatal("expected an error")
+			}
+			if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != c.argument {
+				t.Errorf("expected the error to be reported on argument %d, got %v", c.argument, resp.Error.FunctionArgument)
+			}
+		})
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -237,6 +239,62 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Private and public regional endpoints of the services, merged over the endpoints file",
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(),
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy of the API calls, with optional overrides per service",
+				Elem: &schema.Resource{
+					Schema: retrySchema(true),
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Client-side rate limit of the requests sent to each API host",
+				Elem: &schema.Resource{
+					Schema: rateLimitSchema(true),
+				},
+			},
+			"http_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the file that a JSON line is appended to for every API call, with secrets redacted",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_HTTP_TRACE_FILE", "IBMCLOUD_HTTP_TRACE_FILE"}, nil),
+			},
+			"http_trace_bodies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the HTTP trace records the request and response bodies, with secrets redacted",
+			},
+			"default_deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether Terraform will be prevented from destroying resources that do not set deletion_protection",
+			},
+			"deletion_protection_resource_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The resource types that default_deletion_protection applies to. It applies to all resource types when not set",
+			},
+			"vpc_quota_preflight": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"off", "warn", "error"}, false),
+				Description:  "What the plans of VPC resources do when they exceed the quotas of the account: error (the default), warn or off",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -526,6 +584,7 @@ func Provider() *schema.Provider {
 			"ibm_is_instance_profiles":               vpc.DataSourceIBMISInstanceProfiles(),
 			"ibm_is_instance":                        vpc.DataSourceIBMISInstance(),
 			"ibm_is_instances":                       vpc.DataSourceIBMISInstances(),
+			"ibm_is_instance_console_log":            vpc.DataSourceIBMISInstanceConsoleLog(),
 			"ibm_is_instance_network_attachment":     vpc.DataSourceIBMIsInstanceNetworkAttachment(),
 			"ibm_is_instance_network_attachments":    vpc.DataSourceIBMIsInstanceNetworkAttachments(),
 			"ibm_is_instance_network_interface":      vpc.DataSourceIBMIsInstanceNetworkInterface(),
@@ -565,6 +624,7 @@ func Provider() *schema.Provider {
 			"ibm_is_private_path_service_gateway_endpoint_gateway_bindings": vpc.DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindings(),
 			"ibm_is_public_gateway":              vpc.DataSourceIBMISPublicGateway(),
 			"ibm_is_public_gateways":             vpc.DataSourceIBMISPublicGateways(),
+			"ibm_is_reachability":                vpc.DataSourceIBMISReachability(),
 			"ibm_is_region":                      vpc.DataSourceIBMISRegion(),
 			"ibm_is_regions":                     vpc.DataSourceIBMISRegions(),
 			"ibm_is_reservation":                 vpc.DataSourceIBMIsReservation(),
@@ -619,12 +679,14 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateway":                 vpc.DataSourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateways":                vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":        vpc.DataSourceIbmIsVpcAddressPrefixes(),
+			"ibm_is_vpc_import_blocks":           vpc.DataSourceIBMISVPCImportBlocks(),
 			"ibm_is_vpc_address_prefix":          vpc.DataSourceIBMIsVPCAddressPrefix(),
 			"ibm_is_vpn_gateway_connection":      vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":     vpc.DataSourceIBMISVPNGatewayConnections(),
 
-			"ibm_is_vpn_gateway_connection_local_cidrs": vpc.DataSourceIBMIsVPNGatewayConnectionLocalCidrs(),
-			"ibm_is_vpn_gateway_connection_peer_cidrs":  vpc.DataSourceIBMIsVPNGatewayConnectionPeerCidrs(),
+			"ibm_is_vpn_gateway_connection_local_cidrs":   vpc.DataSourceIBMIsVPNGatewayConnectionLocalCidrs(),
+			"ibm_is_vpn_gateway_connection_peer_cidrs":    vpc.DataSourceIBMIsVPNGatewayConnectionPeerCidrs(),
+			"ibm_is_vpn_gateway_connection_tunnel_status": vpc.DataSourceIBMISVPNGatewayConnectionTunnelStatus(),
 
 			"ibm_is_vpc_default_routing_table":       vpc.DataSourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_table":               vpc.DataSourceIBMIsVPCRoutingTable(),
@@ -1355,6 +1417,8 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_network_interface_floating_ip": vpc.ResourceIBMIsVirtualNetworkInterfaceFloatingIP(),
 			"ibm_is_virtual_network_interface_ip":          vpc.ResourceIBMIsVirtualNetworkInterfaceIP(),
 			"ibm_is_snapshot_consistency_group":            vpc.ResourceIBMIsSnapshotConsistencyGroup(),
+			"ibm_is_snapshot_consistency_group_restore":    vpc.ResourceIBMISSnapshotConsistencyGroupRestore(),
+			"ibm_is_snapshot_copy":                         vpc.ResourceIBMISSnapshotCopy(),
 			"ibm_is_volume":                                vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                           vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                vpc.ResourceIBMISVPNGatewayConnection(),
@@ -1449,6 +1513,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
+			"ibm_pi_instance_migration":              power.ResourceIBMPIInstanceMigration(),
 			"ibm_pi_instance_snapshot":               power.ResourceIBMPIInstanceSnapshot(),
 			"ibm_pi_ipsec_policy":                    power.ResourceIBMPIIPSecPolicy(),
 			"ibm_pi_key":                             power.ResourceIBMPIKey(),
@@ -1562,6 +1627,7 @@ func Provider() *schema.Provider {
 			// satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
+			"ibm_satellite_host_attach":                         satellite.ResourceIBMSatelliteHostAttach(),
 			"ibm_satellite_cluster":                             satellite.ResourceIBMSatelliteCluster(),
 			"ibm_satellite_cluster_worker_pool":                 satellite.ResourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.ResourceIBMSatelliteLink(),
@@ -1767,8 +1833,8 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 }
 
 func wrapResource(name string, resource *schema.Resource) *schema.Resource {
-	return &schema.Resource{
-		Schema:               resource.Schema,
+	wrappedResource := &schema.Resource{
+		Schema:               withDeletionProtection(resource.Schema),
 		SchemaVersion:        resource.SchemaVersion,
 		MigrateState:         resource.MigrateState,
 		StateUpgraders:       resource.StateUpgraders,
@@ -1788,6 +1854,39 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		Description:          resource.Description,
 		UseJSONNumber:        resource.UseJSONNumber,
 	}
+
+	// deletion_protection is updated in place, so resources that cannot be
+	// updated get an update that only stores it in state.
+	if wrappedResource.UpdateContext == nil && wrappedResource.UpdateWithoutTimeout == nil {
+		wrappedResource.UpdateContext = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		}
+	}
+
+	return wrappedResource
+}
+
+// withDeletionProtection returns a copy of resourceSchema with the
+// deletion_protection attribute that is enforced by wrapFunction. It is left
+// unset rather than defaulted so that the provider default_deletion_protection
+// applies when it is not configured.
+func withDeletionProtection(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	if resourceSchema == nil {
+		return nil
+	}
+
+	wrappedSchema := make(map[string]*schema.Schema, len(resourceSchema)+1)
+	for key, value := range resourceSchema {
+		wrappedSchema[key] = value
+	}
+	if _, ok := wrappedSchema[flex.DeletionProtection]; !ok {
+		wrappedSchema[flex.DeletionProtection] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether Terraform will be prevented from destroying the resource. Defaults to the provider default_deletion_protection",
+		}
+	}
+	return wrappedSchema
 }
 
 func wrapDataSource(name string, resource *schema.Resource) *schema.Resource {
@@ -1813,37 +1912,100 @@ func wrapFunction(
 	fallback func(*schema.ResourceData, interface{}) error,
 	isDataSource bool,
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
-	if function != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if function == nil && fallback != nil {
+		function = func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
+		}
+	}
+	if function == nil {
+		return nil
+	}
 
-			// only allow deletion if the resource is not marked as protected
-			if operationName == "delete" && schema.Get("deletion_protection") != nil {
-				// we check the value in state, not current config. Current config will always be null for a delete
-
-				if schema.Get("deletion_protection") == true {
-					log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
-					var diags diag.Diagnostics
-					summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", schema.Get("name"))
-					return append(
-						diags,
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  summary,
-							Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
-						},
-					)
-				}
-			}
+	// API calls and waits made with the context are attributed to the operation
+	operation := conns.Operation{Resource: resourceName, Name: operationName, DataSource: isDataSource}
+	withOperation := function
+	function = func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// and so are the calls made without it, while the operation runs
+		defer conns.TrackOperation(operation)()
+		context, span := conns.StartOperationSpan(context, operation)
+		diags := withOperation(context, schema, meta)
+		errorID, errorSummary := diagnosticsError(diags)
+		conns.EndOperationSpan(span, errorID, errorSummary)
+		return diags
+	}
+	if isDataSource {
+		return function
+	}
 
+	switch operationName {
+	case "update":
+		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			// deletion_protection only lives in state, there is nothing to update
+			if schema.HasChange(flex.DeletionProtection) && !schema.HasChangesExcept(flex.DeletionProtection) {
+				return nil
+			}
 			return function(context, schema, meta)
 		}
-	} else if fallback != nil {
+	case "delete":
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
+			// only allow deletion if the resource is not marked as protected
+			if deletionProtected(resourceName, schema, meta) {
+				log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
+				var diags diag.Diagnostics
+				summary := fmt.Sprintf("Deletion protection is enabled for %s %s to prevent accidential deletion", resourceName, schema.Id())
+				return append(
+					diags,
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  summary,
+						Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
+					},
+				)
+			}
+			return function(context, schema, meta)
+		}
+	}
+
+	return function
+}
+
+// deletionProtected reports whether the resource may not be deleted. We check
+// the value in state, not current config. Current config will always be null
+// for a delete. Resources that do not set deletion_protection fall back to the
+// provider default_deletion_protection.
+func deletionProtected(resourceName string, d *schema.ResourceData, meta interface{}) bool {
+	if v, ok := d.GetOkExists(flex.DeletionProtection); ok {
+		return v.(bool)
+	}
+	if session, ok := meta.(conns.ClientSession); ok {
+		return session.DeletionProtectionDefault(resourceName)
+	}
+	return false
+}
+
+// diagnosticsError returns the ID and summary of the first error of diags.
+// The ID is the one of the TerraformProblem in the console message of the
+// error, if any.
+func diagnosticsError(diags diag.Diagnostics) (string, string) {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return problemID(d.Detail, d.Summary), d.Summary
 		}
 	}
+	return "", ""
+}
 
-	return nil
+// problemID returns the ID of the TerraformProblem whose console message is
+// one of messages.
+func problemID(messages ...string) string {
+	for _, message := range messages {
+		for _, line := range strings.Split(message, "\n") {
+			if id, ok := strings.CutPrefix(strings.TrimSpace(line), "id: "); ok {
+				return id
+			}
+		}
+	}
+	return ""
 }
 
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
@@ -1852,7 +2014,20 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	}
 
 	var diags diag.Diagnostics
+	tfError := terraformProblem(err, resourceName, operationName, isDataSource)
+	return append(
+		diags,
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  tfError.Error(),
+			Detail:   tfError.GetConsoleMessage(),
+		},
+	)
+}
 
+// terraformProblem converts err to a TerraformProblem attributed to the given
+// resource and operation, and logs its debug message.
+func terraformProblem(err error, resourceName, operationName string, isDataSource bool) *flex.TerraformProblem {
 	// Distinguish data sources from resources. Data sources technically are resources but
 	// they may have the same names and we need to tell them apart.
 	if isDataSource {
@@ -1868,14 +2043,7 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	}
 
 	log.Printf("[DEBUG] %s", tfError.GetDebugMessage())
-	return append(
-		diags,
-		diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  tfError.Error(),
-			Detail:   tfError.GetConsoleMessage(),
-		},
-	)
+	return tfError
 }
 
 func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
@@ -2420,6 +2588,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		os.Setenv("FUNCTION_NAMESPACE", wskNameSpace)
 	}
 
+	retryPolicy, retryServices := expandRetry(d, retryCount)
+	rateLimit, hostRateLimits := expandRateLimit(d)
+
 	config := conns.Config{
 		BluemixAPIKey:        bluemixAPIKey,
 		Region:               region,
@@ -2428,9 +2599,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
 		SoftLayerUserName:    softlayerUsername,
 		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
@@ -2439,8 +2608,226 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		PrivateEndpointType:  privateEndpointType,
 		EndpointsFile:        file,
+		Endpoints:            expandEndpoints(d),
 		IAMTrustedProfileID:  iamTrustedProfileId,
+
+		Retry:                           retryPolicy,
+		RetryServices:                   retryServices,
+		RateLimit:                       rateLimit,
+		HostRateLimits:                  hostRateLimits,
+		HTTPTraceFile:                   d.Get("http_trace_file").(string),
+		HTTPTraceBodies:                 d.Get("http_trace_bodies").(bool),
+		DefaultDeletionProtection:       d.Get("default_deletion_protection").(bool),
+		DeletionProtectionResourceTypes: flex.ExpandStringList(d.Get("deletion_protection_resource_types").(*schema.Set).List()),
+		VPCQuotaPreflight:               d.Get("vpc_quota_preflight").(string),
 	}
 
 	return config.ClientSession()
 }
+
+// retrySchema returns the attributes of the retry block when withServices is
+// true, and of its service blocks otherwise. Unset attributes fall back to
+// the enclosing block and then to conns.DefaultRetryPolicy.
+func retrySchema(withServices bool) map[string]*schema.Schema {
+	retry := map[string]*schema.Schema{
+		"max_retries": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of retries of an API call. Defaults to max_retries",
+		},
+		"min_delay": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The delay (in seconds) before the first retry. It doubles with every retry. Defaults to 1",
+		},
+		"max_delay": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum delay (in seconds) between retries. Defaults to 30",
+		},
+		"jitter": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether delays are randomized between half and all of their value. Defaults to true",
+		},
+		"retryable_status_codes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Description: "The HTTP status codes that are retried. Defaults to 429, 500, 502, 503 and 504",
+		},
+	}
+	if withServices {
+		retry["service"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Retry policy of a single service",
+			Elem: &schema.Resource{
+				Schema: retrySchema(false),
+			},
+		}
+	} else {
+		retry["name"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ValidateAllowedStringValues(conns.RetryServiceNames),
+			Description:  "The name of the service",
+		}
+	}
+	return retry
+}
+
+// expandRetry returns the retry policy of the provider and the policies of
+// the services in the retry block.
+func expandRetry(d *schema.ResourceData, maxRetries int) (conns.RetryPolicy, map[string]conns.RetryPolicy) {
+	policy := expandRetryPolicy(d, "retry.0", conns.DefaultRetryPolicy(maxRetries))
+
+	services := map[string]conns.RetryPolicy{}
+	for i, v := range d.Get("retry.0.service").([]interface{}) {
+		service := v.(map[string]interface{})
+		prefix := fmt.Sprintf("retry.0.service.%d", i)
+		services[service["name"].(string)] = expandRetryPolicy(d, prefix, policy)
+		if service["name"] == "softlayer" {
+			// The SoftLayer session retries on its own, see conns.newSession
+			for _, key := range []string{"max_delay", "jitter", "retryable_status_codes"} {
+				if _, ok := d.GetOkExists(prefix + "." + key); ok {
+					log.Printf("[WARN] retry.service.%s is ignored for softlayer, which only honours max_retries and min_delay", key)
+				}
+			}
+		}
+	}
+	return policy, services
+}
+
+func expandRetryPolicy(d *schema.ResourceData, prefix string, policy conns.RetryPolicy) conns.RetryPolicy {
+	// GetOkExists tells an explicit 0 or false from an unset attribute
+	if v, ok := d.GetOkExists(prefix + ".max_retries"); ok {
+		policy.MaxRetries = v.(int)
+	}
+	if v, ok := d.GetOkExists(prefix + ".min_delay"); ok {
+		policy.MinDelay = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := d.GetOkExists(prefix + ".max_delay"); ok {
+		policy.MaxDelay = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := d.GetOkExists(prefix + ".jitter"); ok {
+		policy.Jitter = v.(bool)
+	}
+	if v, ok := d.GetOk(prefix + ".retryable_status_codes"); ok {
+		statusCodes := []int{}
+		for _, code := range v.(*schema.Set).List() {
+			statusCodes = append(statusCodes, code.(int))
+		}
+		policy.RetryableStatusCodes = statusCodes
+	}
+	return policy
+}
+
+// rateLimitSchema returns the attributes of the rate_limit block when
+// withHosts is true, and of its host blocks otherwise.
+func rateLimitSchema(withHosts bool) map[string]*schema.Schema {
+	rateLimit := map[string]*schema.Schema{
+		"requests_per_second": {
+			Type:         schema.TypeFloat,
+			Optional:     withHosts,
+			Required:     !withHosts,
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "The number of requests per second sent to an API host. 0 means no limit",
+		},
+		"burst": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The number of requests that can be sent at once before the rate applies. Defaults to 1",
+		},
+	}
+	if withHosts {
+		rateLimit["host"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Rate limit of a single API host",
+			Elem: &schema.Resource{
+				Schema: rateLimitSchema(false),
+			},
+		}
+	} else {
+		rateLimit["name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the API host, for example us-south.iaas.cloud.ibm.com",
+		}
+	}
+	return rateLimit
+}
+
+// expandRateLimit returns the default rate limit of the API hosts and the
+// rate limits of the hosts in the rate_limit block.
+func expandRateLimit(d *schema.ResourceData) (conns.RateLimit, map[string]conns.RateLimit) {
+	rateLimit := conns.RateLimit{
+		RequestsPerSecond: d.Get("rate_limit.0.requests_per_second").(float64),
+		Burst:             d.Get("rate_limit.0.burst").(int),
+	}
+
+	hostRateLimits := map[string]conns.RateLimit{}
+	for _, v := range d.Get("rate_limit.0.host").([]interface{}) {
+		host := v.(map[string]interface{})
+		hostRateLimits[host["name"].(string)] = conns.RateLimit{
+			RequestsPerSecond: host["requests_per_second"].(float64),
+			Burst:             host["burst"].(int),
+		}
+	}
+	return rateLimit, hostRateLimits
+}
+
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := map[string]*schema.Schema{}
+	for _, key := range conns.EndpointKeys {
+		endpoints[conns.EndpointAttribute(key)] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("Regional endpoints for %s", key),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"public": {
+						Type:        schema.TypeMap,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Public endpoints keyed by region",
+					},
+					"private": {
+						Type:        schema.TypeMap,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Private endpoints keyed by region",
+					},
+				},
+			},
+		}
+	}
+	return endpoints
+}
+
+// expandEndpoints converts the endpoints block to the endpoints file format.
+func expandEndpoints(d *schema.ResourceData) map[string]interface{} {
+	endpoints := map[string]interface{}{}
+	for _, key := range conns.EndpointKeys {
+		v, ok := d.GetOk(fmt.Sprintf("endpoints.0.%s.0", conns.EndpointAttribute(key)))
+		if !ok {
+			continue
+		}
+		visibilities := map[string]interface{}{}
+		for visibility, regions := range v.(map[string]interface{}) {
+			if len(regions.(map[string]interface{})) > 0 {
+				visibilities[visibility] = regions
+			}
+		}
+		if len(visibilities) > 0 {
+			endpoints[key] = visibilities
+		}
+	}
+	return endpoints
+}
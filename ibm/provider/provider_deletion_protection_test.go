@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The provider default_deletion_protection only applies to resources that do
+// not set deletion_protection, so no resource may default it.
+func TestResourceDeletionProtectionHasNoDefault(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		s, ok := resource.Schema[flex.DeletionProtection]
+		if ok && (s.Default != nil || s.DefaultFunc != nil) {
+			t.Errorf("%s: expected %s to have no default", name, flex.DeletionProtection)
+		}
+	}
+}
+
+func TestDeletionProtected(t *testing.T) {
+	resource := Provider().ResourcesMap["ibm_database"]
+	cases := []struct {
+		name     string
+		state    map[string]interface{}
+		expected bool
+	}{
+		{"unset", map[string]interface{}{}, false},
+		{"enabled", map[string]interface{}{flex.DeletionProtection: true}, true},
+		{"disabled", map[string]interface{}{flex.DeletionProtection: false}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resource.Schema, c.state)
+			if protected := deletionProtected("ibm_database", d, nil); protected != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, protected)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package catalogmanagement
 
 import (
+	"context"
 	"log"
 	"os"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,12 +27,12 @@ const (
 
 func ResourceIBMCmOfferingInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmOfferingInstanceCreate,
-		Read:     resourceIBMCmOfferingInstanceRead,
-		Update:   resourceIBMCmOfferingInstanceUpdate,
-		Delete:   resourceIBMCmOfferingInstanceDelete,
-		Exists:   resourceIBMCmOfferingInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmOfferingInstanceCreate,
+		ReadContext:   resourceIBMCmOfferingInstanceRead,
+		UpdateContext: resourceIBMCmOfferingInstanceUpdate,
+		DeleteContext: resourceIBMCmOfferingInstanceDelete,
+		Exists:        resourceIBMCmOfferingInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(4 * time.Minute),
@@ -136,15 +138,15 @@ func ResourceIBMCmOfferingInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createOfferingInstanceOptions := &catalogmanagementv1.CreateOfferingInstanceOptions{}
@@ -201,24 +203,24 @@ func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{
 	offeringInstance, response, err := catalogManagementClient.CreateOfferingInstance(createOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*offeringInstance.ID)
 
 	if d.Get("wait_until_successful").(bool) {
-		if _, err = waitUntilSuccess(d, meta); err != nil {
+		if _, err = waitUntilSuccess(ctx, d, meta); err != nil {
 			log.Print(err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	log.Printf("LOG2 Service version instance of type %q was created on cluster %q", *createOfferingInstanceOptions.KindFormat, *createOfferingInstanceOptions.ClusterID)
 
-	return resourceIBMCmOfferingInstanceRead(d, meta)
+	return resourceIBMCmOfferingInstanceRead(ctx, d, meta)
 }
 
-func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitUntilSuccess(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
 		return nil, err
@@ -243,13 +245,13 @@ func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, er
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
@@ -263,67 +265,67 @@ func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("url", offeringInstance.URL); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting url: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting url: %s", err))
 	}
 	if err = d.Set("crn", offeringInstance.CRN); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting crn: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting crn: %s", err))
 	}
 	if err = d.Set("label", offeringInstance.Label); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting label: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting label: %s", err))
 	}
 	if err = d.Set("catalog_id", offeringInstance.CatalogID); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting catalog_id: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting catalog_id: %s", err))
 	}
 	if err = d.Set("offering_id", offeringInstance.OfferingID); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting offering_id: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting offering_id: %s", err))
 	}
 	if err = d.Set("kind_format", offeringInstance.KindFormat); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting kind_format: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting kind_format: %s", err))
 	}
 	if err = d.Set("version", offeringInstance.Version); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting version: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting version: %s", err))
 	}
 	if err = d.Set("cluster_id", offeringInstance.ClusterID); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting cluster_id: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting cluster_id: %s", err))
 	}
 	if err = d.Set("cluster_region", offeringInstance.ClusterRegion); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting cluster_region: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting cluster_region: %s", err))
 	}
 	if offeringInstance.ClusterNamespaces != nil {
 		if err = d.Set("cluster_namespaces", offeringInstance.ClusterNamespaces); err != nil {
-			return flex.FmtErrorf("[ERROR] Error setting cluster_namespaces: %s", err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting cluster_namespaces: %s", err))
 		}
 	}
 	if err = d.Set("cluster_all_namespaces", offeringInstance.ClusterAllNamespaces); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting cluster_all_namespaces: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting cluster_all_namespaces: %s", err))
 	}
 	if err = d.Set("schematics_workspace_id", offeringInstance.SchematicsWorkspaceID); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting schematics_workspace_id: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting schematics_workspace_id: %s", err))
 	}
 	if err = d.Set("install_plan", offeringInstance.InstallPlan); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting install_plan: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting install_plan: %s", err))
 	}
 	if err = d.Set("channel", offeringInstance.Channel); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting channel: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting channel: %s", err))
 	}
 	if err = d.Set("plan_id", offeringInstance.PlanID); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting plan_id: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting plan_id: %s", err))
 	}
 	if err = d.Set("parent_crn", offeringInstance.ParentCRN); err != nil {
-		return flex.FmtErrorf("[ERROR] Error setting parent_crn: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error setting parent_crn: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
@@ -333,12 +335,12 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Failed to retrieve rev %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	putOfferingInstanceOptions := &catalogmanagementv1.PutOfferingInstanceOptions{}
@@ -399,20 +401,20 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 	_, response, err = catalogManagementClient.PutOfferingInstance(putOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMCmOfferingInstanceRead(d, meta)
+	return resourceIBMCmOfferingInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	deleteOfferingInstanceOptions := &catalogmanagementv1.DeleteOfferingInstanceOptions{}
@@ -423,7 +425,7 @@ func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{
 	response, err := catalogManagementClient.DeleteOfferingInstance(deleteOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,12 +37,12 @@ const (
 
 func ResourceIBMCISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISInstanceCreate,
-		Read:     ResourceIBMCISInstanceRead,
-		Update:   ResourceIBMCISInstanceUpdate,
-		Delete:   ResourceIBMCISInstanceDelete,
-		Exists:   ResourceIBMCISInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISInstanceCreate,
+		ReadContext:   ResourceIBMCISInstanceRead,
+		UpdateContext: ResourceIBMCISInstanceUpdate,
+		DeleteContext: ResourceIBMCISInstanceDelete,
+		Exists:        ResourceIBMCISInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -166,11 +167,11 @@ func ResourceIBMCISValidator() *validate.ResourceValidator {
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "internet-svcs"
-func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceName := "internet-svcs"
 	plan := d.Get("plan").(string)
@@ -183,27 +184,27 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterCISDeployments(deployments, location)
 
@@ -212,7 +213,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList))
 	}
 
 	rsInst.Target = &deployments[0].CatalogCRN
@@ -223,7 +224,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		defaultRg, err := flex.DefaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
@@ -234,7 +235,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response))
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
@@ -249,21 +250,21 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForCISInstanceCreate
 
-	_, err = waitForCISInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForCISInstanceCreate(ctx, d, meta, *instance.ID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	d.SetId(*instance.ID)
 
-	return ResourceIBMCISInstanceRead(d, meta)
+	return ResourceIBMCISInstanceRead(ctx, d, meta)
 }
 
-func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -278,7 +279,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response))
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -305,7 +306,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -313,7 +314,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
@@ -324,18 +325,18 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, rcontroller+"/internet-svcs/"+url.QueryEscape(*instance.CRN))
 
 	return nil
 }
 
-func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -353,18 +354,18 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 		}
 
 		updateReq.ResourcePlanID = &servicePlan
@@ -382,22 +383,22 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
 	}
 
-	_, err = waitForCISInstanceUpdate(d, meta)
+	_, err = waitForCISInstanceUpdate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return ResourceIBMCISInstanceRead(d, meta)
+	return ResourceIBMCISInstanceRead(ctx, d, meta)
 }
 
-func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	recursive := true
@@ -414,13 +415,13 @@ func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n %s", err, response)
 			err = nil
 		} else {
-			return fmt.Errorf("[ERROR] Error deleting resource instance: %s %s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s %s", err, response))
 		}
 	}
 
-	_, err = waitForCISInstanceDelete(d, meta)
+	_, err = waitForCISInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -455,7 +456,7 @@ func ResourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 	return *instance.ID == instanceID, nil
 }
 
-func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForCISInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -487,10 +488,10 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -522,10 +523,10 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -556,7 +557,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func filterCISDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
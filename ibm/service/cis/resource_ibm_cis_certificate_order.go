@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,12 +30,12 @@ const (
 
 func ResourceIBMCISCertificateOrder() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISCertificateOrderCreate,
-		Update:   ResourceIBMCISCertificateOrderRead,
-		Read:     ResourceIBMCISCertificateOrderRead,
-		Delete:   ResourceIBMCISCertificateOrderDelete,
-		Exists:   ResourceIBMCISCertificateOrderExist,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISCertificateOrderCreate,
+		UpdateContext: ResourceIBMCISCertificateOrderRead,
+		ReadContext:   ResourceIBMCISCertificateOrderRead,
+		DeleteContext: ResourceIBMCISCertificateOrderDelete,
+		Exists:        ResourceIBMCISCertificateOrderExist,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -100,10 +102,10 @@ func ResourceIBMCISCertificateOrderValidator() *validate.ResourceValidator {
 	return &cisCertificateOrderValidator
 }
 
-func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISCertificateOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -120,22 +122,22 @@ func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	result, resp, err := cisClient.OrderCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate order failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return ResourceIBMCISCertificateOrderRead(d, meta)
+	return ResourceIBMCISCertificateOrderRead(ctx, d, meta)
 }
 
-func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISCertificateOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certificateID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -143,7 +145,7 @@ func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	result, resp, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate read failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -154,15 +156,15 @@ func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func ResourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISCertificateOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	certificateID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -170,12 +172,12 @@ func ResourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface
 	resp, err := cisClient.DeleteCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate delete failed: %v", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = waitForCISCertificateOrderDelete(d, meta)
+	_, err = waitForCISCertificateOrderDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -206,7 +208,7 @@ func ResourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{
 	return true, nil
 }
 
-func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -238,5 +240,5 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cissslv1 "github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,12 +41,12 @@ const (
 
 func ResourceIBMCISCertificateUpload() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceCISCertificateUploadCreate,
-		Read:     resourceCISCertificateUploadRead,
-		Update:   resourceCISCertificateUploadUpdate,
-		Delete:   resourceCISCertificateUploadDelete,
-		Exists:   resourceCISCertificateUploadExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISCertificateUploadCreate,
+		ReadContext:   resourceCISCertificateUploadRead,
+		UpdateContext: resourceCISCertificateUploadUpdate,
+		DeleteContext: resourceCISCertificateUploadDelete,
+		Exists:        resourceCISCertificateUploadExists,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -155,10 +157,10 @@ func ResourceIBMCISCertificateUploadValidator() *validate.ResourceValidator {
 	return &cisCertificateUploadValidator
 }
 
-func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -177,7 +179,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 	result, response, err := cisClient.UploadCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Upload custom certificate failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	certID := *result.Result.ID
 	d.SetId(flex.ConvertCisToTfThreeVar(certID, zoneID, crn))
@@ -195,21 +197,21 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 		priorityResponse, err := cisClient.ChangeCertificatePriority(priorityOpt)
 		if err != nil {
 			log.Printf("Change certificate priority failed: %v", priorityResponse)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceCISCertificateUploadRead(d, meta)
+	return resourceCISCertificateUploadRead(ctx, d, meta)
 }
-func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -218,7 +220,7 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	result, response, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Get custom certificate failed: %v", response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -234,15 +236,15 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	d.Set(cisCertificateUploadExpiresOn, result.Result.ExpiresOn)
 	return nil
 }
-func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -258,7 +260,7 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.UpdateCustomCertificate(opt)
 		if err != nil {
 			log.Printf("Update custom certificate failed: %v", response)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -276,22 +278,22 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 			_, err := cisClient.ChangeCertificatePriority(priorityOpt)
 			if err != nil {
 				log.Printf("Change certificate priority failed: %v", err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
-	return resourceCISCertificateUploadRead(d, meta)
+	return resourceCISCertificateUploadRead(ctx, d, meta)
 }
 
-func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certID, zoneID, crn, _ := flex.ConvertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -299,11 +301,11 @@ func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}
 	_, err = cisClient.DeleteCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Delete custom certificate failed: %v", err)
-		return err
+		return diag.FromErr(err)
 	}
-	_, err = waitForCISCertificateUploadDelete(d, meta)
+	_, err = waitForCISCertificateUploadDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -334,7 +336,7 @@ func resourceCISCertificateUploadExists(d *schema.ResourceData, meta interface{}
 	return true, nil
 }
 
-func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateUploadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -367,5 +369,5 @@ func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -25,12 +27,12 @@ var dedicatedHostPackageType = "DEDICATED_HOST"
 
 func ResourceIBMComputeDedicatedHost() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeDedicatedHostCreate,
-		Read:     resourceIBMComputeDedicatedHostRead,
-		Delete:   resourceIBMComputeDedicatedHostDelete,
-		Exists:   resourceIBMComputeDedicatedHostExists,
-		Update:   resourceIBMComputeDedicatedHostUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeDedicatedHostCreate,
+		ReadContext:   resourceIBMComputeDedicatedHostRead,
+		DeleteContext: resourceIBMComputeDedicatedHostDelete,
+		Exists:        resourceIBMComputeDedicatedHostExists,
+		UpdateContext: resourceIBMComputeDedicatedHostUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -100,12 +102,12 @@ func ResourceIBMComputeDedicatedHost() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	pkg, err := product.GetPackageByType(sess, dedicatedHostPackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	datacenter := d.Get("datacenter").(string)
@@ -115,12 +117,12 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	// Lookup the data center ID
 	dc, err := location.GetDatacenterByName(sess, datacenter)
 	if err != nil {
-		return fmt.Errorf("[ERROR] No data centers matching %s could be found", datacenter)
+		return diag.FromErr(fmt.Errorf("[ERROR] No data centers matching %s could be found", datacenter))
 	}
 
 	rt, err := hardware.GetRouterByName(sess, router, "id")
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating dedicated host: %s", err))
 	}
 
 	primaryBackendNetworkComponent := datatypes.Network_Component{
@@ -139,7 +141,7 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	// 2. Get all prices for the package
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id, productItemMaskWithPriceLocationGroupID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	priceItems := []datatypes.Product_Item_Price{}
@@ -181,32 +183,32 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated host: %s", err))
 	}
 	//place order
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of dedicated host: %s", err))
 	}
 
 	// wait for machine availability
-	dedicated, err := findDedicatedHostByOrderID(&hardware, d, meta)
+	dedicated, err := findDedicatedHostByOrderID(ctx, &hardware, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for dedicated host (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for dedicated host (%s) to become ready: %s", d.Id(), err))
 	}
 
 	id := *dedicated.(datatypes.Virtual_DedicatedHost).Id
 	d.SetId(fmt.Sprintf("%d", id))
-	return resourceIBMComputeDedicatedHostRead(d, meta)
+	return resourceIBMComputeDedicatedHostRead(ctx, d, meta)
 }
 
-func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualDedicatedHostService(meta.(conns.ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -214,7 +216,7 @@ func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving dedicated host: %s", err))
 	}
 
 	d.Set("hostname", result.Name)
@@ -226,49 +228,49 @@ func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMComputeDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualDedicatedHostService(sess.SetRetries(0))
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving dedicated host: %s", err))
 	}
 
 	if d.HasChange("hostname") {
 		result.Name = sl.String(d.Get("hostname").(string))
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Could n't update dedicated host: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Could n't update dedicated host: %s", err))
 		}
 
 	}
-	return resourceIBMComputeDedicatedHostRead(d, meta)
+	return resourceIBMComputeDedicatedHostRead(ctx, d, meta)
 }
 
-func resourceIBMComputeDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualDedicatedHostService(meta.(conns.ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	ok, err := service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting dedicated host: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting dedicated host: %s", err))
 	}
 
 	if !ok {
-		return fmt.Errorf(
-			"API reported it was unsuccessful in removing the dedicated host '%d'", id)
+		return diag.FromErr(fmt.Errorf(
+			"API reported it was unsuccessful in removing the dedicated host '%d'", id))
 	}
 
 	d.SetId("")
@@ -295,7 +297,7 @@ func resourceIBMComputeDedicatedHostExists(d *schema.ResourceData, meta interfac
 	return result.Id != nil && *result.Id == dedicatedID, nil
 }
 
-func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, meta interface{}) (interface{}, error) {
+func findDedicatedHostByOrderID(ctx context.Context, d *datatypes.Hardware, r *schema.ResourceData, meta interface{}) (interface{}, error) {
 	hostname := *d.Hostname
 
 	log.Printf("Waiting for dedicated host (%s) to have to be provisioned", hostname)
@@ -325,5 +327,5 @@ func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, m
 		MinTimeout: 1 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
@@ -25,12 +27,12 @@ import (
 
 func ResourceIBMComputePlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputePlacementGroupCreate,
-		Read:     resourceIBMComputePlacementGroupRead,
-		Update:   resourceIBMComputePlacementGroupUpdate,
-		Delete:   resourceIBMComputePlacementGroupDelete,
-		Exists:   resourceIBMComputePlacementGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputePlacementGroupCreate,
+		ReadContext:   resourceIBMComputePlacementGroupRead,
+		UpdateContext: resourceIBMComputePlacementGroupUpdate,
+		DeleteContext: resourceIBMComputePlacementGroupDelete,
+		Exists:        resourceIBMComputePlacementGroupExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -80,7 +82,7 @@ func ResourceIBMComputePlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	name := d.Get("name").(string)
 	datacenter := d.Get("datacenter").(string)
@@ -93,7 +95,7 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 	// 1.Getting the router ID
 	routerids, err := PodService.Filter(filter.Path("datacenterName").Eq(datacenter).Build()).Mask(podMask).GetAllObjects()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered  problem trying to get the router ID: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered  problem trying to get the router ID: %s", err))
 	}
 	var routerid int
 	for _, iterate := range routerids {
@@ -107,7 +109,7 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 		Mask("id,name").
 		Filter(filter.Path("name").Eq(rule).Build()).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Encountered  problem trying to get the placement group rule ID: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Encountered  problem trying to get the placement group rule ID: %s", err))
 	}
 
 	opts := datatypes.Virtual_PlacementGroup{
@@ -120,16 +122,16 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 
 	pgrp, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Placement Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Placement Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*pgrp.Id))
 	log.Printf("[INFO] Placement Group ID: %d", *pgrp.Id)
 
-	return resourceIBMComputePlacementGroupRead(d, meta)
+	return resourceIBMComputePlacementGroupRead(ctx, d, meta)
 }
 
-func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess)
 
@@ -143,7 +145,7 @@ func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface
 				return nil
 			}
 		}
-		return fmt.Errorf("[ERROR] Error retrieving Placement Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Placement Group: %s", err))
 	}
 
 	d.Set("name", pgrp.Name)
@@ -157,7 +159,7 @@ func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMComputePlacementGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess.SetRetries(0))
 
@@ -170,7 +172,7 @@ func resourceIBMComputePlacementGroupUpdate(d *schema.ResourceData, meta interfa
 		_, err := service.Id(pgrpID).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error editing Placement Group: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error editing Placement Group: %s", err))
 		}
 	}
 
@@ -199,7 +201,7 @@ func resourceIBMComputePlacementGroupExists(d *schema.ResourceData, meta interfa
 	return result.Id != nil && *result.Id == pgrpID, nil
 }
 
-func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess)
 
@@ -230,14 +232,14 @@ func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interfa
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = conns.WaitForStateContext(ctx, stateConf)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = service.Id(pgrpID).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Placement Group: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Placement Group: %s", err))
 	}
 
 	return nil
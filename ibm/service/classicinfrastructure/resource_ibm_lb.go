@@ -4,12 +4,14 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -33,12 +35,12 @@ const (
 
 func ResourceIBMLb() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbCreate,
-		Read:     resourceIBMLbRead,
-		Update:   resourceIBMLbUpdate,
-		Delete:   resourceIBMLbDelete,
-		Exists:   resourceIBMLbExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbCreate,
+		ReadContext:   resourceIBMLbRead,
+		UpdateContext: resourceIBMLbUpdate,
+		DeleteContext: resourceIBMLbDelete,
+		Exists:        resourceIBMLbExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -108,7 +110,7 @@ func ResourceIBMLb() *schema.Resource {
 	}
 }
 
-func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
@@ -146,7 +148,7 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	} else {
 		if d.Get("ha_enabled").(bool) {
-			return fmt.Errorf("High Availability is not supported for shared local load balancers")
+			return diag.FromErr(fmt.Errorf("High Availability is not supported for shared local load balancers"))
 		}
 		categoryCode = product.ProxyLoadBalancerCategoryCode
 		if _, ok := d.GetOk("security_certificate_id"); ok {
@@ -162,13 +164,13 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	pkg, err := product.GetPackageByType(sess, LbLocalPackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICE_LOAD_BALANCER with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -180,7 +182,7 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("[ERROR] No product items matching %s could be found", keyName)
+		return diag.FromErr(fmt.Errorf("[ERROR] No product items matching %s could be found", keyName))
 	}
 
 	//select prices with the required capacity
@@ -208,12 +210,12 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of load balancer: %s", err))
 	}
 
-	loadBalancer, err := findLoadBalancerByOrderId(sess, *receipt.OrderId, dedicated, d)
+	loadBalancer, err := findLoadBalancerByOrderId(ctx, sess, *receipt.OrderId, dedicated, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of load balancer: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *loadBalancer.Id))
@@ -225,10 +227,10 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Load Balancer ID: %s", d.Id())
 
-	return resourceIBMLbUpdate(d, meta)
+	return resourceIBMLbUpdate(ctx, d, meta)
 }
 
-func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	vipID, _ := strconv.Atoi(d.Id())
@@ -237,7 +239,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err := setLocalLBSecurityCert(sess, vipID, certID)
 	if err != nil {
-		return fmt.Errorf("Update load balancer failed: %s", err)
+		return diag.FromErr(fmt.Errorf("Update load balancer failed: %s", err))
 	}
 
 	if d.HasChange("connections") {
@@ -246,7 +248,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 			Mask(lbMask).
 			GetObject()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ors, nrs := d.GetChange("connections")
 		oldValue := ors.(int)
@@ -254,7 +256,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if oldValue > 0 {
 			if *vip.DedicatedFlag {
-				return fmt.Errorf("[ERROR] Error Updating load balancer connection limit: Upgrade for dedicated loadbalancer is not supported")
+				return diag.FromErr(fmt.Errorf("[ERROR] Error Updating load balancer connection limit: Upgrade for dedicated loadbalancer is not supported"))
 			}
 			if vip.BillingItem.UpgradeItems[0].Capacity != nil {
 				validUpgradeValue := vip.BillingItem.UpgradeItems[0].Capacity
@@ -262,16 +264,16 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 					_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
 						Id(vipID).UpgradeConnectionLimit()
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Updating load balancer connection limit: %s", err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error Updating load balancer connection limit: %s", err))
 					}
 				} else {
 
-					return fmt.Errorf("[ERROR] Error Updating load balancer connection limit : Valid value to which connection limit can be upgraded is : %d ", int(*validUpgradeValue))
+					return diag.FromErr(fmt.Errorf("[ERROR] Error Updating load balancer connection limit : Valid value to which connection limit can be upgraded is : %d ", int(*validUpgradeValue)))
 
 				}
 
 			} else {
-				return fmt.Errorf("[ERROR] Error Updating load balancer connection limit: No upgrade available, already it has maximum connection limit")
+				return diag.FromErr(fmt.Errorf("[ERROR] Error Updating load balancer connection limit: No upgrade available, already it has maximum connection limit"))
 			}
 		}
 
@@ -284,7 +286,7 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
 				Id(vipID).StartSsl()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting ssl acceleration for load balancer : %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error starting ssl acceleration for load balancer : %s", err))
 			}
 
 		} else {
@@ -292,16 +294,16 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
 				Id(vipID).StopSsl()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error stopping ssl acceleration for load balancer : %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error stopping ssl acceleration for load balancer : %s", err))
 			}
 
 		}
 	}
 
-	return resourceIBMLbRead(d, meta)
+	return resourceIBMLbRead(ctx, d, meta)
 }
 
-func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	vipID, _ := strconv.Atoi(d.Id())
 
@@ -311,7 +313,7 @@ func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err))
 	}
 
 	d.Set("connections", getConnectionLimit(*vip.ConnectionLimit))
@@ -328,7 +330,7 @@ func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	vipService := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess)
 	vipID, _ := strconv.Atoi(d.Id())
@@ -338,7 +340,7 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 	if certID > 0 {
 		err := setLocalLBSecurityCert(sess, vipID, 0)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Remove certificate before deleting load balancer failed: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Remove certificate before deleting load balancer failed: %s", err))
 		}
 
 	}
@@ -358,19 +360,19 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the load balancer: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("[ERROR] Error while looking up billing item associated with the load balancer: No billing item for ID:%d", vipID)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while looking up billing item associated with the load balancer: No billing item for ID:%d", vipID))
 	}
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diag.FromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 
 	return nil
@@ -409,7 +411,7 @@ func getConnectionLimit(connectionLimit int) int {
 	}
 }
 
-func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated bool, d *schema.ResourceData) (datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
+func findLoadBalancerByOrderId(ctx context.Context, sess *session.Session, orderId int, dedicated bool, d *schema.ResourceData) (datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
 	var filterPath string
 	if dedicated {
 		filterPath = "adcLoadBalancers.dedicatedBillingItem.orderItem.order.id"
@@ -445,7 +447,7 @@ func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated boo
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := conns.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, err
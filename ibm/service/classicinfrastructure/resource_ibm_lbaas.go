@@ -5,6 +5,7 @@ package classicinfrastructure
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -54,12 +56,12 @@ func init() {
 
 func ResourceIBMLbaas() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasCreate,
-		Read:     resourceIBMLbaasRead,
-		Delete:   resourceIBMLbaasDelete,
-		Exists:   resourceIBMLbaasExists,
-		Update:   resourceIBMLbaasUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasCreate,
+		ReadContext:   resourceIBMLbaasRead,
+		DeleteContext: resourceIBMLbaasDelete,
+		Exists:        resourceIBMLbaasExists,
+		UpdateContext: resourceIBMLbaasUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -243,14 +245,14 @@ func ResourceIBMLbaas() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	// Find price items
 	productOrderContainer, err := buildLbaasLBProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating Load balancer: %s", err))
 	}
 	log.Println("[INFO] Creating Load Balancer")
 
@@ -258,34 +260,34 @@ func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err = services.GetProductOrderService(sess).
 		VerifyOrder(productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of Load balancer: %s", err))
 	}
 	//place order
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of Load balancer: %s", err))
 	}
 
 	name := d.Get("name").(string)
 
-	lbaasLB, err := findLbaasLBByOrderId(sess, name, d)
+	lbaasLB, err := findLbaasLBByOrderId(ctx, sess, name, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of Load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of Load balancer: %s", err))
 	}
 
 	d.SetId(*lbaasLB.Uuid)
 
-	return resourceIBMLbaasUpdate(d, meta)
+	return resourceIBMLbaasUpdate(ctx, d, meta)
 }
 
-func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
 	result, err := service.Mask("datacenter,members,listeners.defaultPool,listeners.defaultPool.sessionAffinity,listeners.defaultPool.healthMonitor,healthMonitors,sslCiphers[name],useSystemPublicIpPool,isPublic,name,description,operatingStatus,address").GetLoadBalancer(sl.String(d.Id()))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving load balancer: %s", err))
 	}
 
 	var lbType string
@@ -318,14 +320,14 @@ func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess.SetRetries(0))
 
 	if d.HasChange("description") {
 		_, err := service.UpdateLoadBalancer(sl.String(d.Id()), sl.String(d.Get("description").(string)))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	listenerService := services.GetNetworkLBaaSListenerService(sess.SetRetries(0))
@@ -336,7 +338,7 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		add, err := expandProtocols(ns.Difference(os).List())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rem := os.Difference(ns).List()
 		removeList := make([]string, len(rem), len(rem))
@@ -349,22 +351,22 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 		if len(removeList) > 0 {
 			_, err := listenerService.DeleteLoadBalancerProtocols(sl.String(d.Id()), removeList)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error removing protocols: %#v", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error removing protocols: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(ctx, d, meta)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 
 		if len(add) > 0 {
 			_, err := listenerService.UpdateLoadBalancerProtocols(sl.String(d.Id()), add)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error adding protocols: %#v", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error adding protocols: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(ctx, d, meta)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 
@@ -374,7 +376,7 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 			service := services.GetNetworkLBaaSLoadBalancerService(sess.SetRetries(0))
 			supportedCiphers, err := services.GetNetworkLBaaSSSLCipherService(sess).Mask("id,name").GetAllObjects()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retreving list of ssl ciphers: %#v", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retreving list of ssl ciphers: %#v", err))
 			}
 			ciphers := make([]int, v.(*schema.Set).Len())
 			for i, v := range v.(*schema.Set).List() {
@@ -387,21 +389,21 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, err = service.UpdateSslCiphers(sl.String(d.Id()), ciphers)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating ssl ciphers: %#v", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating ssl ciphers: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(ctx, d, meta)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 
 		}
 
 	}
 
-	return resourceIBMLbaasRead(d, meta)
+	return resourceIBMLbaasRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -410,12 +412,12 @@ func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
 		if strings.Contains(err.Error(), "DELETE_PENDING") {
 			log.Println("Deletion is already in progress, probably from previous runs")
 		} else {
-			return fmt.Errorf("[ERROR] Error deleting load balancer: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting load balancer: %s", err))
 		}
 	}
-	_, err = waitForLbaasLBDelete(d, meta)
+	_, err = waitForLbaasLBDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to be deleted: %s", d.Id(), err))
 	}
 	d.SetId("")
 	return nil
@@ -497,7 +499,7 @@ func buildLbaasLBProductOrderContainer(d *schema.ResourceData, sess *session.Ses
 	return &productOrderContainer, nil
 }
 
-func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.ResourceData) (*datatypes.Network_LBaaS_LoadBalancer, error) {
+func findLbaasLBByOrderId(ctx context.Context, sess *session.Session, name string, d *schema.ResourceData) (*datatypes.Network_LBaaS_LoadBalancer, error) {
 
 	isIDSet := false
 	stateConf := &resource.StateChangeConf{
@@ -534,7 +536,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		NotFoundChecks: 40,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := conns.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return nil, err
@@ -548,7 +550,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		fmt.Errorf("[ERROR] Cannot find a load balancer with name '%s' ", name)
 }
 
-func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -575,10 +577,10 @@ func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		NotFoundChecks: 40,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -601,7 +603,7 @@ func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIBMLBProtocolHash(v interface{}) int {
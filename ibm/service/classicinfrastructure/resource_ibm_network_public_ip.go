@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -30,12 +32,12 @@ const (
 
 func ResourceIBMNetworkPublicIp() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkPublicIpCreate,
-		Read:     resourceIBMNetworkPublicIpRead,
-		Update:   resourceIBMNetworkPublicIpUpdate,
-		Delete:   resourceIBMNetworkPublicIpDelete,
-		Exists:   resourceIBMNetworkPublicIpExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkPublicIpCreate,
+		ReadContext:   resourceIBMNetworkPublicIpRead,
+		UpdateContext: resourceIBMNetworkPublicIpUpdate,
+		DeleteContext: resourceIBMNetworkPublicIpDelete,
+		Exists:        resourceIBMNetworkPublicIpExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -81,7 +83,7 @@ func ResourceIBMNetworkPublicIp() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
@@ -91,7 +93,7 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 		// Find price items with AdditionalServices
 		productOrderContainer, err = buildGlobalIpProductOrderContainer(d, sess, AdditionalServicesPackageType)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating network public ip: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating network public ip: %s", err))
 		}
 	}
 
@@ -100,32 +102,32 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of network public ip: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of network public ip: %s", err))
 	}
 
-	globalIp, err := findGlobalIpByOrderId(sess, *receipt.OrderId, d)
+	globalIp, err := findGlobalIpByOrderId(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of network public ip: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of network public ip: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *globalIp.Id))
 	d.Set("ip_address", *globalIp.IpAddress.IpAddress)
 
-	return resourceIBMNetworkPublicIpUpdate(d, meta)
+	return resourceIBMNetworkPublicIpUpdate(ctx, d, meta)
 }
 
-func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid network public ip ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	globalIp, err := service.Id(globalIpId).Mask(GlobalIpMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving network public Ip: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving network public Ip: %s", err))
 	}
 
 	d.Set("ip_address", *globalIp.IpAddress.IpAddress)
@@ -138,13 +140,13 @@ func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid network public ip ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	routes_to := d.Get("routes_to").(string)
@@ -165,17 +167,17 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = service.Id(globalIpId).Route(sl.String(routes_to))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error editing network public Ip: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error editing network public Ip: %s", err))
 	}
 	// Update notes
 	if d.HasChange("notes") {
 		publicIp, err := service.Id(globalIpId).Mask(GlobalIpMask).GetObject()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating network public Ip: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating network public Ip: %s", err))
 		}
 		err = updatePublicIPNotes(d, sess, publicIp)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error editing network public Ip: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error editing network public Ip: %s", err))
 		}
 	}
 
@@ -197,10 +199,10 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := conns.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for network public ip destination ip address to become active: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for network public ip destination ip address to become active: %s", err))
 	}
 
 	if _, ok := pendingResult.(datatypes.Network_Subnet_IpAddress_Global); ok {
@@ -210,18 +212,18 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid network public ip ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(globalIpId).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting network public ip: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting network public ip: %s", err))
 	}
 
 	if billingItem.Id == nil {
@@ -230,7 +232,7 @@ func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -252,7 +254,7 @@ func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{})
 	return result.Id != nil && *result.Id == globalIpId, nil
 }
 
-func findGlobalIpByOrderId(sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Subnet_IpAddress_Global, error) {
+func findGlobalIpByOrderId(ctx context.Context, sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Subnet_IpAddress_Global, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -280,7 +282,7 @@ func findGlobalIpByOrderId(sess *session.Session, orderId int, d *schema.Resourc
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := conns.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Network_Subnet_IpAddress_Global{}, err
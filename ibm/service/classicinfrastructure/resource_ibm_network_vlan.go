@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -37,12 +39,12 @@ const (
 
 func ResourceIBMNetworkVlan() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkVlanCreate,
-		Read:     resourceIBMNetworkVlanRead,
-		Update:   resourceIBMNetworkVlanUpdate,
-		Delete:   resourceIBMNetworkVlanDelete,
-		Exists:   resourceIBMNetworkVlanExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkVlanCreate,
+		ReadContext:   resourceIBMNetworkVlanRead,
+		UpdateContext: resourceIBMNetworkVlanUpdate,
+		DeleteContext: resourceIBMNetworkVlanDelete,
+		Exists:        resourceIBMNetworkVlanExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -151,7 +153,7 @@ func ResourceIBMNetworkVlan() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	router := d.Get("router_hostname").(string)
@@ -160,7 +162,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 	vlanType := d.Get("type").(string)
 	if (vlanType == "PRIVATE" && len(router) > 0 && strings.Contains(router, "fcr")) ||
 		(vlanType == "PUBLIC" && len(router) > 0 && strings.Contains(router, "bcr")) {
-		return fmt.Errorf("[ERROR] Error creating vlan: mismatch between vlan_type '%s' and router_hostname '%s'", vlanType, router)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating vlan: mismatch between vlan_type '%s' and router_hostname '%s'", vlanType, router))
 	}
 
 	// Find price items with AdditionalServicesNetworkVlan
@@ -169,7 +171,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		// Find price items with AdditionalServices
 		productOrderContainer, err = buildVlanProductOrderContainer(d, sess, AdditionalServicesPackageType)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating vlan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating vlan: %s", err))
 		}
 	}
 
@@ -178,19 +180,19 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of vlan: %s", err))
 	}
 
-	vlan, err := findVlanByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	vlan, err := findVlanByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error finding VLAN order %d: %s", *receipt.OrderId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error finding VLAN order %d: %s", *receipt.OrderId, err))
 	}
 
 	if len(name) > 0 {
 		_, err = services.GetNetworkVlanService(sess).
 			Id(*vlan.Id).EditObject(&datatypes.Network_Vlan{Name: sl.String(name)})
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vlan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating vlan: %s", err))
 		}
 	}
 
@@ -203,25 +205,25 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		//Try setting only when it is non empty as we are creating vlan
 		err = setVlanTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMNetworkVlanRead(d, meta)
+	return resourceIBMNetworkVlanRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid vlan ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid vlan ID, must be an integer: %s", err))
 	}
 
 	vlan, err := service.Id(vlanId).Mask(VlanMask).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving vlan: %s", err))
 	}
 
 	d.Set("vlan_number", *vlan.VlanNumber)
@@ -287,13 +289,13 @@ func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid vlan ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid vlan ID, must be an integer: %s", err))
 	}
 
 	opts := datatypes.Network_Vlan{}
@@ -310,7 +312,7 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 		tags := getTags(d)
 		err := setVlanTags(vlanId, tags, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -318,20 +320,20 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 		_, err = service.Id(vlanId).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vlan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating vlan: %s", err))
 		}
 	}
 
-	return resourceIBMNetworkVlanRead(d, meta)
+	return resourceIBMNetworkVlanRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid vlan ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid vlan ID, must be an integer: %s", err))
 	}
 
 	const (
@@ -358,14 +360,14 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = conns.WaitForStateContext(ctx, stateConf)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingItem, err := service.Id(vlanId).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting vlan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting vlan: %s", err))
 	}
 
 	// VLANs which don't have billing items are managed by SoftLayer. They can't be deleted by
@@ -381,7 +383,7 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 	// automatically which the VLAN doesn't have any child resources.
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 
-	return err
+	return diag.FromErr(err)
 }
 
 func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -405,7 +407,7 @@ func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (boo
 	return result.Id != nil && *result.Id == vlanID, nil
 }
 
-func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
+func findVlanByOrderId(ctx context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -433,7 +435,7 @@ func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := conns.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Network_Vlan{}, err
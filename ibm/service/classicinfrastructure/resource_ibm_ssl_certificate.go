@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	fmt "fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -30,11 +32,11 @@ const (
 
 func ResourceIBMSSLCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSSLCertificateCreate,
-		Read:     resourceIBMSSLCertificateRead,
-		Update:   resourceIBMSSLCertificateUpdate,
-		Delete:   resourceIBMSSLCertificateDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSSLCertificateCreate,
+		ReadContext:   resourceIBMSSLCertificateRead,
+		UpdateContext: resourceIBMSSLCertificateUpdate,
+		DeleteContext: resourceIBMSSLCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 
@@ -427,17 +429,17 @@ func ResourceIBMSSLCertificate() *schema.Resource {
 		},
 	}
 }
-func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sess := m.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateRequestService(sess.SetRetries(0))
 	sslKeyName := sl.String(d.Get("ssl_type").(string))
 	pkg, err := product.GetPackageByType(sess, AdditionalServicesSSLCertificatePackageType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var itemId *int
 	for _, item := range productItems {
@@ -447,7 +449,7 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 	}
 	validCSR, err := service.ValidateCsr(sl.String(d.Get("certificate_signing_request").(string)), sl.Int(d.Get("validity_months").(int)), itemId, sl.String(d.Get("server_type").(string)))
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during validation of CSR: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during validation of CSR: %s", err))
 	}
 	if validCSR == true {
 		productOrderContainer, err := buildSSLProductOrderContainer(d, sess, AdditionalServicesSSLCertificatePackageType)
@@ -455,13 +457,13 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 			// Find price items with AdditionalServices
 			productOrderContainer, err = buildSSLProductOrderContainer(d, sess, AdditionalSSLServicesPackageType)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating SSL certificate: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating SSL certificate: %s", err))
 			}
 		}
 		log.Printf("[INFO] Creating SSL Certificate")
 		verifiedOrderContainer, err := services.GetProductOrderService(sess).VerifyOrder(productOrderContainer)
 		if err != nil {
-			return fmt.Errorf("Order verification failed: %s", err)
+			return diag.FromErr(fmt.Errorf("Order verification failed: %s", err))
 		}
 
 		servercorecount := verifiedOrderContainer.ServerCoreCount
@@ -470,57 +472,57 @@ func resourceIBMSSLCertificateCreate(d *schema.ResourceData, m interface{}) erro
 		receipt, err := services.GetProductOrderService(sess).PlaceOrder(productOrderContainer, sl.Bool(false))
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error during creation of ssl: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of ssl: %s", err))
 		}
 
-		ssl, err := findSSLByOrderId(sess, *receipt.OrderId)
+		ssl, err := findSSLByOrderId(ctx, sess, *receipt.OrderId)
 		d.SetId(fmt.Sprintf("%d", *ssl.Id))
-		return resourceIBMSSLCertificateRead(d, m)
+		return resourceIBMSSLCertificateRead(ctx, d, m)
 	} else {
 		log.Println("Provided CSR is not valid.")
-		return fmt.Errorf("[ERROR] Error while validating CSR: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while validating CSR: %s", err))
 	}
 }
 
-func resourceIBMSSLCertificateRead(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sess := m.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateRequestService(sess)
 	sslId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid SSL ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid SSL ID, must be an integer: %s", err))
 	}
 
 	ssl, err := service.Id(sslId).Mask(SSLMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving SSL: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving SSL: %s", err))
 	}
 	d.Set("certificate_signing_request", ssl.CertificateSigningRequest)
 	return nil
 }
 
-func resourceIBMSSLCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMSSLCertificateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIBMSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sess := m.(conns.ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess)
 	service1 := services.GetSecurityCertificateRequestService(sess)
 	sslId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid SSL ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid SSL ID, must be an integer: %s", err))
 	}
 
 	value, err := service1.Id(sslId).GetObject()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid Object ID: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid Object ID: %s", err))
 	}
 	sslReqId := value.StatusId
 
 	if *sslReqId == 49 || *sslReqId == 43 {
 		deleteObject, err := service.Id(sslId).DeleteObject()
 		if deleteObject == false {
-			return fmt.Errorf("[ERROR] Error deleting SSL: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting SSL: %s", err))
 		} else {
 			d.SetId("")
 			return nil
@@ -528,7 +530,7 @@ func resourceIBMSSLCertificateDelete(d *schema.ResourceData, m interface{}) erro
 	} else if *sslReqId == 50 {
 		cancelObject, err := service1.Id(sslId).CancelSslOrder()
 		if cancelObject == false {
-			return fmt.Errorf("[ERROR] Error deleting SSL: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting SSL: %s", err))
 		} else {
 			d.SetId("")
 			return nil
@@ -815,7 +817,7 @@ func buildSSLProductOrderContainer(d *schema.ResourceData, sess *session1.Sessio
 	return &sslContainer, nil
 }
 
-func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
+func findSSLByOrderId(ctx context.Context, sess *session1.Session, orderId int) (datatypes.Security_Certificate_Request, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -838,7 +840,7 @@ func findSSLByOrderId(sess *session1.Session, orderId int) (datatypes.Security_C
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := conns.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return datatypes.Security_Certificate_Request{}, err
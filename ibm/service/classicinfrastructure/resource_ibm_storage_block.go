@@ -4,6 +4,7 @@
 package classicinfrastructure
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -22,12 +24,12 @@ import (
 
 func ResourceIBMStorageBlock() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMStorageBlockCreate,
-		Read:     resourceIBMStorageBlockRead,
-		Update:   resourceIBMStorageBlockUpdate,
-		Delete:   resourceIBMStorageBlockDelete,
-		Exists:   resourceIBMStorageBlockExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMStorageBlockCreate,
+		ReadContext:   resourceIBMStorageBlockRead,
+		UpdateContext: resourceIBMStorageBlockUpdate,
+		DeleteContext: resourceIBMStorageBlockDelete,
+		Exists:        resourceIBMStorageBlockExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -248,7 +250,7 @@ func ResourceIBMStorageBlock() *schema.Resource {
 	}
 }
 
-func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
 	storageType := d.Get("type").(string)
@@ -261,12 +263,12 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 	hourlyBilling := d.Get("hourly_billing").(bool)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	storageOrderContainer, err := buildStorageProductOrderContainer(sess, storageType, iops, capacity, snapshotCapacity, blockStorage, datacenter, hourlyBilling)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating storage:%s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while creating storage:%s", err))
 	}
 
 	log.Println("[INFO] Creating storage")
@@ -296,42 +298,42 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 				VolumeSize: &capacity,
 			}, sl.Bool(false))
 	default:
-		return fmt.Errorf("[ERROR] Error during creation of storage: Invalid storageType %s", storageType)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: Invalid storageType %s", storageType))
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: %s", err))
 	}
 
 	// Find the storage device
-	blockStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err := findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
 
 	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
+	_, err = WaitForStorageAvailable(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage (%s) to become ready: %s", d.Id(), err))
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	blockStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err = findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error during creation of storage: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
 
 	log.Printf("[INFO] Storage ID: %s", d.Id())
 
-	return resourceIBMStorageBlockUpdate(d, meta)
+	return resourceIBMStorageBlockUpdate(ctx, d, meta)
 }
 
-func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	storageId, _ := strconv.Atoi(d.Id())
 
@@ -341,7 +343,7 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving storage information: %s", err))
 	}
 
 	storageType := strings.Fields(*storage.StorageType.Description)[0]
@@ -349,7 +351,7 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 	// Calculate IOPS
 	iops, err := getIops(storage, storageType)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving storage information: %s", err))
 	}
 
 	d.Set("type", storageType)
@@ -437,11 +439,11 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err))
 	}
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -450,14 +452,14 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 	}
 
 	// Update allowed_ip_addresses
 	if d.HasChange("allowed_ip_addresses") {
 		err := updateAllowedIpAddresses(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -465,7 +467,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_subnets") {
 		err := updateAllowedSubnets(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -473,7 +475,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_virtual_guest_ids") {
 		err := updateAllowedVirtualGuestIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -481,7 +483,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_hardware_ids") {
 		err := updateAllowedHardwareIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -489,7 +491,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("notes") {
 		err := updateNotes(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage information: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage information: %s", err))
 		}
 	}
 
@@ -499,7 +501,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 
 		modifyOrder, err := prepareModifyOrder(sess, storage, iops, size)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating storage: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating storage: %s", err))
 		}
 
 		_, err = services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(
@@ -510,19 +512,19 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 				},
 			}, sl.Bool(false))
 		// Wait for storage availability
-		_, err = WaitForStorageUpdate(d, meta)
+		_, err = WaitForStorageUpdate(ctx, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for storage (%s) to update: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for storage (%s) to update: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMStorageBlockRead(d, meta)
+	return resourceIBMStorageBlockRead(ctx, d, meta)
 }
 
-func resourceIBMStorageBlockDelete(d *schema.ResourceData, meta interface{}) error {
-	return resourceIBMStorageFileDelete(d, meta)
+func resourceIBMStorageBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIBMStorageFileDelete(ctx, d, meta)
 }
 
 func resourceIBMStorageBlockExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -111,12 +112,12 @@ func ResourceIBMCloudant() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create:   resourceIBMCloudantCreate,
-		Read:     resourceIBMCloudantRead,
-		Update:   resourceIBMCloudantUpdate,
-		Delete:   resourcecontroller.ResourceIBMResourceInstanceDelete,
-		Exists:   resourcecontroller.ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCloudantCreate,
+		ReadContext:   resourceIBMCloudantRead,
+		UpdateContext: resourceIBMCloudantUpdate,
+		DeleteContext: resourcecontroller.ResourceIBMResourceInstanceDelete,
+		Exists:        resourcecontroller.ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -134,17 +135,17 @@ func ResourceIBMCloudant() *schema.Resource {
 	}
 }
 
-func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCloudantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Set("service", "cloudantnosqldb")
 
 	err := validateCloudantInstanceCapacity(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = validateCloudantInstanceCors(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	params := make(map[string]interface{})
@@ -174,9 +175,8 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("parameters", params)
 	}
 
-	err = resourcecontroller.ResourceIBMResourceInstanceCreate(d, meta)
-	if err != nil {
-		return err
+	if diags := resourcecontroller.ResourceIBMResourceInstanceCreate(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
 	// return original parameters on state
@@ -184,14 +184,14 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 
 	client, err := getCloudantClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// if matches an instance creation default skip request
 	if d.Get("include_data_events").(bool) {
 		err := updateCloudantActivityTrackerEvents(client, d)
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error updating activity tracker events: %s", err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error updating activity tracker events: %s", err))
 		}
 	}
 
@@ -199,102 +199,100 @@ func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 	if d.Get("capacity").(int) > 1 {
 		err := updateCloudantInstanceCapacity(client, d)
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error retrieving capacity throughput information: %s", err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error retrieving capacity throughput information: %s", err))
 		}
 	}
 
 	err = updateCloudantInstanceCors(client, d)
 	if err != nil {
-		return flex.FmtErrorf("[ERROR] Error updating CORS settings: %s", err)
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error updating CORS settings: %s", err))
 	}
 
-	return resourceIBMCloudantRead(d, meta)
+	return resourceIBMCloudantRead(ctx, d, meta)
 }
 
-func resourceIBMCloudantRead(d *schema.ResourceData, meta interface{}) error {
-	err := resourcecontroller.ResourceIBMResourceInstanceRead(d, meta)
-	if err != nil {
-		return err
+func resourceIBMCloudantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourcecontroller.ResourceIBMResourceInstanceRead(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
-	err = setCloudantLegacyCredentials(d, meta)
+	err := setCloudantLegacyCredentials(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = setCloudantResourceControllerURL(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	client, err := getCloudantClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = setCloudantActivityTrackerEvents(client, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = setCloudantInstanceCapacity(client, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = setCloudantInstanceCors(client, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIBMCloudantUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCloudantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.Set("service", "cloudantnosqldb")
 
 	err := validateCloudantInstanceCapacity(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = validateCloudantInstanceCors(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resourcecontroller.ResourceIBMResourceInstanceUpdate(d, meta)
-	if err != nil {
-		return err
+	if diags := resourcecontroller.ResourceIBMResourceInstanceUpdate(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
 	client, err := getCloudantClient(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("include_data_events") {
 		err := updateCloudantActivityTrackerEvents(client, d)
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error updating activity tracker events: %s", err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error updating activity tracker events: %s", err))
 		}
 	}
 
 	if d.HasChange("capacity") {
 		err := updateCloudantInstanceCapacity(client, d)
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error retrieving capacity throughput information: %s", err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error retrieving capacity throughput information: %s", err))
 		}
 	}
 
 	if d.HasChange("enable_cors") {
 		err := updateCloudantInstanceCors(client, d)
 		if err != nil {
-			return flex.FmtErrorf("[ERROR] Error updating CORS settings: %s", err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error updating CORS settings: %s", err))
 		}
 	}
 
-	return resourceIBMCloudantRead(d, meta)
+	return resourceIBMCloudantRead(ctx, d, meta)
 }
 
 func setCloudantLegacyCredentials(d *schema.ResourceData, meta interface{}) error {
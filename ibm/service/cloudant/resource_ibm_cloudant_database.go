@@ -15,6 +15,7 @@ import (
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
@@ -92,13 +93,11 @@ func resourceIBMCloudantDatabaseCreate(context context.Context, d *schema.Resour
 }
 
 func resourceIBMCloudantDatabaseRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	parts, err := flex.IdParts(d.Id())
+	instanceCRN, dbName, err := parseCloudantDatabaseID(d.Id())
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_database", "read", "id-parts")
 		return tfErr.GetDiag()
 	}
-
-	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
 	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_database", "read", "get-instance-url")
@@ -145,13 +144,11 @@ func resourceIBMCloudantDatabaseRead(context context.Context, d *schema.Resource
 }
 
 func resourceIBMCloudantDatabaseDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	parts, err := flex.IdParts(d.Id())
+	instanceCRN, dbName, err := parseCloudantDatabaseID(d.Id())
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_database", "delete", "id-parts")
 		return tfErr.GetDiag()
 	}
-
-	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
 	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cloudant_database", "delete", "get-instance-url")
@@ -204,3 +201,18 @@ func GetCloudantInstanceUrl(instanceCRN string, meta interface{}) (string, error
 
 	return "", fmt.Errorf("Unable to get URL for cloudant instance")
 }
+
+// parseCloudantDatabaseID splits the ID of a database into the CRN of its
+// Cloudant instance and the database name, which may itself contain slashes.
+func parseCloudantDatabaseID(id string) (string, string, error) {
+	i := strings.LastIndex(id, ":")
+	j := strings.Index(id[i+1:], "/")
+	if i < 0 || j < 0 {
+		return "", "", fmt.Errorf("The given id %s is not of the form <instance_crn>/<db_name>, please check documentation on how to provide id during import command", id)
+	}
+	instanceCRN, dbName := id[:i+1+j], id[i+2+j:]
+	if _, err := crn.Parse(instanceCRN); err != nil {
+		return "", "", fmt.Errorf("The given id %s does not start with an instance CRN: %s", id, err)
+	}
+	return instanceCRN, dbName, nil
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"testing"
+)
+
+func TestParseCloudantDatabaseID(t *testing.T) {
+	instanceCRN := "crn:v1:bluemix:public:cloudantnosqldb:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835::"
+	cases := []struct {
+		id     string
+		dbName string
+		valid  bool
+	}{
+		{instanceCRN + "/mydb", "mydb", true},
+		{instanceCRN + "/my/db", "my/db", true},
+		{instanceCRN, "", false},
+		{"mydb", "", false},
+		{"instance/mydb", "", false},
+	}
+	for _, c := range cases {
+		t.Run(c.id, func(t *testing.T) {
+			gotCRN, gotDBName, err := parseCloudantDatabaseID(c.id)
+			if !c.valid {
+				if err == nil {
+					t.Errorf("expected an error, got %q %q", gotCRN, gotDBName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotCRN != instanceCRN || gotDBName != c.dbName {
+				t.Errorf("expected %q %q, got %q %q", instanceCRN, c.dbName, gotCRN, gotDBName)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package cloudfoundry
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,12 +27,12 @@ const (
 
 func ResourceIBMServiceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMServiceInstanceCreate,
-		Read:     resourceIBMServiceInstanceRead,
-		Update:   resourceIBMServiceInstanceUpdate,
-		Delete:   resourceIBMServiceInstanceDelete,
-		Exists:   resourceIBMServiceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMServiceInstanceCreate,
+		ReadContext:   resourceIBMServiceInstanceRead,
+		UpdateContext: resourceIBMServiceInstanceUpdate,
+		DeleteContext: resourceIBMServiceInstanceDelete,
+		Exists:        resourceIBMServiceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -121,10 +123,10 @@ func ResourceIBMServiceInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serviceName := d.Get("service").(string)
 	plan := d.Get("plan").(string)
@@ -138,12 +140,12 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	serviceOff, err := cfClient.ServiceOfferings().FindByLabel(serviceName)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := cfClient.ServicePlans().FindPlanInServiceOffering(serviceOff.GUID, plan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	svcInst.PlanGUID = servicePlan.GUID
 
@@ -168,30 +170,30 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	service, err := cfClient.ServiceInstances().Create(svcInst)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating service: %s", err))
 	}
 
 	d.SetId(service.Metadata.GUID)
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create service (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create service (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMServiceInstanceRead(d, meta)
+	return resourceIBMServiceInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceGUID := d.Id()
 
 	service, err := cfClient.ServiceInstances().Get(serviceGUID, 1)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service: %s", err))
 	}
 
 	servicePlanGUID := service.Entity.ServicePlanGUID
@@ -208,17 +210,17 @@ func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) er
 
 	svcOff, err := cfClient.ServiceOfferings().Get(service.Entity.ServicePlan.Entity.ServiceGUID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("service", svcOff.Entity.Label)
 
 	return nil
 }
 
-func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceGUID := d.Id()
@@ -233,12 +235,12 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		service := d.Get("service").(string)
 		serviceOff, err := cfClient.ServiceOfferings().FindByLabel(service)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := cfClient.ServicePlans().FindPlanInServiceOffering(serviceOff.GUID, plan)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 		}
 		updateReq.PlanGUID = helpers.String(servicePlan.GUID)
 
@@ -255,32 +257,32 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = cfClient.ServiceInstances().Update(serviceGUID, updateReq)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating service: %s", err))
 	}
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update service (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for update service (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMServiceInstanceRead(d, meta)
+	return resourceIBMServiceInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMServiceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 
 	err = cfClient.ServiceInstances().Delete(id, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting service: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting service: %s", err))
 	}
 
-	_, err = waitForServiceInstanceDelete(d, meta)
+	_, err = waitForServiceInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for service (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for service (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -323,7 +325,7 @@ func getServiceTags(d *schema.ResourceData) []string {
 	return tags
 }
 
-func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -351,10 +353,10 @@ func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cfClient, err := meta.(conns.ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -381,5 +383,5 @@ func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
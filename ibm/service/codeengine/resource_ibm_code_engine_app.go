@@ -687,7 +687,7 @@ func resourceIbmCodeEngineAppCreate(context context.Context, d *schema.ResourceD
 
 	d.SetId(fmt.Sprintf("%s/%s", *createAppOptions.ProjectID, *app.Name))
 
-	_, err = waitForIbmCodeEngineAppCreate(d, meta)
+	_, err = waitForIbmCodeEngineAppCreate(context, d, meta)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error waiting for resource IbmCodeEngineApp (%s) to be created: %s", d.Id(), err)
 		return flex.DiscriminatedTerraformErrorf(err, errMsg, "ibm_code_engine_app", "create", "wait-for-state").GetDiag()
@@ -696,7 +696,7 @@ func resourceIbmCodeEngineAppCreate(context context.Context, d *schema.ResourceD
 	return resourceIbmCodeEngineAppRead(context, d, meta)
 }
 
-func waitForIbmCodeEngineAppCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForIbmCodeEngineAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	codeEngineClient, err := meta.(conns.ClientSession).CodeEngineV2()
 	if err != nil {
 		return false, err
@@ -734,7 +734,7 @@ func waitForIbmCodeEngineAppCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmCodeEngineAppRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
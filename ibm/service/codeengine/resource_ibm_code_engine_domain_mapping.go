@@ -213,7 +213,7 @@ func resourceIbmCodeEngineDomainMappingCreate(context context.Context, d *schema
 
 	d.SetId(fmt.Sprintf("%s/%s", *createDomainMappingOptions.ProjectID, *domainMapping.Name))
 
-	_, err = waitForIbmCodeEngineDomainMappingCreate(d, meta)
+	_, err = waitForIbmCodeEngineDomainMappingCreate(context, d, meta)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error waiting for resource IbmCodeEngineDomainMapping (%s) to be created: %s", d.Id(), err)
 		return flex.DiscriminatedTerraformErrorf(err, errMsg, "ibm_code_engine_domain_mapping", "create", "wait-for-state").GetDiag()
@@ -222,7 +222,7 @@ func resourceIbmCodeEngineDomainMappingCreate(context context.Context, d *schema
 	return resourceIbmCodeEngineDomainMappingRead(context, d, meta)
 }
 
-func waitForIbmCodeEngineDomainMappingCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForIbmCodeEngineDomainMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	codeEngineClient, err := meta.(conns.ClientSession).CodeEngineV2()
 	if err != nil {
 		return false, err
@@ -260,7 +260,7 @@ func waitForIbmCodeEngineDomainMappingCreate(d *schema.ResourceData, meta interf
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmCodeEngineDomainMappingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -448,7 +448,7 @@ func resourceIbmCodeEngineFunctionCreate(context context.Context, d *schema.Reso
 
 	d.SetId(fmt.Sprintf("%s/%s", *createFunctionOptions.ProjectID, *function.Name))
 
-	_, err = waitForIbmCodeEngineFunctionCreate(d, meta)
+	_, err = waitForIbmCodeEngineFunctionCreate(context, d, meta)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error waiting for resource IbmCodeEngineFunction (%s) to be created: %s", d.Id(), err)
 		return flex.DiscriminatedTerraformErrorf(err, errMsg, "ibm_code_engine_function", "create", "wait-for-state").GetDiag()
@@ -457,7 +457,7 @@ func resourceIbmCodeEngineFunctionCreate(context context.Context, d *schema.Reso
 	return resourceIbmCodeEngineFunctionRead(context, d, meta)
 }
 
-func waitForIbmCodeEngineFunctionCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForIbmCodeEngineFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	codeEngineClient, err := meta.(conns.ClientSession).CodeEngineV2()
 	if err != nil {
 		return false, err
@@ -495,7 +495,7 @@ func waitForIbmCodeEngineFunctionCreate(d *schema.ResourceData, meta interface{}
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmCodeEngineFunctionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -151,7 +151,7 @@ func resourceIbmCodeEngineProjectCreate(context context.Context, d *schema.Resou
 
 	d.SetId(*project.ID)
 
-	_, err = waitForIbmCodeEngineProjectCreate(d, meta)
+	_, err = waitForIbmCodeEngineProjectCreate(context, d, meta)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error waiting for resource IbmCodeEngineProject (%s) to be created: %s", d.Id(), err)
 		return flex.DiscriminatedTerraformErrorf(err, errMsg, "ibm_code_engine_project", "create", "wait-for-state").GetDiag()
@@ -160,7 +160,7 @@ func resourceIbmCodeEngineProjectCreate(context context.Context, d *schema.Resou
 	return resourceIbmCodeEngineProjectRead(context, d, meta)
 }
 
-func waitForIbmCodeEngineProjectCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForIbmCodeEngineProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	codeEngineClient, err := meta.(conns.ClientSession).CodeEngineV2()
 	if err != nil {
 		return false, err
@@ -192,7 +192,7 @@ func waitForIbmCodeEngineProjectCreate(d *schema.ResourceData, meta interface{})
 		MinTimeout: 20 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmCodeEngineProjectRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -101,8 +101,8 @@ func DataSourceIBMCosBucketObject() *schema.Resource {
 
 func dataSourceIBMCosBucketObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
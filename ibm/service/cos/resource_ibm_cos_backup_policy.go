@@ -77,7 +77,7 @@ func ResourceIBMCOSBackupPolicy() *schema.Resource {
 
 func resourceIBMCOSBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	bucketName := parseBucketId(bucketCRN, "bucketName")
 	policyName := d.Get("policy_name").(string)
 	deleteAfterDays := d.Get("initial_delete_after_days").(int)
 	targetBackupVaultCRN := d.Get("target_backup_vault_crn").(string)
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -1649,8 +1650,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 	if len(bucket_meta) < 2 || len(strings.Split(bucket_meta[1], ":")) < 2 {
 		return false, fmt.Errorf("[ERROR] Error parsing bucket ID. Bucket ID format must be: $CRN:meta:$buckettype:$bucketlocation")
 	}
-	resourceInstanceId := strings.Split(d.Id(), ":bucket:")[0]
-	resourceInstanceIdInput := resourceInstanceId + "::"
+	resourceInstanceIdInput := parseBucketId(d.Id(), "serviceID")
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &resourceInstanceIdInput,
 	}
@@ -1773,28 +1773,27 @@ func SelectSatlocCosApi(apiType string, serviceID string, bLocation string) stri
 }
 
 func parseBucketId(id string, info string) string {
-	crn := strings.Split(id, ":meta:")[0]
-	meta := strings.Split(id, ":meta:")[1]
+	bucketID, meta, _ := strings.Cut(id, ":meta:")
+	bucketCRN, err := crn.Parse(bucketID)
+	if err != nil {
+		return ""
+	}
 
 	if info == "bucketName" {
-		return strings.Split(crn, ":bucket:")[1]
+		return bucketCRN.Resource
 	}
 	if info == "serviceID" {
-		return fmt.Sprintf("%s::", strings.Split(crn, ":bucket:")[0])
+		return bucketCRN.ServiceInstanceCRN().String()
 	}
+	s := strings.Split(meta, ":")
 	if info == "apiType" {
-		return strings.Split(meta, ":")[0]
+		return s[0]
 	}
-	if info == "bLocation" {
-		return strings.Split(meta, ":")[1]
+	if info == "bLocation" && len(s) > 1 {
+		return s[1]
 	}
-	if info == "endpointType" {
-		s := strings.Split(meta, ":")
-		if len(s) > 2 {
-			return strings.Split(meta, ":")[2]
-		}
-		return ""
-
+	if info == "endpointType" && len(s) > 2 {
+		return s[2]
 	}
 	return ""
 }
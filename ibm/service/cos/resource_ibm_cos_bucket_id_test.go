@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+)
+
+const (
+	testInstanceCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835::"
+	testBucketCRN   = "crn:v1:bluemix:public:cloud-object-storage:global:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:bucket:my-bucket"
+)
+
+func TestParseBucketId(t *testing.T) {
+	cases := []struct {
+		id       string
+		info     string
+		expected string
+	}{
+		{testBucketCRN + ":meta:rl:us-south:public", "bucketName", "my-bucket"},
+		{testBucketCRN + ":meta:rl:us-south:public", "serviceID", testInstanceCRN},
+		{testBucketCRN + ":meta:rl:us-south:public", "apiType", "rl"},
+		{testBucketCRN + ":meta:rl:us-south:public", "bLocation", "us-south"},
+		{testBucketCRN + ":meta:rl:us-south:public", "endpointType", "public"},
+		{testBucketCRN + ":meta:crl:us", "endpointType", ""},
+		{testBucketCRN, "bLocation", ""},
+		{"my-bucket:meta:rl:us-south:public", "bucketName", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.info, func(t *testing.T) {
+			if s := parseBucketId(c.id, c.info); s != c.expected {
+				t.Errorf("expected %q for %s, got %q", c.expected, c.id, s)
+			}
+		})
+	}
+}
+
+func TestParseObjectId(t *testing.T) {
+	id := getObjectId(testBucketCRN, "path/to:location:key", "us-south")
+	cases := map[string]string{
+		"instanceCRN":    testInstanceCRN,
+		"bucketCRN":      testBucketCRN,
+		"bucketName":     "my-bucket",
+		"objectKey":      "path/to:location:key",
+		"bucketLocation": "us-south",
+	}
+	for info, expected := range cases {
+		t.Run(info, func(t *testing.T) {
+			if s := parseObjectId(id, info); s != expected {
+				t.Errorf("expected %q, got %q", expected, s)
+			}
+		})
+	}
+}
@@ -487,8 +487,8 @@ func lifecycleConfigurationSet(lifecycleConfigurationList []interface{}) []*s3.L
 
 func resourceIBMCOSBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
@@ -518,8 +518,8 @@ func resourceIBMCOSBucketLifecycleConfigurationCreate(ctx context.Context, d *sc
 
 func resourceIBMCOSBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
@@ -636,11 +636,8 @@ func resourceIBMCOSBucketLifecycleConfigurationDelete(ctx context.Context, d *sc
 func parseLifecycleId(id string, info string) string {
 	bucketCRN := strings.Split(id, ":meta:")[0]
 	meta := strings.Split(id, ":meta:")[1]
-	if info == "bucketName" {
-		return strings.Split(bucketCRN, ":bucket:")[1]
-	}
 	if info == "instanceCRN" {
-		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+		return parseBucketId(bucketCRN, "serviceID")
 	}
 	if info == "bucketCRN" {
 		return bucketCRN
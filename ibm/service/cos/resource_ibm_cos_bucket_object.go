@@ -157,8 +157,8 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 
 func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -348,8 +348,8 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 	objectKey := d.Get("key").(string)
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -447,8 +447,8 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 
 func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -575,30 +575,29 @@ func getObjectId(bucketCRN string, objectKey string, bucketLocation string) stri
 }
 
 func parseObjectId(id string, info string) string {
-	splitID := strings.Split(id, ":object:")
-	bucketCRN := splitID[0]
+	bucketCRN, object, _ := strings.Cut(id, ":object:")
+	objectKey, bucketLocation := object, ""
+	if i := strings.LastIndex(object, ":location:"); i >= 0 {
+		objectKey, bucketLocation = object[:i], object[i+len(":location:"):]
+	}
 
 	if info == "instanceCRN" {
-		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+		return parseBucketId(bucketCRN, "serviceID")
 	}
 
 	if info == "bucketCRN" {
 		return bucketCRN
 	}
 
-	if info == "bucketName" {
-		return strings.Split(bucketCRN, ":bucket:")[1]
-	}
-
 	if info == "objectKey" {
-		return strings.Split(splitID[1], ":location:")[0]
+		return objectKey
 	}
 
 	if info == "bucketLocation" {
-		return strings.Split(splitID[1], ":location:")[1]
+		return bucketLocation
 	}
 
-	return parseBucketId(splitID[0], info)
+	return parseBucketId(bucketCRN, info)
 }
 
 // deleteAllCOSObjectVersions deletes all versions of a specified key from an COS bucket.
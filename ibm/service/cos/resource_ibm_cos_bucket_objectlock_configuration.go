@@ -104,8 +104,8 @@ func ResourceIBMCOSBucketObjectlock() *schema.Resource {
 func resourceIBMCOSBucketObjectlockCreate(d *schema.ResourceData, meta interface{}) error {
 
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -138,8 +138,8 @@ func resourceIBMCOSBucketObjectlockCreate(d *schema.ResourceData, meta interface
 
 func resourceIBMCOSBucketObjectlockUpdate(d *schema.ResourceData, meta interface{}) error {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -247,11 +247,8 @@ func resourceIBMCOSBucketObjectlockDelete(d *schema.ResourceData, meta interface
 func parseObjectLockId(id string, info string) string {
 	bucketCRN := strings.Split(id, ":meta:")[0]
 	meta := strings.Split(id, ":meta:")[1]
-	if info == "bucketName" {
-		return strings.Split(bucketCRN, ":bucket:")[1]
-	}
 	if info == "instanceCRN" {
-		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+		return parseBucketId(bucketCRN, "serviceID")
 	}
 	if info == "bucketCRN" {
 		return bucketCRN
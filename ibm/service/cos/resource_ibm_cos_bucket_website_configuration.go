@@ -345,8 +345,8 @@ func websiteConfigurationSet(websiteConfigurationList []interface{}) (*s3.Websit
 
 func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
@@ -378,8 +378,8 @@ func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta
 
 func resourceIBMCOSBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
@@ -481,11 +481,8 @@ func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta
 func parseWebsiteId(id string, info string) string {
 	bucketCRN := strings.Split(id, ":meta:")[0]
 	meta := strings.Split(id, ":meta:")[1]
-	if info == "bucketName" {
-		return strings.Split(bucketCRN, ":bucket:")[1]
-	}
 	if info == "instanceCRN" {
-		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+		return parseBucketId(bucketCRN, "serviceID")
 	}
 	if info == "bucketCRN" {
 		return bucketCRN
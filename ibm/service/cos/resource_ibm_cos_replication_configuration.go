@@ -157,8 +157,8 @@ func replicationRuleSet(replicateList []interface{}) []*s3.ReplicationRule {
 
 func resourceIBMCOSBucketReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -198,8 +198,8 @@ func resourceIBMCOSBucketReplicationConfigurationCreate(d *schema.ResourceData,
 
 func resourceIBMCOSBucketReplicationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
 	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketName := parseBucketId(bucketCRN, "bucketName")
+	instanceCRN := parseBucketId(bucketCRN, "serviceID")
 
 	bucketLocation := d.Get("bucket_location").(string)
 	endpointType := d.Get("endpoint_type").(string)
@@ -315,11 +315,8 @@ func parseBucketReplId(id string, info string) string {
 	bucketCRN := strings.Split(id, ":meta:")[0]
 	meta := strings.Split(id, ":meta:")[1]
 
-	if info == "bucketName" {
-		return strings.Split(bucketCRN, ":bucket:")[1]
-	}
 	if info == "instanceCRN" {
-		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+		return parseBucketId(bucketCRN, "serviceID")
 	}
 	if info == "bucketCRN" {
 		return bucketCRN
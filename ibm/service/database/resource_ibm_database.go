@@ -823,8 +823,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			flex.DeletionProtection: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
-				Description: "Whether Terraform will be prevented from destroying the instance",
+				Description: "Whether Terraform will be prevented from destroying the instance. Defaults to the provider default_deletion_protection",
 			},
 
 			flex.ResourceName: {
@@ -1302,7 +1301,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	}
 	d.SetId(*instance.ID)
 
-	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForDatabaseInstanceCreate(context, d, meta, *instance.ID)
 	if err != nil {
 		return diag.FromErr(
 			fmt.Errorf(
@@ -1817,7 +1816,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
 		}
 
-		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err))
@@ -2281,7 +2280,7 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
@@ -2342,7 +2341,7 @@ func waitForICDReady(meta interface{}, instanceID string) error {
 	return nil
 }
 
-func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -2377,10 +2376,10 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 		return false, fmt.Errorf("[ERROR] Error ICD interface not ready after create: %s with error %s\n", instanceID, waitErr)
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -2417,7 +2416,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
@@ -2466,7 +2465,7 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -2496,7 +2495,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -22,6 +22,7 @@ import (
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -805,12 +806,12 @@ func ResourceIBMDb2Instance() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create:   resourceIBMDb2InstanceCreate,
-		Read:     resourcecontroller.ResourceIBMResourceInstanceRead,
-		Update:   resourcecontroller.ResourceIBMResourceInstanceUpdate,
-		Delete:   resourcecontroller.ResourceIBMResourceInstanceDelete,
-		Exists:   resourcecontroller.ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDb2InstanceCreate,
+		ReadContext:   resourcecontroller.ResourceIBMResourceInstanceRead,
+		UpdateContext: resourcecontroller.ResourceIBMResourceInstanceUpdate,
+		DeleteContext: resourcecontroller.ResourceIBMResourceInstanceDelete,
+		Exists:        resourcecontroller.ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -828,10 +829,10 @@ func ResourceIBMDb2Instance() *schema.Resource {
 	}
 }
 
-func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDb2InstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceName := d.Get("service").(string)
@@ -845,35 +846,35 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 	}
 
 	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
 		if !metadata.Service.RCProvisionable {
-			return fmt.Errorf("%s cannot be provisioned by resource controller", serviceName)
+			return diag.FromErr(fmt.Errorf("%s cannot be provisioned by resource controller", serviceName))
 		}
 	} else {
-		return fmt.Errorf("[ERROR] Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName)
+		return diag.FromErr(fmt.Errorf("[ERROR] Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := resourcecontroller.FilterDeployments(deployments, location)
 
@@ -882,7 +883,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service", plan, location, locationList))
 	}
 
 	rsInst.Target = &deployments[0].CatalogCRN
@@ -893,7 +894,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		defaultRg, err := flex.DefaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
@@ -929,7 +930,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		if subscriptionId, ok := d.GetOk("subscription_id"); ok {
 			params["subscription_id"] = subscriptionId.(string)
 		} else {
-			return fmt.Errorf("[ERROR] Missing required field 'subscription_id' while creating an instance for plan: %s", plan)
+			return diag.FromErr(fmt.Errorf("[ERROR] Missing required field 'subscription_id' while creating an instance for plan: %s", plan))
 		}
 	}
 
@@ -973,19 +974,19 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp))
 	}
 
 	d.SetId(*instance.ID)
 
-	_, err = waitForResourceInstanceCreate(d, meta)
+	_, err = waitForResourceInstanceCreate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	db2SaasClient, err := meta.(conns.ClientSession).Db2saasV1()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	encodedCRN := url.QueryEscape(*instance.CRN)
@@ -1006,7 +1007,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 				autoScalingThreshold, err = strconv.Atoi(autoscalingConfig["auto_scaling_threshold"].(string))
 				if err != nil {
 					fmt.Println("Error in converting auto_scaling_threshold to string", err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -1014,7 +1015,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 				autoScalingOverTimePeriod, err = strconv.Atoi(autoscalingConfig["auto_scaling_over_time_period"].(string))
 				if err != nil {
 					fmt.Println("Error in converting auto_scaling_over_time_period to string", err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -1024,7 +1025,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 					autoScalingPauseLimit, err = strconv.Atoi(v)
 					if err != nil {
 						fmt.Println("Error in converting auto_scaling_pause_limit to int:", err)
-						return err
+						return diag.FromErr(err)
 					}
 				case int:
 					autoScalingPauseLimit = v
@@ -1296,10 +1297,10 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return resourcecontroller.ResourceIBMResourceInstanceRead(d, meta)
+	return resourcecontroller.ResourceIBMResourceInstanceRead(ctx, d, meta)
 }
 
-func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1330,7 +1331,7 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 30 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func checkStringNilValue(config map[string]interface{}, key string) *string {
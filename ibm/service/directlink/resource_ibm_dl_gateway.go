@@ -15,6 +15,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,12 +27,12 @@ import (
 
 func ResourceIBMDLGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayCreate,
-		Read:     resourceIBMdlGatewayRead,
-		Delete:   resourceIBMdlGatewayDelete,
-		Exists:   resourceIBMdlGatewayExists,
-		Update:   resourceIBMdlGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayCreate,
+		ReadContext:   resourceIBMdlGatewayRead,
+		DeleteContext: resourceIBMdlGatewayDelete,
+		Exists:        resourceIBMdlGatewayExists,
+		UpdateContext: resourceIBMdlGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -668,10 +669,10 @@ func directlinkClient(meta interface{}) (*directlinkv1.DirectLinkV1, error) {
 	return sess, err
 }
 
-func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dtype := d.Get(dlType).(string)
 	createGatewayOptionsModel := &directlinkv1.CreateGatewayOptions{}
@@ -779,28 +780,28 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlCarrierName)
 			log.Printf("%s is a required field", dlCarrierName)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlCrossConnectRouter); ok {
 			crossConnectRouter = d.Get(dlCrossConnectRouter).(string)
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlCrossConnectRouter)
 			log.Printf("%s is a required field", dlCrossConnectRouter)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlLocationName); ok {
 			locationName = d.Get(dlLocationName).(string)
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlLocationName)
 			log.Printf("%s is a required field", dlLocationName)
-			return err
+			return diag.FromErr(err)
 		}
 		if _, ok := d.GetOk(dlCustomerName); ok {
 			customerName = d.Get(dlCustomerName).(string)
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating gateway, %s is a required field", dlCustomerName)
 			log.Printf("%s is a required field", dlCustomerName)
-			return err
+			return diag.FromErr(err)
 		}
 		gatewayDedicatedTemplateModel, _ := directLink.NewGatewayTemplateGatewayTypeDedicatedTemplate(bgpAsn, global, metered, name, speed, dtype, carrierName, crossConnectRouter, customerName, locationName)
 
@@ -947,13 +948,13 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 		} else {
 			err = fmt.Errorf("[ERROR] Error creating direct link connect gateway, %s is a required field", dlPort)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response))
 	}
 	d.SetId(*gateway.ID)
 
@@ -962,12 +963,12 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		getPortOptions := directLink.NewGetPortOptions(*gateway.Port.ID)
 		port, response, err := directLink.GetPort(getPortOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting port %s %s", response, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting port %s %s", response, err))
 		}
 		if port != nil && port.ProviderName != nil && !strings.Contains(strings.ToLower(*port.ProviderName), "netbond") && !strings.Contains(strings.ToLower(*port.ProviderName), "megaport") {
-			_, err = isWaitForDirectLinkAvailable(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForDirectLinkAvailable(ctx, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -983,7 +984,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return resourceIBMdlGatewayRead(ctx, d, meta)
 }
 
 func resourceIBMdlGatewayExportRouteFiltersRead(d *schema.ResourceData, meta interface{}) error {
@@ -1078,13 +1079,13 @@ func resourceIBMdlGatewayImportRouteFiltersRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -1100,7 +1101,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 
 	instance := instanceIntf.(*directlinkv1.GetGatewayResponse)
@@ -1272,7 +1273,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(dlTags, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(flex.ResourceName, *instance.Name)
@@ -1306,7 +1307,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	resourceIBMdlGatewayImportRouteFiltersRead(d, meta)
 	return nil
 }
-func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkAvailable(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayProvisioning},
@@ -1316,7 +1317,7 @@ func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -1336,11 +1337,11 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
-func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -1351,7 +1352,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if (err != nil) || (instanceIntf == nil) {
 		log.Printf("Error fetching Direct Link Gateway :%s", detail)
-		return err
+		return diag.FromErr(err)
 	}
 	instance := instanceIntf.(*directlinkv1.GetGatewayResponse)
 	gatewayPatchTemplateModel := map[string]interface{}{}
@@ -1391,7 +1392,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayAsPrepends(listGatewayAsPrependsOptions)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response))
 		}
 		etag := response.GetHeaders().Get("etag")
 		asPrependsCreateItems := make([]directlinkv1.AsPrependPrefixArrayTemplate, 0)
@@ -1430,7 +1431,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, responseRep, operationErr := directLink.ReplaceGatewayAsPrepends(replaceGatewayAsPrependsOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep)
-			return fmt.Errorf("[ERROR] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep))
 		}
 	}
 	if d.HasChange(dlExportRouteFilters) {
@@ -1439,7 +1440,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayExportRouteFilters(listGatewayExportRouteFiltersOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing the Direct Link Export Route Filters  %s\n%s", operationErr, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway Export Route Filters %s\n%s", operationErr, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Direct Link Gateway Export Route Filters %s\n%s", operationErr, response))
 		}
 		etag := response.GetHeaders().Get("etag")
 		exportRouteFiltersReplaceList := make([]directlinkv1.GatewayTemplateRouteFilter, 0)
@@ -1473,14 +1474,14 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		/* after updating the Asprepends , waiting  for
 		   gateway to move to provisioned state
 		*/
-		_, err = isWaitForDirectLinkAvailable(directLink, ID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForDirectLinkAvailable(ctx, directLink, ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_, response, err := directLink.ReplaceGatewayExportRouteFilters(replaceGatewayExportRouteFiltersOptionsModel)
 		if err != nil {
 			log.Printf("[DEBUG] Error while replacing Export Route Fileter to a gateway id %s %s\n%s", ID, err, response)
-			return fmt.Errorf("[ERROR] Error while replacing Export Route Fileter to a gateway id %s %s\n%s", ID, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while replacing Export Route Fileter to a gateway id %s %s\n%s", ID, err, response))
 		}
 	}
 
@@ -1489,7 +1490,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayImportRouteFilters(listGatewayImportRouteFiltersOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing the Direct Link Import Route Filters  %s\n%s", operationErr, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway Import Route Filters %s\n%s", operationErr, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Direct Link Gateway Import Route Filters %s\n%s", operationErr, response))
 		}
 		etag := response.GetHeaders().Get("etag")
 		importRouteFiltersReplaceList := make([]directlinkv1.GatewayTemplateRouteFilter, 0)
@@ -1523,14 +1524,14 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		/* after updating the export route filter , waiting  for
 		   gatewat to move to provisioned state
 		*/
-		_, err = isWaitForDirectLinkAvailable(directLink, ID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForDirectLinkAvailable(ctx, directLink, ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_, response, err := directLink.ReplaceGatewayImportRouteFilters(replaceGatewayImportRouteFiltersOptionsModel)
 		if err != nil {
 			log.Printf("[DEBUG] Error while replacing Import Route Fileter to a gateway id %s %s\n%s", ID, err, response)
-			return fmt.Errorf("[ERROR] Error while replacing Import Route Fileter to a gateway id %s %s\n%s", ID, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while replacing Import Route Fileter to a gateway id %s %s\n%s", ID, err, response))
 		}
 
 	}
@@ -1650,17 +1651,17 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	_, response, err := directLink.UpdateGateway(patchGatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return resourceIBMdlGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -1671,7 +1672,7 @@ func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
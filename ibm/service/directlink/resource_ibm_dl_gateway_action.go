@@ -12,21 +12,23 @@ import (
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
 func ResourceIBMDLGatewayAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayCreateAction,
-		Read:     resourceIBMdlGatewayActionRead,
-		Update:   resourceIBMdlGatewayActionUpdate,
-		Delete:   resourceIBMdlGatewayActionDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayCreateAction,
+		ReadContext:   resourceIBMdlGatewayActionRead,
+		UpdateContext: resourceIBMdlGatewayActionUpdate,
+		DeleteContext: resourceIBMdlGatewayActionDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -568,10 +570,10 @@ func ResourceIBMDLGatewayActionValidator() *validate.ResourceValidator {
 	return &ibmISDLGatewayResourceValidator
 }
 
-func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayCreateAction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createGatewayActionOptionsModel := &directlinkv1.CreateGatewayActionOptions{}
@@ -698,13 +700,13 @@ func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{})
 	}
 	gateway, response, err := directLink.CreateGatewayAction(createGatewayActionOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Direct Link Gateway Action err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[DEBUG] Direct Link Gateway Action err %s\n%s", err, response))
 	}
 
 	d.SetId(*gateway.ID)
-	_, err = isWaitForDirectLinkAvailableforAction(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForDirectLinkAvailableforAction(ctx, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(dlTags); ok || v != "" {
@@ -715,7 +717,7 @@ func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{})
 				"Error on create of resource direct link gateway %s tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMdlGatewayActionRead(d, meta)
+	return resourceIBMdlGatewayActionRead(ctx, d, meta)
 }
 func resourceIBMdlGatewayActionExportRouteFiltersRead(d *schema.ResourceData, meta interface{}) error {
 	directLink, err := directlinkClient(meta)
@@ -809,10 +811,10 @@ func resourceIBMdlGatewayActionImportRouteFiltersRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ID := d.Id()
 	getOptions := &directlinkv1.GetGatewayOptions{
@@ -825,7 +827,7 @@ func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Direct Link Gateway: %s\n%s", err, response))
 	}
 	instance := instanceIntf.(*directlinkv1.GetGatewayResponse)
 	if instance.Name != nil {
@@ -943,7 +945,7 @@ func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) er
 	d.Set(dlTags, tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(flex.ResourceName, *instance.Name)
@@ -978,7 +980,7 @@ func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func isWaitForDirectLinkAvailableforAction(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkAvailableforAction(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayProvisioning},
@@ -988,7 +990,7 @@ func isWaitForDirectLinkAvailableforAction(client *directlinkv1.DirectLinkV1, id
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isDirectLinkRefreshFuncforAction(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -1008,7 +1010,7 @@ func isDirectLinkRefreshFuncforAction(client *directlinkv1.DirectLinkV1, id stri
 	}
 }
 
-func isWaitForDirectLinkActionAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkActionAvailable(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayActionUpdate},
@@ -1018,7 +1020,7 @@ func isWaitForDirectLinkActionAvailable(client *directlinkv1.DirectLinkV1, id st
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isDirectLinkRefreshActionFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -1042,7 +1044,7 @@ func isDirectLinkRefreshActionFunc(client *directlinkv1.DirectLinkV1, id string)
 	}
 }
 
-func isWaitForDirectLinkDeleteActionAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkDeleteActionAvailable(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayDeleteActionUpdate},
@@ -1052,7 +1054,7 @@ func isWaitForDirectLinkDeleteActionAvailable(client *directlinkv1.DirectLinkV1,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isDirectLinkRefreshDeleteActionFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -1076,11 +1078,11 @@ func isDirectLinkRefreshDeleteActionFunc(client *directlinkv1.DirectLinkV1, id s
 	}
 }
 
-func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createGatewayActionOptionsModel := &directlinkv1.CreateGatewayActionOptions{}
 	action := d.Get(dlCustomerAction).(string)
@@ -1094,9 +1096,9 @@ func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if action == "update_attributes_approve" || action == "update_attributes_reject" {
-		_, err = isWaitForDirectLinkActionAvailable(directLink, gatewayId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForDirectLinkActionAvailable(ctx, directLink, gatewayId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		instanceIntf, response, err := directLink.GetGateway(getOptions)
 
@@ -1105,7 +1107,7 @@ func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{})
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway : %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Direct Link Gateway : %s\n%s", err, response))
 		}
 		instance := instanceIntf.(*directlinkv1.GetGatewayResponse)
 		if instance.ChangeRequest != nil {
@@ -1148,32 +1150,32 @@ func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{})
 		createGatewayActionOptionsModel.Updates = updateList
 		gateway, response, err := directLink.CreateGatewayAction(createGatewayActionOptionsModel)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Direct Link Gateway update_attributes_approve err %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[DEBUG] Direct Link Gateway update_attributes_approve err %s\n%s", err, response))
 		}
 		d.SetId(*gateway.ID)
-		_, err = isWaitForDirectLinkAvailableforAction(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForDirectLinkAvailableforAction(ctx, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceIBMdlGatewayActionRead(d, meta)
+		return resourceIBMdlGatewayActionRead(ctx, d, meta)
 	}
 	if action == "delete_gateway_approve" || action == "delete_gateway_reject" {
-		_, err = isWaitForDirectLinkDeleteActionAvailable(directLink, gatewayId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForDirectLinkDeleteActionAvailable(ctx, directLink, gatewayId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_, response, err := directLink.CreateGatewayAction(createGatewayActionOptionsModel)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] delete_gateway_approve failed with error  %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[DEBUG] delete_gateway_approve failed with error  %s\n%s", err, response))
 		}
 	}
 	return nil
 }
-func resourceIBMdlGatewayActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createGatewayActionOptionsModel := &directlinkv1.CreateGatewayActionOptions{}
 	action := d.Get(dlCustomerAction).(string)
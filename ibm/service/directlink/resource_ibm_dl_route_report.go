@@ -4,22 +4,25 @@
 package directlink
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMDLGatewayRouteReport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayRouteReportCreate,
-		Read:     resourceIBMDLRouteReportRead,
-		Delete:   resourceIBMdlGatewayRouteReportDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayRouteReportCreate,
+		ReadContext:   resourceIBMDLRouteReportRead,
+		DeleteContext: resourceIBMdlGatewayRouteReportDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -195,10 +198,10 @@ func ResourceIBMDLGatewayRouteReport() *schema.Resource {
 	}
 }
 
-func resourceIBMdlGatewayRouteReportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayRouteReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := d.Get(dlGatewayId).(string)
@@ -206,22 +209,22 @@ func resourceIBMdlGatewayRouteReportCreate(d *schema.ResourceData, meta interfac
 	routeReport, response, err := directLink.CreateGatewayRouteReport(createGatewayRouteReportOptionsModel)
 	if err != nil {
 		log.Println("[DEBUG] Create Route Report for DirectLink gateway", gatewayId, "err: ", err, " with response code:", response.StatusCode)
-		return fmt.Errorf("[ERROR] Create Route Report for DirectLink gateway(%s) err: %s with response code: %d", gatewayId, err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("[ERROR] Create Route Report for DirectLink gateway(%s) err: %s with response code: %d", gatewayId, err, response.StatusCode))
 	}
 
 	if routeReport == nil {
-		return fmt.Errorf("error creating route report for gateway: %s with response code: %d", gatewayId, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("error creating route report for gateway: %s with response code: %d", gatewayId, response.StatusCode))
 	} else if routeReport.ID != nil {
 		d.SetId(fmt.Sprintf("%s/%s", gatewayId, *routeReport.ID))
 		d.Set(dlRouteReportId, *routeReport.ID)
 	}
 
-	isWaitForDirectLinkGatewayRouteReportCompleted(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+	isWaitForDirectLinkGatewayRouteReportCompleted(ctx, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 
-	return resourceIBMDLRouteReportRead(d, meta)
+	return resourceIBMDLRouteReportRead(ctx, d, meta)
 }
 
-func isWaitForDirectLinkGatewayRouteReportCompleted(client *directlinkv1.DirectLinkV1, ID string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkGatewayRouteReportCompleted(ctx context.Context, client *directlinkv1.DirectLinkV1, ID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link route report to be completed for  (%s) ", ID)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlRouteReportPending},
@@ -231,7 +234,7 @@ func isWaitForDirectLinkGatewayRouteReportCompleted(client *directlinkv1.DirectL
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isDirectLinkGatewayRouteReportRefreshFunc(client *directlinkv1.DirectLinkV1, ID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -259,17 +262,17 @@ func isDirectLinkGatewayRouteReportRefreshFunc(client *directlinkv1.DirectLinkV1
 	}
 }
 
-func resourceIBMDLRouteReportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDLRouteReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Println("[Info] Fetching DL Route Report GW ID:")
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -286,11 +289,11 @@ func resourceIBMDLRouteReportRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error fetching DL Route Reports for gateway(%s) err: %s with response code  %d", gatewayId, err, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching DL Route Reports for gateway(%s) err: %s with response code  %d", gatewayId, err, response.StatusCode))
 	}
 
 	if report == nil {
-		return fmt.Errorf("error fetching route report for gateway: %s and route report: %s with response code: %d", gatewayId, routeReportId, response.StatusCode)
+		return diag.FromErr(fmt.Errorf("error fetching route report for gateway: %s and route report: %s with response code: %d", gatewayId, routeReportId, response.StatusCode))
 	}
 
 	if report.Status != nil {
@@ -404,16 +407,16 @@ func resourceIBMDLRouteReportRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMdlGatewayRouteReportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayRouteReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -428,7 +431,7 @@ func resourceIBMdlGatewayRouteReportDelete(d *schema.ResourceData, meta interfac
 		}
 
 		log.Printf("Error deleting Direct Link Route Report : %s", response)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,12 +47,12 @@ const (
 func ResourceIBMPrivateDNSGLBPool() *schema.Resource {
 	return &schema.Resource{
 
-		Create:   resourceIBMPrivateDNSGLBPoolCreate,
-		Read:     resourceIBMPrivateDNSGLBPoolRead,
-		Update:   resourceIBMPrivateDNSGLBPoolUpdate,
-		Delete:   resourceIBMPrivateDNSGLBPoolDelete,
-		Exists:   resourceIBMPrivateDNSGLBPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBPoolCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBPoolRead,
+		UpdateContext: resourceIBMPrivateDNSGLBPoolUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBPoolDelete,
+		Exists:        resourceIBMPrivateDNSGLBPoolExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -171,10 +173,10 @@ func ResourceIBMPrivateDNSGLBPool() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSGLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -209,24 +211,24 @@ func resourceIBMPrivateDNSGLBPoolCreate(d *schema.ResourceData, meta interface{}
 	result, resp, err := sess.CreatePool(CreatePoolOptions)
 	if err != nil {
 		log.Printf("create global load balancer pool failed %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *result.ID))
 
-	return resourceIBMPrivateDNSGLBPoolRead(d, meta)
+	return resourceIBMPrivateDNSGLBPoolRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getPoolOptions := sess.NewGetPoolOptions(idset[0], idset[1])
 	presponse, resp, err := sess.GetPool(getPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB Pool:%s\n%s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching pdns GLB Pool:%s\n%s", err, resp))
 	}
 
 	response := *presponse
@@ -264,10 +266,10 @@ func flattenPDNSGlbPoolOrigins(list []dns.Origin) []map[string]interface{} {
 	return origins
 }
 
-func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -314,28 +316,28 @@ func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}
 		}
 		_, detail, err := sess.UpdatePool(updatePoolOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB Pool:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating pdns GLB Pool:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBPoolRead(d, meta)
+	return resourceIBMPrivateDNSGLBPoolRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
 	DeletePoolOptions := sess.NewDeletePoolOptions(idset[0], idset[1])
 	response, err := sess.DeletePool(DeletePoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB Pool:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns GLB Pool:%s\n%s", err, response))
 	}
-	_, err = waitForPDNSGlbPoolDelete(d, meta)
+	_, err = waitForPDNSGlbPoolDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -377,7 +379,7 @@ func expandPDNSGlbPoolOrigins(originsList *schema.Set) (origins []dns.OriginInpu
 	return
 }
 
-func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForPDNSGlbPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
 		return nil, err
@@ -403,5 +405,5 @@ func waitForPDNSGlbPoolDelete(d *schema.ResourceData, meta interface{}) (interfa
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
@@ -72,7 +72,13 @@ func dataSourceIBMEventStreamsMirroringConfigRead(context context.Context, d *sc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	d.SetId(getMirroringConfigID(instanceCRN))
+	id, err := getMirroringConfigID(instanceCRN)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("getMirroringConfigID: %s", err), "ibm_event_streams_mirroring_config", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(id)
 	d.Set("resource_instance_id", instanceCRN)
 	d.Set("mirroring_topic_patterns", mirroringConfig.Includes)
 	return nil
@@ -82,13 +88,11 @@ func getMirroringConfigInstanceURL(d *schema.ResourceData, meta interface{}) (st
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if instanceCRN == "" { // importing
 		id := d.Id()
-		crnSegments := strings.Split(id, ":")
-		if len(crnSegments) != 10 || crnSegments[8] != mirroringConfigResourceType {
+		mirroringConfigCRN, err := getInstanceCRN(id, mirroringConfigResourceType)
+		if err != nil {
 			return "", "", fmt.Errorf("ID '%s' is not a mirroring config resource", id)
 		}
-		crnSegments[8] = ""
-		crnSegments[9] = ""
-		instanceCRN = strings.Join(crnSegments, ":")
+		instanceCRN = mirroringConfigCRN.ServiceInstanceCRN().String()
 		d.Set("resource_instance_id", instanceCRN)
 	}
 
@@ -106,9 +110,6 @@ func getMirroringConfigInstanceURL(d *schema.ResourceData, meta interface{}) (st
 	return adminURL, instanceCRN, nil
 }
 
-func getMirroringConfigID(instanceCRN string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = mirroringConfigResourceType
-	crnSegments[9] = ""
-	return strings.Join(crnSegments, ":")
+func getMirroringConfigID(instanceCRN string) (string, error) {
+	return getResourceCRN(instanceCRN, mirroringConfigResourceType, "")
 }
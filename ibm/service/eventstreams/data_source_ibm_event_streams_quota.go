@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -74,7 +73,13 @@ func dataSourceIBMEventStreamsQuotaRead(context context.Context, d *schema.Resou
 	d.Set("entity", entity)
 	d.Set("producer_byte_rate", getQuotaValue(quota.ProducerByteRate))
 	d.Set("consumer_byte_rate", getQuotaValue(quota.ConsumerByteRate))
-	d.SetId(getQuotaID(instanceCRN, entity))
+	id, err := getQuotaID(instanceCRN, entity)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("getQuotaID: %s", err), "ibm_event_streams_quota", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(id)
 
 	return nil
 }
@@ -92,16 +97,13 @@ func getQuotaClientInstanceEntity(d *schema.ResourceData, meta interface{}) (*ad
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if instanceCRN == "" { // importing
 		id := d.Id()
-		crnSegments := strings.Split(id, ":")
-		if len(crnSegments) != 10 || crnSegments[8] != "quota" || crnSegments[9] == "" {
+		quotaCRN, err := getInstanceCRN(id, "quota")
+		if err != nil || quotaCRN.Resource == "" {
 			return nil, "", "", fmt.Errorf("ID '%s' is not a quota resource", id)
 		}
-		entity := crnSegments[9]
-		crnSegments[8] = ""
-		crnSegments[9] = ""
-		instanceCRN = strings.Join(crnSegments, ":")
+		instanceCRN = quotaCRN.ServiceInstanceCRN().String()
 		d.Set("resource_instance_id", instanceCRN)
-		d.Set("entity", entity)
+		d.Set("entity", quotaCRN.Resource)
 	}
 
 	instance, err := getInstanceDetails(instanceCRN, meta)
@@ -113,11 +115,8 @@ func getQuotaClientInstanceEntity(d *schema.ResourceData, meta interface{}) (*ad
 	return adminrestClient, instanceCRN, d.Get("entity").(string), nil
 }
 
-func getQuotaID(instanceCRN string, entity string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = "quota"
-	crnSegments[9] = entity
-	return strings.Join(crnSegments, ":")
+func getQuotaID(instanceCRN string, entity string) (string, error) {
+	return getResourceCRN(instanceCRN, "quota", entity)
 }
 
 // admin-rest API returns nil for undefined rate, convert that to -1
@@ -66,7 +66,12 @@ func dataSourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	uniqueID := getUniqueSchemaID(instanceCRN, schemaID)
+	uniqueID, err := getUniqueSchemaID(instanceCRN, schemaID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsSchemaRead getUniqueSchemaID: %s", err), "ibm_event_streams_schema", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 
 	d.SetId(uniqueID)
 	d.Set("resource_instance_id", instanceCRN)
@@ -76,7 +76,13 @@ func dataSourceIBMEventStreamsSchemaGlobalCompatibilityRuleRead(context context.
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	d.SetId(getSchemaGlobalCompatibilityRuleID(instanceCRN))
+	id, err := getSchemaGlobalCompatibilityRuleID(instanceCRN)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("getSchemaGlobalCompatibilityRuleID: %s", err), "ibm_event_streams_schema_global_rule", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(id)
 	d.Set("resource_instance_id", instanceCRN)
 	d.Set("config", *rule.Config)
 	return nil
@@ -86,13 +92,11 @@ func getSchemaRuleInstanceURL(d *schema.ResourceData, meta interface{}) (string,
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if instanceCRN == "" { // importing
 		id := d.Id()
-		crnSegments := strings.Split(id, ":")
-		if len(crnSegments) != 10 || crnSegments[8] != schemaGlobalCompatibilityRuleResourceType {
+		ruleCRN, err := getInstanceCRN(id, schemaGlobalCompatibilityRuleResourceType)
+		if err != nil {
 			return "", "", fmt.Errorf("ID '%s' is not a schema global compatibility resource", id)
 		}
-		crnSegments[8] = ""
-		crnSegments[9] = ""
-		instanceCRN = strings.Join(crnSegments, ":")
+		instanceCRN = ruleCRN.ServiceInstanceCRN().String()
 		d.Set("resource_instance_id", instanceCRN)
 	}
 
@@ -110,9 +114,6 @@ func getSchemaRuleInstanceURL(d *schema.ResourceData, meta interface{}) (string,
 	return adminURL, instanceCRN, nil
 }
 
-func getSchemaGlobalCompatibilityRuleID(instanceCRN string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = schemaGlobalCompatibilityRuleResourceType
-	crnSegments[9] = ""
-	return strings.Join(crnSegments, ":")
+func getSchemaGlobalCompatibilityRuleID(instanceCRN string) (string, error) {
+	return getResourceCRN(instanceCRN, schemaGlobalCompatibilityRuleResourceType, "")
 }
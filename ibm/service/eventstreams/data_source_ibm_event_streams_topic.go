@@ -68,7 +68,12 @@ func dataSourceIBMEventStreamsTopicRead(context context.Context, d *schema.Resou
 	topicName := d.Get("name").(string)
 	for name := range topics {
 		if name == topicName {
-			topicID := getTopicID(instanceCRN, topicName)
+			topicID, err := getTopicID(instanceCRN, topicName)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("dataSourceIBMEventStreamsTopicRead getTopicID: %s", err), "ibm_event_streams_topic", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
 			d.SetId(topicID)
 			log.Printf("[INFO]dataSourceIBMEventStreamsTopicRead set topic ID to %s", topicID)
 			d.Set("resource_instance_id", instanceCRN)
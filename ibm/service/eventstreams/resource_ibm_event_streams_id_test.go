@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"testing"
+)
+
+const (
+	testInstanceCRN = "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835::"
+	testTopicID     = "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:topic:my-topic"
+	testSchemaID    = "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:schema:tf-schema"
+)
+
+func TestGetResourceCRN(t *testing.T) {
+	cases := []struct {
+		name     string
+		id       func(string) (string, error)
+		expected string
+	}{
+		{"topic", func(c string) (string, error) { return getTopicID(c, "my-topic") }, testTopicID},
+		{"schema", func(c string) (string, error) { return getUniqueSchemaID(c, "tf-schema") }, testSchemaID},
+		{"quota", func(c string) (string, error) { return getQuotaID(c, "default") }, "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:quota:default"},
+		{"mirroring config", getMirroringConfigID, "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:mirroring-config:"},
+		{"global compatibility rule", getSchemaGlobalCompatibilityRuleID, "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:schema-global-compatibility-rule:"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			id, err := c.id(testInstanceCRN)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, id)
+			}
+		})
+	}
+
+	if _, err := getTopicID("not-a-crn", "my-topic"); err == nil {
+		t.Errorf("expected an error for an invalid instance CRN")
+	}
+}
+
+func TestGetInstanceCRN(t *testing.T) {
+	c, err := getInstanceCRN(testTopicID, "topic")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if instanceCRN := c.ServiceInstanceCRN().String(); instanceCRN != testInstanceCRN {
+		t.Errorf("expected %q, got %q", testInstanceCRN, instanceCRN)
+	}
+
+	if _, err := getInstanceCRN(testTopicID, "quota"); err == nil {
+		t.Errorf("expected an error for a resource of another type")
+	}
+	if _, err := getInstanceCRN("topic:my-topic", "topic"); err == nil {
+		t.Errorf("expected an error for an ID that is not a CRN")
+	}
+}
+
+func TestGetTopicName(t *testing.T) {
+	topicName, err := getTopicName(testTopicID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if topicName != "my-topic" {
+		t.Errorf("expected %q, got %q", "my-topic", topicName)
+	}
+}
+
+func TestGetSchemaID(t *testing.T) {
+	schemaID, err := getSchemaID(testSchemaID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schemaID != "tf-schema" {
+		t.Errorf("expected %q, got %q", "tf-schema", schemaID)
+	}
+
+	if _, err := getSchemaID(testTopicID); err == nil {
+		t.Errorf("expected an error for a topic ID")
+	}
+}
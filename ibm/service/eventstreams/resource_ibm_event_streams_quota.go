@@ -83,7 +83,13 @@ func resourceIBMEventStreamsQuotaCreate(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	d.SetId(getQuotaID(instanceCRN, entity))
+	id, err := getQuotaID(instanceCRN, entity)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("getQuotaID: %s", err), "ibm_event_streams_quota", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(id)
 
 	return resourceIBMEventStreamsQuotaRead(context, d, meta)
 }
@@ -115,7 +121,13 @@ func resourceIBMEventStreamsQuotaRead(context context.Context, d *schema.Resourc
 	d.Set("entity", entity)
 	d.Set("producer_byte_rate", getQuotaValue(quota.ProducerByteRate))
 	d.Set("consumer_byte_rate", getQuotaValue(quota.ConsumerByteRate))
-	d.SetId(getQuotaID(instanceCRN, entity))
+	id, err := getQuotaID(instanceCRN, entity)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("getQuotaID: %s", err), "ibm_event_streams_quota", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(id)
 
 	return nil
 }
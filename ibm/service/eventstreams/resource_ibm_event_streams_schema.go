@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
@@ -203,7 +204,12 @@ func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	uniqueID := getUniqueSchemaID(instanceCRN, *schemaMetadata.ID)
+	uniqueID, err := getUniqueSchemaID(instanceCRN, *schemaMetadata.ID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsSchemaCreate getUniqueSchemaID: %s", err), "ibm_event_streams_schema", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(uniqueID)
 
 	return resourceIBMEventStreamsSchemaRead(context, d, meta)
@@ -227,7 +233,12 @@ func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Resour
 
 	getSchemaOptions := &schemaregistryv1.GetLatestSchemaOptions{}
 
-	schemaID := getSchemaID(d.Id())
+	schemaID, err := getSchemaID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsSchemaRead getSchemaID: %s", err), "ibm_event_streams_schema", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	getSchemaOptions.SetID(schemaID)
 
 	avroSchema, response, err := schemaregistryClient.GetLatestSchemaWithContext(context, getSchemaOptions)
@@ -344,12 +355,12 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, error) {
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
-		schemaID := d.Id()
-		if len(schemaID) == 0 || !strings.Contains(schemaID, ":") {
+		schemaCRN, err := crn.Parse(d.Id())
+		if err != nil {
 			log.Printf("[DEBUG] getInstanceURL resource_instance_id is missing")
 			return "", "", fmt.Errorf("resource_instance_id is required")
 		}
-		instanceCRN = getInstanceCRN(schemaID)
+		instanceCRN = schemaCRN.ServiceInstanceCRN().String()
 	}
 
 	instance, err := getInstanceDetails(instanceCRN, meta)
@@ -390,13 +401,14 @@ func getInstanceDetails(crn string, meta interface{}) (*resourcecontrollerv2.Res
 	return instance, nil
 }
 
-func getUniqueSchemaID(instanceCRN string, schemaID string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = "schema"
-	crnSegments[9] = schemaID
-	return strings.Join(crnSegments, ":")
+func getUniqueSchemaID(instanceCRN string, schemaID string) (string, error) {
+	return getResourceCRN(instanceCRN, "schema", schemaID)
 }
 
-func getSchemaID(id string) string {
-	return strings.Split(id, ":")[9]
+func getSchemaID(id string) (string, error) {
+	schemaCRN, err := getInstanceCRN(id, "schema")
+	if err != nil {
+		return "", err
+	}
+	return schemaCRN.Resource, nil
 }
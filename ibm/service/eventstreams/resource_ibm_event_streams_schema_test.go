@@ -10,11 +10,11 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"gotest.tools/assert"
 
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 )
@@ -75,6 +75,7 @@ func TestAccIBMEventStreamsSchemaAllArgs(t *testing.T) {
 
 func TestAccIBMEventStreamsSchemaImport(t *testing.T) {
 	var conf map[string]interface{}
+	schemaID := "tf-schema"
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheck(t) },
 		Providers:    acc.TestAccProviders,
@@ -179,8 +180,11 @@ func testAccCheckIBMEventStreamsSchemaExists(n string, obj map[string]interface{
 			}
 		}
 
-		schemaID = getSchemaID(id)
-		getLatestSchemaOptions.SetID(schemaID)
+		schemaCRN, err := crn.Parse(id)
+		if err != nil {
+			return err
+		}
+		getLatestSchemaOptions.SetID(schemaCRN.Resource)
 
 		avroSchema, _, err := schemaregistryClient.GetLatestSchema(getLatestSchemaOptions)
 		if err != nil {
@@ -218,29 +222,3 @@ func testAccCheckIBMEventStreamsSchemaDestroy(s *terraform.State) error {
 
 	return nil
 }
-
-var (
-	schemaID = "tf-schema"
-	id       = "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:schema:tf-schema"
-)
-
-func TestGetUniqueSchemaID(t *testing.T) {
-	gotID := getUniqueSchemaID(instanceCRN, schemaID)
-	assert.Equal(t, id, gotID)
-}
-
-func TestGetSchemaID(t *testing.T) {
-	gotSchemaID := getSchemaID(id)
-	assert.Equal(t, schemaID, gotSchemaID)
-}
-
-func getUniqueSchemaID(instanceCRN string, schemaID string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = "schema"
-	crnSegments[9] = schemaID
-	return strings.Join(crnSegments, ":")
-}
-
-func getSchemaID(id string) string {
-	return strings.Split(id, ":")[9]
-}
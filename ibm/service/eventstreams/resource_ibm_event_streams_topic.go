@@ -13,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -145,7 +146,13 @@ func resourceIBMEventStreamsTopicCreate(context context.Context, d *schema.Resou
 					return tfErr.GetDiag()
 				}
 				if exists {
-					d.SetId(getTopicID(instanceCRN, topicName))
+					topicID, err := getTopicID(instanceCRN, topicName)
+					if err != nil {
+						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsTopicCreate getTopicID %s: %s", topicName, err), "ibm_event_streams_topic", "create")
+						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+						return tfErr.GetDiag()
+					}
+					d.SetId(topicID)
 					return resourceIBMEventStreamsTopicRead(context, d, meta)
 				}
 			}
@@ -155,7 +162,13 @@ func resourceIBMEventStreamsTopicCreate(context context.Context, d *schema.Resou
 		return tfErr.GetDiag()
 	}
 	log.Printf("[INFO] resourceIBMEventStreamsTopicCreate CreateTopic: topic is %s, detail is %v", topicName, topicDetail)
-	d.SetId(getTopicID(instanceCRN, topicName))
+	topicID, err := getTopicID(instanceCRN, topicName)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsTopicCreate getTopicID %s: %s", topicName, err), "ibm_event_streams_topic", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(topicID)
 	return resourceIBMEventStreamsTopicRead(context, d, meta)
 }
 
@@ -167,8 +180,12 @@ func resourceIBMEventStreamsTopicRead(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	topicID := d.Id()
-	topicName := getTopicName(topicID)
+	topicName, err := getTopicName(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsTopicRead getTopicName: %s", err), "ibm_event_streams_topic", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIBMEventStreamsTopicRead ListTopics: %s", err), "ibm_event_streams_topic", "read")
@@ -270,12 +287,12 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	}
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
-		topicID := d.Id()
-		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
+		topicCRN, err := crn.Parse(d.Id())
+		if err != nil {
 			log.Printf("[DEBUG] createSaramaAdminClient resource_instance_id is missing")
 			return nil, "", fmt.Errorf("resource_instance_id is required")
 		}
-		instanceCRN = getInstanceCRN(topicID)
+		instanceCRN = topicCRN.ServiceInstanceCRN().String()
 	}
 	instance, err := getInstanceDetails(instanceCRN, meta)
 	if err != nil {
@@ -342,22 +359,37 @@ func config2TopicDetail(config map[string]interface{}) map[string]*string {
 	return configEntries
 }
 
-func getTopicID(instanceCRN string, topicName string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = "topic"
-	crnSegments[9] = topicName
-	return strings.Join(crnSegments, ":")
+func getTopicID(instanceCRN string, topicName string) (string, error) {
+	return getResourceCRN(instanceCRN, "topic", topicName)
+}
+
+func getTopicName(topicID string) (string, error) {
+	topicCRN, err := crn.Parse(topicID)
+	if err != nil {
+		return "", err
+	}
+	return topicCRN.Resource, nil
 }
 
-func getTopicName(topicID string) string {
-	return strings.Split(topicID, ":")[9]
+// getResourceCRN returns the CRN of a resource of the Event Streams instance
+// instanceCRN, which the resources and data sources of the package use as
+// their ID.
+func getResourceCRN(instanceCRN string, resourceType string, resource string) (string, error) {
+	c, err := crn.Parse(instanceCRN)
+	if err != nil {
+		return "", err
+	}
+	return c.WithResource(resourceType, resource).String(), nil
 }
 
-func getInstanceCRN(topicID string) string {
-	crnSegments := strings.Split(topicID, ":")
-	crnSegments[8] = ""
-	crnSegments[9] = ""
-	return strings.Join(crnSegments, ":")
+// getInstanceCRN returns the CRN of the Event Streams instance of a resource,
+// which must be of the given type.
+func getInstanceCRN(id string, resourceType string) (crn.CRN, error) {
+	c, err := crn.Parse(id)
+	if err != nil || c.ResourceType != resourceType {
+		return crn.CRN{}, fmt.Errorf("ID '%s' is not a %s resource", id, resourceType)
+	}
+	return c, nil
 }
 
 type accessTokenProvider struct {
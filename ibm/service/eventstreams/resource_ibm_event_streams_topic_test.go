@@ -13,11 +13,11 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"gotest.tools/assert"
 
 	"github.com/IBM-Cloud/bluemix-go/models"
 )
@@ -279,7 +279,8 @@ resource "ibm_resource_instance" "es_instance" {
 
 func createEventStreamsMirroringConfig(serviceName, targetCrn string) string {
 	//get target guid from target crn
-	targetGuid := strings.Split(targetCrn, ":")[7]
+	target, _ := crn.Parse(targetCrn)
+	targetGuid := target.ServiceInstance
 	return fmt.Sprintf(`
 resource "ibm_iam_authorization_policy" "instance_policy" {
   source_service_name         = "%s"
@@ -397,41 +398,3 @@ func testAccCheckIBMEventStreamsTopicExists(n, topicName string) resource.TestCh
 		return fmt.Errorf("topic %s not found", topicName)
 	}
 }
-
-var (
-	instanceCRN = "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835::"
-	topicID     = "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:topic:" + getTestTopicName()
-)
-
-func TestGetTopicID(t *testing.T) {
-	gotTopicID := getTopicID(instanceCRN, getTestTopicName())
-	assert.Equal(t, topicID, gotTopicID)
-}
-
-func TestGetInstanceCRN(t *testing.T) {
-	gotInstanceCRN := getInstanceCRN(topicID)
-	assert.Equal(t, instanceCRN, gotInstanceCRN)
-}
-
-func TestGetTopicName(t *testing.T) {
-	gotTopicName := getTopicName(topicID)
-	assert.Equal(t, getTestTopicName(), gotTopicName)
-}
-
-func getTopicID(instanceCRN string, topicName string) string {
-	crnSegments := strings.Split(instanceCRN, ":")
-	crnSegments[8] = "topic"
-	crnSegments[9] = topicName
-	return strings.Join(crnSegments, ":")
-}
-
-func getTopicName(topicID string) string {
-	return strings.Split(topicID, ":")[9]
-}
-
-func getInstanceCRN(topicID string) string {
-	crnSegments := strings.Split(topicID, ":")
-	crnSegments[8] = ""
-	crnSegments[9] = ""
-	return strings.Join(crnSegments, ":")
-}
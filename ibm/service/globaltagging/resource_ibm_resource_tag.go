@@ -212,7 +212,7 @@ func resourceIBMResourceTagCreate(context context.Context, d *schema.ResourceDat
 				return diag.FromErr(flex.FmtErrorf("Error while creating tag: %s - Full response: %s", string(output), fullResponse))
 			}
 		}
-		response, errored := flex.WaitForTagsAvailable(meta, resourceID, resourceType, tagType, news, d.Timeout(schema.TimeoutCreate))
+		response, errored := flex.WaitForTagsAvailable(context, meta, resourceID, resourceType, tagType, news, d.Timeout(schema.TimeoutCreate))
 		if errored != nil {
 			log.Printf(`[ERROR] Error waiting for resource tags %s : %v
 %v`, resourceID, errored, response)
@@ -297,7 +297,7 @@ func resourceIBMResourceTagUpdate(context context.Context, d *schema.ResourceDat
 
 	if _, ok := d.GetOk(tags); ok {
 		oldList, newList := d.GetChange(tags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, rID, rType, tType)
 		if err != nil {
 			return diag.FromErr(flex.FmtErrorf("Error on create of resource tags: %s", err))
 		}
@@ -328,7 +328,7 @@ func resourceIBMResourceTagDelete(context context.Context, d *schema.ResourceDat
 		tType = "user"
 	}
 	if len(removeTags.List()) > 0 {
-		err := flex.UpdateGlobalTagsUsingCRN(removeTags, nil, meta, rID, rType, tType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, removeTags, nil, meta, rID, rType, tType)
 		if err != nil {
 			return diag.FromErr(flex.FmtErrorf("Error on deleting tags: %s", err))
 		}
@@ -198,7 +198,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentCreate(context context.Context,
 
 	d.SetId(*templateAssignmentResponse.ID)
 
-	_, err = waitForAssignment(d.Timeout(schema.TimeoutCreate), meta, d, isAccessGroupTemplateAssigned)
+	_, err = waitForAssignment(context, d.Timeout(schema.TimeoutCreate), meta, d, isAccessGroupTemplateAssigned)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error assigning %s", err))
 	}
@@ -309,7 +309,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
 		}
-		waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		waitForAssignment(context, d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
 	}
 
 	return resourceIBMIAMAccessGroupTemplateAssignmentRead(context, d, meta)
@@ -331,14 +331,14 @@ func resourceIBMIAMAccessGroupTemplateAssignmentDelete(context context.Context,
 		return diag.FromErr(fmt.Errorf("DeleteAssignmentWithContext failed %s\n%s", err, response))
 	}
 
-	waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	waitForAssignment(context, d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
 
 	d.SetId("")
 
 	return nil
 }
 
-func waitForAssignment(timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
+func waitForAssignment(ctx context.Context, timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{InProgress},
@@ -349,7 +349,7 @@ func waitForAssignment(timeout time.Duration, meta interface{}, d *schema.Resour
 		Timeout:      timeout,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isAccessGroupTemplateAssigned(id string, meta interface{}) resource.StateRefreshFunc {
@@ -317,7 +317,7 @@ func resourceIBMAccountSettingsTemplateAssignmentCreate(context context.Context,
 
 	d.SetId(*templateAssignmentResponse.ID)
 
-	_, err = waitForAssignment(d.Timeout(schema.TimeoutCreate), meta, d, isAccountSettingsTemplateAssigned)
+	_, err = waitForAssignment(context, d.Timeout(schema.TimeoutCreate), meta, d, isAccountSettingsTemplateAssigned)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error assigning %s", err))
 	}
@@ -446,7 +446,7 @@ func resourceIBMAccountSettingsTemplateAssignmentUpdate(context context.Context,
 			return diag.FromErr(fmt.Errorf("UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
 		}
 
-		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccountSettingsTemplateAssigned)
+		_, err = waitForAssignment(context, d.Timeout(schema.TimeoutUpdate), meta, d, isAccountSettingsTemplateAssigned)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error assigning %s", err))
 		}
@@ -471,7 +471,7 @@ func resourceIBMAccountSettingsTemplateAssignmentDelete(context context.Context,
 		return diag.FromErr(fmt.Errorf("DeleteAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
 	}
 
-	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccountSettingsAssignmentRemoved)
+	_, err = waitForAssignment(context, d.Timeout(schema.TimeoutDelete), meta, d, isAccountSettingsAssignmentRemoved)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error removing assignment %s", err))
 	}
@@ -395,7 +395,7 @@ func resourceIBMTrustedProfileTemplateAssignmentCreate(context context.Context,
 
 	d.SetId(*templateAssignmentResponse.ID)
 
-	_, err = waitForAssignment(d.Timeout(schema.TimeoutCreate), meta, d, isTrustedProfileTemplateAssigned)
+	_, err = waitForAssignment(context, d.Timeout(schema.TimeoutCreate), meta, d, isTrustedProfileTemplateAssigned)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error assigning %s", err))
 	}
@@ -526,7 +526,7 @@ func resourceIBMTrustedProfileTemplateAssignmentUpdate(context context.Context,
 			return diag.FromErr(fmt.Errorf("UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
 		}
 
-		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isTrustedProfileTemplateAssigned)
+		_, err = waitForAssignment(context, d.Timeout(schema.TimeoutUpdate), meta, d, isTrustedProfileTemplateAssigned)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error assigning %s", err))
 		}
@@ -550,7 +550,7 @@ func resourceIBMTrustedProfileTemplateAssignmentDelete(context context.Context,
 		return diag.FromErr(fmt.Errorf("[DEBUG] DeleteTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
 	}
 
-	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isTrustedProfileAssignmentRemoved)
+	_, err = waitForAssignment(context, d.Timeout(schema.TimeoutDelete), meta, d, isTrustedProfileAssignmentRemoved)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error removing assignment %s", err))
 	}
@@ -560,7 +560,7 @@ func resourceIBMTrustedProfileTemplateAssignmentDelete(context context.Context,
 	return nil
 }
 
-func waitForAssignment(timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
+func waitForAssignment(ctx context.Context, timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{InProgress},
 		Target:       []string{complete},
@@ -570,7 +570,7 @@ func waitForAssignment(timeout time.Duration, meta interface{}, d *schema.Resour
 		Timeout:      timeout,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTrustedProfileAssignmentRemoved(id string, meta interface{}) resource.StateRefreshFunc {
@@ -275,7 +275,7 @@ func resourceIBMActionControlAssignmentCreate(context context.Context, d *schema
 	if targetModel.Type != nil && (*targetModel.Type == "Account") {
 		log.Printf("[DEBUG] Skipping waitForAssignment for target type: %s", *targetModel.Type)
 	} else {
-		_, err = waitForAssignment(d.Timeout(schema.TimeoutCreate), meta, d, isActionControlAssignmentAssigned)
+		_, err = waitForAssignment(context, d.Timeout(schema.TimeoutCreate), meta, d, isActionControlAssignmentAssigned)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error assigning: %s", err))
 		}
@@ -434,7 +434,7 @@ func resourceIBMActionControlAssignmentUpdate(context context.Context, d *schema
 		if targetModel.Type != nil && (*targetModel.Type == "Account") {
 			log.Printf("[DEBUG] Skipping waitForAssignment for target type: %s", *targetModel.Type)
 		} else {
-			_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isActionControlAssignmentAssigned)
+			_, err = waitForAssignment(context, d.Timeout(schema.TimeoutUpdate), meta, d, isActionControlAssignmentAssigned)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error assigning: %s", err))
 			}
@@ -471,7 +471,7 @@ func resourceIBMActionControlAssignmentDelete(context context.Context, d *schema
 	if targetModel.Type != nil && (*targetModel.Type == "Account") {
 		log.Printf("[DEBUG] Skipping waitForAssignment for target type: %s", *targetModel.Type)
 	} else {
-		_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessActionControlAssignedDeleted)
+		_, err = waitForAssignment(context, d.Timeout(schema.TimeoutDelete), meta, d, isAccessActionControlAssignedDeleted)
 		if err != nil {
 			if strings.Contains(err.Error(), "not found") {
 				return nil
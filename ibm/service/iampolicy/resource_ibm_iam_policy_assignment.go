@@ -294,7 +294,7 @@ func resourceIBMPolicyAssignmentCreate(context context.Context, d *schema.Resour
 	if targetModel.Type != nil && (*targetModel.Type == "Account") {
 		log.Printf("[DEBUG] Skipping waitForAssignment for target type: %s", *targetModel.Type)
 	} else {
-		_, err = waitForAssignment(d.Timeout(schema.TimeoutCreate), meta, d, isAccessPolicyAssigned)
+		_, err = waitForAssignment(context, d.Timeout(schema.TimeoutCreate), meta, d, isAccessPolicyAssigned)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error assigning: %s", err))
 		}
@@ -447,7 +447,7 @@ func resourceIBMPolicyAssignmentUpdate(context context.Context, d *schema.Resour
 		if targetModel.Type != nil && (*targetModel.Type == "Account") {
 			log.Printf("[DEBUG] Skipping waitForAssignment for target type: %s", *targetModel.Type)
 		} else {
-			_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessPolicyAssigned)
+			_, err = waitForAssignment(context, d.Timeout(schema.TimeoutUpdate), meta, d, isAccessPolicyAssigned)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error assigning: %s", err))
 			}
@@ -489,7 +489,7 @@ func resourceIBMPolicyAssignmentDelete(context context.Context, d *schema.Resour
 	if targetModel.Type != nil && (*targetModel.Type == "Account") {
 		log.Printf("[DEBUG] Skipping waitForAssignment for target type: %s", *targetModel.Type)
 	} else {
-		_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessPolicyAssignedDeleted)
+		_, err = waitForAssignment(context, d.Timeout(schema.TimeoutDelete), meta, d, isAccessPolicyAssignedDeleted)
 		if err != nil {
 			if strings.Contains(err.Error(), "not found") {
 				return nil
@@ -547,7 +547,7 @@ func GetTargetModel(d *schema.ResourceData) (*iampolicymanagementv1.AssignmentTa
 	return targetModel, nil
 }
 
-func waitForAssignment(timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
+func waitForAssignment(ctx context.Context, timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{InProgress},
@@ -558,7 +558,7 @@ func waitForAssignment(timeout time.Duration, meta interface{}, d *schema.Resour
 		Timeout:      timeout,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isAccessPolicyAssigned(id string, meta interface{}) resource.StateRefreshFunc {
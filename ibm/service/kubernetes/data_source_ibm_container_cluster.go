@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,13 +13,14 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DataSourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterRead,
+		ReadContext: dataSourceIBMContainerClusterRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -386,10 +388,10 @@ func DataSourceIBMContainerClusterValidator() *validate.ResourceValidator {
 	iBMContainerClusterValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster", Schema: validateSchema}
 	return &iBMContainerClusterValidator
 }
-func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csAPI := csClient.Clusters()
 	wrkAPI := csClient.Workers()
@@ -398,7 +400,7 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var name string
@@ -421,26 +423,26 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	clusterFields, err := csAPI.Find(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster: %s", err))
 	}
 
 	d.SetId(clusterFields.ID)
 
 	if timeoutStage != "" {
-		err = waitForCluster(d, timeoutStage, timeout, meta)
+		err = waitForCluster(ctx, d, timeoutStage, timeout, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		clusterFields, err = csAPI.Find(name, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster after waitForCluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster after waitForCluster: %s", err))
 		}
 	}
 
 	workerFields, err := wrkAPI.List(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -452,7 +454,7 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 	if listBoundedServices {
 		servicesBoundToCluster, err := csAPI.ListServicesBoundToCluster(name, "", targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving services bound to cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving services bound to cluster: %s", err))
 		}
 		for _, service := range servicesBoundToCluster {
 			boundedService := make(map[string]interface{})
@@ -466,12 +468,12 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s", name, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s", name, err))
 	}
 
 	albs, err := albsAPI.ListClusterALBs(name, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") {
-		return fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", name, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", name, err))
 	}
 
 	filterType := d.Get("alb_type").(string)
@@ -498,7 +500,7 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	apikeyAPI := csClient.Apikeys()
@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,7 +24,7 @@ const (
 
 func DataSourceIBMContainerVPCCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterVPCRead,
+		ReadContext: dataSourceIBMContainerClusterVPCRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -370,15 +372,15 @@ func DataSourceIBMContainerVPCClusterValidator() *validate.ResourceValidator {
 	iBMContainerVPCClusterValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_cluster", Schema: validateSchema}
 	return &iBMContainerVPCClusterValidator
 }
-func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var clusterNameOrID string
 
@@ -400,20 +402,20 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 
 	cls, err := csClient.Clusters().GetCluster(clusterNameOrID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving container vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving container vpc cluster: %s", err))
 	}
 
 	d.SetId(cls.ID)
 
 	if timeoutStage != "" {
-		err = waitForVpcCluster(d, meta, timeoutStage, timeout)
+		err = waitForVpcCluster(ctx, d, meta, timeoutStage, timeout)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cls, err = csClient.Clusters().GetCluster(clusterNameOrID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving container vpc cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving container vpc cluster: %s", err))
 		}
 	}
 
@@ -442,7 +444,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 
 	workerFields, err := csClient.Workers().ListWorkers(clusterNameOrID, false, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -454,7 +456,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	//Get worker pools
 	pools, err := csClient.WorkerPools().ListWorkerPools(clusterNameOrID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving worker pools for container vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving worker pools for container vpc cluster: %s", err))
 	}
 
 	d.Set("worker_pools", flex.FlattenVpcWorkerPools(pools))
@@ -462,7 +464,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	if !strings.HasSuffix(cls.MasterKubeVersion, _OPENSHIFT) {
 		albs, err := csClient.Albs().ListClusterAlbs(clusterNameOrID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterNameOrID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterNameOrID, err))
 		}
 
 		filterType := d.Get("alb_type").(string)
@@ -478,16 +480,16 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	d.Set("tags", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClientv1, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apikeyAPI := csClientv1.Apikeys()
 	v1targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	apikeyConfig, err := apikeyAPI.GetApiKeyInfo(clusterNameOrID, v1targetEnv)
 	if err != nil {
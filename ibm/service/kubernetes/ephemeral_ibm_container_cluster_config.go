@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	yaml "gopkg.in/yaml.v3"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &clusterConfigEphemeralResource{}
+)
+
+// clusterConfigEphemeralResource fetches the connection details of a
+// Kubernetes, OpenShift or Satellite cluster when Terraform opens it. Unlike
+// the ibm_container_cluster_config data source, the kubeconfig is read in
+// memory and the credentials are not written to disk, the plan or the state.
+type clusterConfigEphemeralResource struct {
+	client conns.ClientSession
+}
+
+func EphemeralIBMContainerClusterConfig() ephemeral.EphemeralResource {
+	return &clusterConfigEphemeralResource{}
+}
+
+func (r *clusterConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_container_cluster_config"
+}
+
+func (r *clusterConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the connection details of a cluster without writing the kubeconfig to disk or persisting the credentials in state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/id of the cluster",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true will fetch the config for admin",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "It can specify what kind of server URL will be used for the cluster context",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the API server of the cluster.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The CA certificate of the cluster.",
+			},
+			"admin_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client certificate, when admin is set.",
+			},
+			"admin_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client key, when admin is set.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token to authenticate to the cluster.",
+			},
+		},
+	}
+}
+
+func (r *clusterConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// The provider is not configured when the configuration is validated.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected conns.ClientSession, got %T.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *clusterConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var name, resourceGroupId, endpointType types.String
+	var admin types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_name_id"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resource_group_id"), &resourceGroupId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("admin"), &admin)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_type"), &endpointType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	csClient, err := r.client.VpcContainerAPI()
+	if err != nil {
+		resp.Diagnostics.Append(flex.FrameworkErrorDiagnostic(err))
+		return
+	}
+	targetEnv := v2.ClusterTargetHeader{
+		ResourceGroup: resourceGroupId.ValueString(),
+	}
+
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = getClusterConfigDetailInMemory(csClient, name.ValueString(), admin.ValueBool(), targetEnv, endpointType.ValueString())
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.Append(flex.FrameworkErrorDiagnostic(flex.FmtErrorf("[ERROR] Error fetching the cluster config [%s]: %s", name.ValueString(), err)))
+		return
+	}
+
+	values := map[string]interface{}{
+		"cluster_name_id":   name,
+		"resource_group_id": resourceGroupId,
+		"admin":             admin,
+		"endpoint_type":     endpointType,
+		"host":              clusterKeyDetails.Host,
+		"ca_certificate":    clusterKeyDetails.ClusterCACertificate,
+		"admin_certificate": clusterKeyDetails.Admin,
+		"admin_key":         clusterKeyDetails.AdminKey,
+		"token":             clusterKeyDetails.Token,
+	}
+	for attribute, value := range values {
+		resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
+
+// clusterConfigPoster is implemented by the container service client, which
+// embeds the REST client of bluemix-go.
+type clusterConfigPoster interface {
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+// clusterConfigClusters is implemented by the clusters client of bluemix-go,
+// which GetClusterConfigDetail uses to read the cluster and to log in to
+// OpenShift clusters.
+type clusterConfigClusters interface {
+	FindWithOutShowResourcesCompatible(name string, target v2.ClusterTargetHeader) (v2.ClusterInfo, error)
+	FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool, endpointType string) ([]byte, string, error)
+}
+
+// getClusterConfigDetailInMemory returns the same details as
+// GetClusterConfigDetail of bluemix-go, which extracts the kubeconfig archive
+// into a directory. The archive is read in memory instead.
+func getClusterConfigDetailInMemory(csClient v2.ContainerServiceAPI, name string, admin bool, target v2.ClusterTargetHeader, endpointType string) (v1.ClusterKeyInfo, error) {
+	clusterkey := v1.ClusterKeyInfo{}
+	clusters, ok := csClient.Clusters().(clusterConfigClusters)
+	if !ok {
+		return clusterkey, fmt.Errorf("[ERROR] The clusters client %T does not support fetching the cluster config", csClient.Clusters())
+	}
+	clusterInfo, err := clusters.FindWithOutShowResourcesCompatible(name, target)
+	if err != nil {
+		return clusterkey, err
+	}
+
+	poster, ok := csClient.(clusterConfigPoster)
+	if !ok {
+		return clusterkey, fmt.Errorf("[ERROR] The container service client %T does not support fetching the cluster config", csClient)
+	}
+	postBody := map[string]interface{}{
+		"cluster": name,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if clusterInfo.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	} else if endpointType != "" {
+		postBody["endpointType"] = endpointType
+	}
+	var archive bytes.Buffer
+	if _, err = poster.Post("/v2/applyRBACAndGetKubeconfig", postBody, &archive, target.ToMap()); err != nil {
+		return clusterkey, err
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil {
+		return clusterkey, fmt.Errorf("[ERROR] Error reading the cluster config archive: %s", err)
+	}
+	var kubeconfig []byte
+	for _, file := range zipReader.File {
+		fileName := filepath.Base(file.Name)
+		if file.FileInfo().IsDir() || !(strings.HasSuffix(fileName, ".pem") || strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml")) {
+			continue
+		}
+		content, err := readClusterConfigArchiveFile(file)
+		if err != nil {
+			return clusterkey, err
+		}
+		switch {
+		case fileName == "admin-key.pem":
+			clusterkey.AdminKey = string(content)
+		case fileName == "admin.pem":
+			clusterkey.Admin = string(content)
+		case strings.HasPrefix(fileName, "ca") && strings.HasSuffix(fileName, ".pem"):
+			clusterkey.ClusterCACertificate = string(content)
+		case strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml"):
+			kubeconfig = content
+		}
+	}
+	if kubeconfig == nil {
+		return clusterkey, fmt.Errorf("[ERROR] Unable to locate kube config in zip archive")
+	}
+
+	var yamlConfig v1.ConfigFile
+	if err = yaml.Unmarshal(kubeconfig, &yamlConfig); err != nil {
+		return clusterkey, fmt.Errorf("[ERROR] Error parsing the kube config: %s", err)
+	}
+	if len(yamlConfig.Clusters) != 0 {
+		clusterkey.Host = yamlConfig.Clusters[0].Cluster.Server
+	}
+	if len(yamlConfig.Users) != 0 {
+		clusterkey.Token = yamlConfig.Users[0].User.AuthProvider.Config.IDToken
+	}
+
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		kubeconfig, clusterkey.Host, err = clusters.FetchOCTokenForKubeConfig(kubeconfig, &clusterInfo, clusterInfo.IsStagingSatelliteCluster(), endpointType)
+		if err != nil {
+			return clusterkey, err
+		}
+		var openshiftConfig v1.ConfigFileOpenshift
+		if err = yaml.Unmarshal(kubeconfig, &openshiftConfig); err != nil {
+			return clusterkey, fmt.Errorf("[ERROR] Error parsing the kube config: %s", err)
+		}
+		for _, user := range openshiftConfig.Users {
+			if strings.HasPrefix(user.Name, "IAM") {
+				clusterkey.Token = user.User.Token
+			}
+		}
+		clusterkey.ClusterCACertificate = ""
+	}
+	return clusterkey, nil
+}
+
+func readClusterConfigArchiveFile(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading %s from the cluster config archive: %s", file.Name, err)
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
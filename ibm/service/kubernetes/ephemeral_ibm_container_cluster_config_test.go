@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMContainerClusterConfigEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMContainerClusterConfigEphemeralBasic(),
+				// The ephemeral outputs are not saved in state, so they are checked by the postconditions of the config
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_container_vpc_cluster.cluster", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterConfigEphemeralBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_container_vpc_cluster" "cluster" {
+			name              = "%[1]s"
+			resource_group_id = "%[2]s"
+		}
+
+		ephemeral "ibm_container_cluster_config" "cluster" {
+			cluster_name_id   = data.ibm_container_vpc_cluster.cluster.id
+			resource_group_id = "%[2]s"
+			admin             = true
+
+			lifecycle {
+				postcondition {
+					condition     = startswith(self.host, "https://")
+					error_message = "host is not the URL of the API server"
+				}
+				postcondition {
+					condition     = strcontains(self.ca_certificate, "BEGIN CERTIFICATE")
+					error_message = "ca_certificate is not a certificate"
+				}
+				postcondition {
+					condition     = strcontains(self.admin_certificate, "BEGIN CERTIFICATE")
+					error_message = "admin_certificate is not a certificate"
+				}
+				postcondition {
+					condition     = strcontains(self.admin_key, "PRIVATE KEY")
+					error_message = "admin_key is not a private key"
+				}
+			}
+		}
+
+		ephemeral "ibm_container_cluster_config" "cluster_token" {
+			cluster_name_id   = data.ibm_container_vpc_cluster.cluster.id
+			resource_group_id = "%[2]s"
+
+			lifecycle {
+				postcondition {
+					condition     = startswith(self.host, "https://")
+					error_message = "host is not the URL of the API server"
+				}
+				postcondition {
+					condition     = self.token != null && self.token != ""
+					error_message = "token is empty"
+				}
+				postcondition {
+					condition     = self.admin_key == null || self.admin_key == ""
+					error_message = "admin_key is set without admin"
+				}
+			}
+		}
+	`, acc.IksClusterID, acc.IksClusterResourceGroupID)
+}
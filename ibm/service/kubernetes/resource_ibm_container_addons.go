@@ -5,12 +5,14 @@ package kubernetes
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	yaml "gopkg.in/yaml.v3"
@@ -24,12 +26,12 @@ import (
 
 func ResourceIBMContainerAddOns() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerAddOnsCreate,
-		Read:     resourceIBMContainerAddOnsRead,
-		Update:   resourceIBMContainerAddOnsUpdate,
-		Delete:   resourceIBMContainerAddOnsDelete,
-		Exists:   resourceIBMContainerAddOnsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerAddOnsCreate,
+		ReadContext:   resourceIBMContainerAddOnsRead,
+		UpdateContext: resourceIBMContainerAddOnsUpdate,
+		DeleteContext: resourceIBMContainerAddOnsDelete,
+		Exists:        resourceIBMContainerAddOnsExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -165,16 +167,16 @@ func ResourceIBMContainerAddOnsValidator() *validate.ResourceValidator {
 	iBMContainerAddOnsValidator := validate.ResourceValidator{ResourceName: "ibm_container_addons", Schema: validateSchema}
 	return &iBMContainerAddOnsValidator
 }
-func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cluster := d.Get("cluster").(string)
@@ -186,22 +188,22 @@ func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{})
 
 	payload, err := expandAddOns(d, meta, cluster, targetEnv, existingAddons)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Create: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Create: %s", err))
 	}
 	payload.Enable = true
 
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+	_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during create (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during create (%s) : %s", d.Id(), err))
 	}
 	d.SetId(cluster)
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(ctx, d, meta)
 }
 
 // Function to get an Add On's Parameters and Set them with the users value
@@ -413,23 +415,23 @@ func updateAddOnVersion(d *schema.ResourceData, meta interface{}, u map[string]i
 	return nil
 }
 
-func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 
 	result, err := addOnAPI.GetAddons(cluster, targetEnv)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("cluster", cluster)
 	var addOns *schema.Set
@@ -526,7 +528,7 @@ func flattenAddOns(result []v1.AddOn) (resp *schema.Set, err error) {
 
 	return schema.NewSet(resourceIBMContainerAddonsHash, addOns), nil
 }
-func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	manageAllAddons := d.Get("manage_all_addons").(bool)
 	managed_addons := d.Get("managed_addons").([]interface{})
 	var addOnName []string
@@ -549,13 +551,13 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 
@@ -586,7 +588,7 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						// This block upgrates addon version if addon has `allowed_upgrade_versions`
 						err := updateAddOnVersion(d, meta, newPack, cluster, targetEnv)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						ns.Remove(nA)
 						os.Remove(oA)
@@ -604,7 +606,7 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						rmParams.Enable = false
 						_, err = addOnAPI.ConfigureAddons(cluster, &rmParams, targetEnv)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error uninstalling addon %s  on %s during update : %s", d.Id(), oldPack["name"], err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error uninstalling addon %s  on %s during update : %s", d.Id(), oldPack["name"], err))
 						}
 						//Install AddOn with new version
 						addParams := v1.ConfigureAddOns{}
@@ -618,13 +620,13 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						addParams.Enable = true
 						_, err = addOnAPI.ConfigureAddons(cluster, &addParams, targetEnv)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error installing addon %s  on %s during update : %s", d.Id(), newPack["name"], err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error installing addon %s  on %s during update : %s", d.Id(), newPack["name"], err))
 						}
 						ns.Remove(nA)
 						os.Remove(oA)
 
 					} else {
-						return fmt.Errorf("[ERROR] The given addon is not provided with upgradable or updatable version")
+						return diag.FromErr(fmt.Errorf("[ERROR] The given addon is not provided with upgradable or updatable version"))
 					}
 				}
 			}
@@ -651,7 +653,7 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = true
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(remove) > 0 {
@@ -673,12 +675,12 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = false
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
-		_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutUpdate)
+		_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during update (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during update (%s) : %s", d.Id(), err))
 		}
 	}
 
@@ -688,35 +690,35 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 		d.Set("managed_addons", nil)
 	}
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(ctx, d, meta)
 }
 
-func resourceIBMContainerAddOnsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := d.Id()
 	payload, err := expandAddOns(d, meta, cluster, targetEnv, nil)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Destroy %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in getting addons from expandAddOns during Destroy %s", err))
 	}
 
 	payload.Enable = false
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
-func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
+func waitForContainerAddOns(ctx context.Context, d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
 	addOnClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
@@ -750,7 +752,7 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 
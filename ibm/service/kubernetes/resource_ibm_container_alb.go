@@ -4,10 +4,12 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,11 +20,11 @@ import (
 
 func ResourceIBMContainerALB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCreate,
-		Read:     resourceIBMContainerALBRead,
-		Update:   resourceIBMContainerALBUpdate,
-		Delete:   resourceIBMContainerALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCreate,
+		ReadContext:   resourceIBMContainerALBRead,
+		UpdateContext: resourceIBMContainerALBUpdate,
+		DeleteContext: resourceIBMContainerALBDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -96,10 +98,10 @@ func ResourceIBMContainerALB() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var userIP string
 	var enable, disableDeployment bool
@@ -109,7 +111,7 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("disable_deployment"); ok {
 		disableDeployment = v.(bool)
 	} else {
-		return fmt.Errorf("[ERROR] Provide either `enable` or `disable_deployment`")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `enable` or `disable_deployment`"))
 	}
 
 	numOfInstances := "2"
@@ -125,33 +127,33 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 		params.ALBIP = userIP
 	}
 
-	_, err = waitForClusterAvailable(d, meta, albID)
+	_, err = waitForClusterAvailable(ctx, d, meta, albID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err))
 	}
 
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(albID)
-	_, err = waitForContainerALB(d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
+	_, err = waitForContainerALB(ctx, d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource alb (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource alb (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(ctx, d, meta)
 }
 
-func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	albID := d.Id()
@@ -159,11 +161,11 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albConfig, err := albAPI.GetALB(albID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("alb_type", albConfig.ALBType)
@@ -178,10 +180,10 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -196,28 +198,28 @@ func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) err
 
 		targetEnv, err := getAlbTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = waitForClusterAvailable(d, meta, albID)
+		_, err = waitForClusterAvailable(ctx, d, meta, albID)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err))
 		}
 
 		err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		_, err = waitForContainerALB(d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
+		_, err = waitForContainerALB(ctx, d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for updating resource alb (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for updating resource alb (%s) : %s", d.Id(), err))
 		}
 
 	}
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(ctx, d, meta)
 }
 
-func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
+func waitForContainerALB(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
 	albClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
@@ -254,17 +256,17 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func resourceIBMContainerALBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
+func waitForClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -293,7 +295,7 @@ func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID str
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func getAlbTargetHeader(d *schema.ResourceData, meta interface{}) (v1.ClusterTargetHeader, error) {
 	var region string
@@ -4,10 +4,12 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -20,12 +22,12 @@ import (
 
 func ResourceIBMContainerALBCert() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCertCreate,
-		Read:     resourceIBMContainerALBCertRead,
-		Update:   resourceIBMContainerALBCertUpdate,
-		Delete:   resourceIBMContainerALBCertDelete,
-		Exists:   resourceIBMContainerALBCertExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCertCreate,
+		ReadContext:   resourceIBMContainerALBCertRead,
+		UpdateContext: resourceIBMContainerALBCertUpdate,
+		DeleteContext: resourceIBMContainerALBCertDelete,
+		Exists:        resourceIBMContainerALBCertExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -116,10 +118,10 @@ func ResourceIBMContainerALBCertValidator() *validate.ResourceValidator {
 	iBMContainerALBCertValidator := validate.ResourceValidator{ResourceName: "ibm_container_alb_cert", Schema: validateSchema}
 	return &iBMContainerALBCertValidator
 }
-func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certCRN := d.Get("cert_crn").(string)
@@ -142,25 +144,25 @@ func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{})
 	response, err := ingressAPI.CreateIngressSecret(params)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, response.Namespace))
-	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	_, err = waitForContainerALBCert(ctx, d, meta, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource alb cert (%s) : %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource alb cert (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(ctx, d, meta)
 }
 
-func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -172,7 +174,7 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	ingressAPI := ingressClient.Ingresses()
 	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterID, secretName, namespace))
 	d.Set("cluster_id", ingressSecretConfig.Cluster)
@@ -189,17 +191,17 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ingressAPI := ingressClient.Ingresses()
 
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -215,17 +217,17 @@ func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{})
 
 	err = ingressAPI.DeleteIngressSecret(params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	_, albCertDeletionError := waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	_, albCertDeletionError := waitForALBCertDelete(ctx, d, meta, schema.TimeoutDelete)
 	if albCertDeletionError != nil {
-		return albCertDeletionError
+		return diag.FromErr(albCertDeletionError)
 	}
 	d.SetId("")
 	return nil
 }
 
-func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForALBCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -263,17 +265,17 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	secretName := parts[1]
@@ -295,15 +297,15 @@ func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{})
 		ingressAPI := ingressClient.Ingresses()
 		_, err = ingressAPI.UpdateIngressSecret(params)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		_, err = waitForContainerALBCert(ctx, d, meta, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for updating resource alb cert (%s) : %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for updating resource alb cert (%s) : %s", d.Id(), err))
 		}
 	}
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(ctx, d, meta)
 }
 
 func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -338,7 +340,7 @@ func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{})
 	return ingressSecretConfig.Cluster == clusterID && ingressSecretConfig.Name == secretName, nil
 }
 
-func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForContainerALBCert(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -383,5 +385,5 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
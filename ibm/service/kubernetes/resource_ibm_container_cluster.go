@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,12 +61,12 @@ const PUBLIC_SUBNET_TYPE = "public"
 
 func ResourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterCreate,
-		Read:     resourceIBMContainerClusterRead,
-		Update:   resourceIBMContainerClusterUpdate,
-		Delete:   resourceIBMContainerClusterDelete,
-		Exists:   resourceIBMContainerClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterCreate,
+		ReadContext:   resourceIBMContainerClusterRead,
+		UpdateContext: resourceIBMContainerClusterUpdate,
+		DeleteContext: resourceIBMContainerClusterDelete,
+		Exists:        resourceIBMContainerClusterExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -601,15 +602,15 @@ func ResourceIBMContainerClusterValidator() *validate.ResourceValidator {
 	return &ibmContainerClusterResourceValidator
 }
 
-func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClientV2, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -659,10 +660,10 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 				params.PrivateEndpointEnabled = v.(bool)
 				params.GatewayEnabled = gatewayEnabled
 			} else {
-				return fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters")
+				return diag.FromErr(fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters"))
 			}
 		} else {
-			return fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters")
+			return diag.FromErr(fmt.Errorf("[ERROR] set private_service_endpoint to true for gateway_enabled clusters"))
 		}
 	}
 	if v, ok := d.GetOk("kube_version"); ok {
@@ -680,35 +681,35 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(cls.ID)
 
 	targetEnvV2, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if imageSecurityEnabled {
 		err = csClientV2.Clusters().EnableImageSecurityEnforcement(cls.ID, targetEnvV2)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	_, err = waitForClusterMasterAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, err = waitForClusterMasterAvailable(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	timeoutStage := strings.ToLower(d.Get("wait_till").(string))
-	err = waitForCluster(d, timeoutStage, d.Timeout(schema.TimeoutCreate), meta)
+	err = waitForCluster(ctx, d, timeoutStage, d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("force_delete_storage", d.Get("force_delete_storage").(bool))
@@ -717,11 +718,11 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 	workerPoolsAPI := csClient.WorkerPools()
 	workerPools, err := workerPoolsAPI.ListWorkerPools(cls.ID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(workerPools) == 0 || !workerPoolContains(workerPools, defaultWorkerPool) {
-		return fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes")
+		return diag.FromErr(fmt.Errorf("[ERROR] The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes"))
 	}
 
 	labels := make(map[string]string)
@@ -732,7 +733,7 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 	}
 	err = workerPoolsAPI.UpdateLabelsWorkerPool(cls.ID, defaultWorkerPool, labels, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating the labels %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels %s", err))
 	}
 
 	//taints
@@ -741,29 +742,29 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 		taints = taintRes.(*schema.Set).List()
 	}
 	if err := updateWorkerpoolTaints(d, meta, cls.ID, defaultWorkerPool, taints); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMContainerClusterUpdate(d, meta)
+	return resourceIBMContainerClusterUpdate(ctx, d, meta)
 }
 
-func waitForCluster(d *schema.ResourceData, timeoutStage string, timeout time.Duration, meta interface{}) error {
+func waitForCluster(ctx context.Context, d *schema.ResourceData, timeoutStage string, timeout time.Duration, meta interface{}) error {
 	switch timeoutStage {
 	case strings.ToLower(masterNodeReady):
-		_, err := waitForClusterMasterAvailable(d, meta, timeout)
+		_, err := waitForClusterMasterAvailable(ctx, d, meta, timeout)
 		if err != nil {
 			return err
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
-		_, err := waitForClusterOneWorkerAvailable(d, meta, timeout)
+		_, err := waitForClusterOneWorkerAvailable(ctx, d, meta, timeout)
 		if err != nil {
 			return err
 		}
 
 	case clusterNormal:
 		pendingStates := []string{clusterDeploying, clusterRequested, clusterPending, clusterDeployed, clusterCritical, clusterWarning}
-		_, err := waitForClusterState(d, meta, clusterNormal, pendingStates, timeout)
+		_, err := waitForClusterState(ctx, d, meta, clusterNormal, pendingStates, timeout)
 		if err != nil {
 			return err
 		}
@@ -772,10 +773,10 @@ func waitForCluster(d *schema.ResourceData, timeoutStage string, timeout time.Du
 	return nil
 }
 
-func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	wrkAPI := csClient.Workers()
 	workerPoolsAPI := csClient.WorkerPools()
@@ -783,18 +784,18 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err))
 	}
 
 	workerFields, err := wrkAPI.List(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 	}
 	workerCount := 0
 	workers := []map[string]string{}
@@ -812,7 +813,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var poolName string
 	var poolContains bool
@@ -827,14 +828,14 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	if poolContains {
 		workersByPool, err := wrkAPI.ListByWorkerPool(clusterID, poolName, false, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err))
 		}
 
 		// to get the private and public vlan IDs of the gateway enabled cluster.
 		if poolName == computeWorkerPool {
 			gatewayWorkersByPool, err := wrkAPI.ListByWorkerPool(clusterID, gatewayWorkerpool, false, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers of default worker pool for cluster: %s", err))
 			}
 			d.Set("public_vlan_id", gatewayWorkersByPool[0].PublicVlan)
 			d.Set("private_vlan_id", gatewayWorkersByPool[0].PrivateVlan)
@@ -864,7 +865,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 		defaultWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterID, poolName, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("labels", flex.IgnoreSystemLabels(defaultWorkerPool.Labels))
 		d.Set("operating_system", defaultWorkerPool.OperatingSystem)
@@ -881,7 +882,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	albs, err := albsAPI.ListClusterALBs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") {
 
-		return fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -914,7 +915,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("tags", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("image_security_enforcement", cls.ImageSecurityEnabled)
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
@@ -925,26 +926,26 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	csClientV2, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnvV2, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	subnetAPI := csClient.Subnets()
@@ -968,11 +969,11 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			_, err = WaitForClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 		// "update_all_workers" deafult is false, enable to true when all worker nodes to be updated
@@ -981,7 +982,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 			workerFields, err := csClientV2.Workers().ListAllWorkers(clusterID, false, targetEnvV2)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 			}
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
@@ -989,7 +990,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			for _, w := range workerFields {
 				workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, w.PoolID, targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err))
 				}
 
 				/*kubeversion update done if
@@ -1003,13 +1004,13 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 					err = wrkAPI.Update(clusterID, w.ID, params, targetEnv)
 					if err != nil {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error updating worker %s: %s", w.ID, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error updating worker %s: %s", w.ID, err))
 					}
 					if waitForWorkerUpdate {
-						_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+						_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 						if err != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 						}
 					}
 				}
@@ -1054,7 +1055,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1077,22 +1078,22 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 				if strings.Compare(newPack["version"].(string), oldPack["version"].(string)) != 0 {
 					cluster, err := clusterAPI.Find(clusterID, targetEnv)
 					if err != nil {
-						return fmt.Errorf("[ERROR] workers_info Error retrieving cluster %s: %s", clusterID, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] workers_info Error retrieving cluster %s: %s", clusterID, err))
 					}
 					if newPack["version"].(string) != strings.Split(cluster.MasterKubeVersion, "_")[0] {
-						return fmt.Errorf("[ERROR] Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0])
+						return diag.FromErr(fmt.Errorf("[ERROR] Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0]))
 					}
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}
 					err = wrkAPI.Update(clusterID, oldPack["id"].(string), params, targetEnv)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error updating worker %s: %s", oldPack["id"].(string), err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error updating worker %s: %s", oldPack["id"].(string), err))
 					}
 
-					_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+					_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 					}
 				}
 			}
@@ -1138,14 +1139,14 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		newSubnet := newSubnets.(*schema.Set)
 		rem := oldSubnet.Difference(newSubnet).List()
 		if len(rem) > 0 {
-			return fmt.Errorf("Subnet(s) %v cannot be deleted", rem)
+			return diag.FromErr(fmt.Errorf("Subnet(s) %v cannot be deleted", rem))
 		}
 		metro := d.Get("datacenter").(string)
 		//from datacenter retrive the metro for filtering the subnets
 		metro = metro[0:3]
 		subnets, err := subnetAPI.List(targetEnv, metro)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, nS := range newSubnet.List() {
 			exists := false
@@ -1157,7 +1158,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			if !exists {
 				err := subnetAPI.AddSubnet(clusterID, nS.(string), targetEnv)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				subnet := getSubnet(subnets, nS.(string))
 				if subnet.Type == PUBLIC_SUBNET_TYPE {
@@ -1167,9 +1168,9 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if publicSubnetAdded && d.Get("wait_till").(string) == ingressReady {
-		_, err = WaitForSubnetAvailable(d, meta, targetEnv)
+		_, err = WaitForSubnetAvailable(ctx, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for initializing ingress hostname and secret: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for initializing ingress hostname and secret: %s", err))
 		}
 	}
 
@@ -1178,7 +1179,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] tags Error retrieving cluster %s: %s", clusterID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] tags Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -1200,7 +1201,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMContainerClusterRead(d, meta)
+	return resourceIBMContainerClusterRead(ctx, d, meta)
 }
 
 func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorkers []interface{}, workerInfo []map[string]string) (string, error) {
@@ -1238,30 +1239,30 @@ func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorker
 	return "", fmt.Errorf("[ERROR] Unable to get ID of worker")
 }
 
-func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := d.Id()
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting cluster: %s", err))
 	}
-	_, err = waitForClusterDelete(d, meta)
+	_, err = waitForClusterDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1290,11 +1291,11 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 // waitForClusterMasterAvailable Waits for cluster creation
-func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1324,10 +1325,10 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForClusterState(d *schema.ResourceData, meta interface{}, waitForState string, pendingState []string, timeout time.Duration) (interface{}, error) {
+func waitForClusterState(ctx context.Context, d *schema.ResourceData, meta interface{}, waitForState string, pendingState []string, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1361,11 +1362,11 @@ func waitForClusterState(d *schema.ResourceData, meta interface{}, waitForState
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 // waitForClusterOneWorkerAvailable Waits for cluster creation
-func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1420,11 +1421,11 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{},
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1441,7 +1442,7 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1463,7 +1464,7 @@ func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.Clus
 	}
 }
 
-func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSubnetAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1480,7 +1481,7 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1497,7 +1498,7 @@ func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Res
 }
 
 // WaitForClusterVersionUpdate Waits for cluster creation
-func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1515,7 +1516,7 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 		ContinuousTargetOccurence: 3,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
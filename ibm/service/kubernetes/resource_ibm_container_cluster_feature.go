@@ -4,11 +4,13 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,11 +29,11 @@ const (
 
 func ResourceIBMContainerClusterFeature() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterFeatureCreate,
-		Read:     resourceIBMContainerClusterFeatureRead,
-		Update:   resourceIBMContainerClusterFeatureUpdate,
-		Delete:   resourceIBMContainerClusterFeatureDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterFeatureCreate,
+		ReadContext:   resourceIBMContainerClusterFeatureRead,
+		UpdateContext: resourceIBMContainerClusterFeatureUpdate,
+		DeleteContext: resourceIBMContainerClusterFeatureDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -111,24 +113,24 @@ func ResourceIBMContainerClusterFeatureValidator() *validate.ResourceValidator {
 	return &iBMContainerClusterFeatureValidator
 }
 
-func resourceIBMContainerClusterFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cluster := d.Get("cluster").(string)
 	var isOptionSet bool
 
 	if v, ok := d.GetOkExists("private_service_endpoint"); ok {
 		if v.(bool) {
-			err := updateCluster(cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutCreate), d, meta)
+			err := updateCluster(ctx, cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutCreate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
-			return fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled")
+			return diag.FromErr(fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled"))
 		}
 		d.SetId(cluster)
-		err := reloadCluster(cluster, d.Timeout(schema.TimeoutCreate), d, meta)
+		err := reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		isOptionSet = true
 	}
@@ -141,25 +143,25 @@ func resourceIBMContainerClusterFeatureCreate(d *schema.ResourceData, meta inter
 			cmd = disablePublicSECmdAction
 		}
 		log.Printf("Started enabling the public ep %s", cmd)
-		err := updateCluster(cluster, cmd, d.Timeout(schema.TimeoutCreate), d, meta)
+		err := updateCluster(ctx, cluster, cmd, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(cluster)
-		err = reloadCluster(cluster, d.Timeout(schema.TimeoutCreate), d, meta)
+		err = reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutCreate), d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		isOptionSet = true
 	}
 
 	if !isOptionSet {
-		return fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both"))
 	}
-	return resourceIBMContainerClusterFeatureRead(d, meta)
+	return resourceIBMContainerClusterFeatureRead(ctx, d, meta)
 }
 
-func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
+func reloadCluster(ctx context.Context, cluster string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -179,12 +181,12 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	if v, ok := d.GetOkExists("reload_workers"); ok {
 		if v.(bool) {
 			log.Printf("Waiting for cluster (%s) to be available.", cluster)
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", cluster, err)
 			}
 			log.Printf("Waiting for workers (%s) to be available.", cluster)
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", cluster, err)
 			}
@@ -203,11 +205,11 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 			if err != nil {
 				return err
 			}
-			_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForClusterAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 			}
-			_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+			_, err = WaitForWorkerAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
 			}
@@ -217,7 +219,7 @@ func reloadCluster(cluster string, timeout time.Duration, d *schema.ResourceData
 	return nil
 }
 
-func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
+func updateCluster(ctx context.Context, cluster, actionCmd string, timeout time.Duration, d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return err
@@ -230,12 +232,12 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 		return err
 	}
 	log.Printf("Waiting for cluster (%s) to be available.", cluster)
-	_, err = WaitForClusterAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForClusterAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for cluster (%s) to become ready: %s", d.Id(), err)
 	}
 	log.Printf("Waiting for workers (%s) to be available.", cluster)
-	_, err = WaitForWorkerAvailableForFeatureUpdate(cluster, timeout, meta, targetEnv)
+	_, err = WaitForWorkerAvailableForFeatureUpdate(ctx, cluster, timeout, meta, targetEnv)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
 	}
@@ -249,20 +251,20 @@ func updateCluster(cluster, actionCmd string, timeout time.Duration, d *schema.R
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving armada cluster: %s", err))
 	}
 
 	d.Set("cluster", clusterID)
@@ -274,28 +276,28 @@ func resourceIBMContainerClusterFeatureRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cluster := d.Get("cluster").(string)
 	var isOptionSet bool
 	if d.HasChange("private_service_endpoint") {
 		if v, ok := d.GetOkExists("private_service_endpoint"); ok {
 			if v.(bool) {
-				err := updateCluster(cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutUpdate), d, meta)
+				err := updateCluster(ctx, cluster, enablePrivateSECmdAction, d.Timeout(schema.TimeoutUpdate), d, meta)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
-				return fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled")
+				return diag.FromErr(fmt.Errorf("[ERROR] The `private_service_endpoint` can not be disabled"))
 			}
-			err := reloadCluster(cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err := reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			isOptionSet = true
 		}
@@ -308,27 +310,27 @@ func resourceIBMContainerClusterFeatureUpdate(d *schema.ResourceData, meta inter
 			} else {
 				cmd = disablePublicSECmdAction
 			}
-			err := updateCluster(cluster, cmd, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err := updateCluster(ctx, cluster, cmd, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = reloadCluster(cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
+			err = reloadCluster(ctx, cluster, d.Timeout(schema.TimeoutUpdate), d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			isOptionSet = true
 		}
 	}
 
 	if !isOptionSet {
-		return fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both")
+		return diag.FromErr(fmt.Errorf("[ERROR] Provide either `public_service_endpoint` or  `private_service_endpoint` or both"))
 	}
 
-	return resourceIBMContainerClusterFeatureRead(d, meta)
+	return resourceIBMContainerClusterFeatureRead(ctx, d, meta)
 }
 
 // WaitForClusterAvailableForFeatureUpdate Waits for cluster creation
-func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailableForFeatureUpdate(ctx context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -345,7 +347,7 @@ func WaitForClusterAvailableForFeatureUpdate(cluster string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -365,7 +367,7 @@ func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.Cl
 	}
 }
 
-func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailableForFeatureUpdate(ctx context.Context, cluster string, timeout time.Duration, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -382,5 +384,5 @@ func WaitForWorkerAvailableForFeatureUpdate(cluster string, timeout time.Duratio
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
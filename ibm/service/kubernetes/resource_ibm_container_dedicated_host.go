@@ -401,7 +401,7 @@ func waitForDedicatedHostAvailable(ctx context.Context, dedicatedHostAPI v2.Dedi
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func waitForDedicatedHostRemove(ctx context.Context, dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
@@ -417,7 +417,7 @@ func waitForDedicatedHostRemove(ctx context.Context, dedicatedHostAPI v2.Dedicat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func dedicatedHostStateRefreshFunc(dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -447,7 +447,7 @@ func waitForDedicatedHostPlacement(ctx context.Context, dedicatedHostAPI v2.Dedi
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func dedicatedHostPlacementRefreshFunc(dedicatedHostAPI v2.DedicatedHost, hostID, hostPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
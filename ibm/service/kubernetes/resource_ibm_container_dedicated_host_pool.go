@@ -270,7 +270,7 @@ func waitForDedicatedHostPoolAvailable(ctx context.Context, dedicatedHostPoolAPI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func waitForDedicatedHostPoolRemove(ctx context.Context, dedicatedHostPoolAPI v2.DedicatedHostPool, hostPoolID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
@@ -286,7 +286,7 @@ func waitForDedicatedHostPoolRemove(ctx context.Context, dedicatedHostPoolAPI v2
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func dedicatedHostPoolStateRefreshFunc(dedicatedHostPoolAPI v2.DedicatedHostPool, hostPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
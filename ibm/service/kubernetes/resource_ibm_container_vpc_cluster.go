@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,12 +43,12 @@ const (
 
 func ResourceIBMContainerVpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcClusterCreate,
-		Read:     resourceIBMContainerVpcClusterRead,
-		Update:   resourceIBMContainerVpcClusterUpdate,
-		Delete:   resourceIBMContainerVpcClusterDelete,
-		Exists:   resourceIBMContainerVpcClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcClusterCreate,
+		ReadContext:   resourceIBMContainerVpcClusterRead,
+		UpdateContext: resourceIBMContainerVpcClusterUpdate,
+		DeleteContext: resourceIBMContainerVpcClusterDelete,
+		Exists:        resourceIBMContainerVpcClusterExists,
+		Importer:      &schema.ResourceImporter{},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -56,6 +57,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.OnlyInUpdateDiff([]string{EnableSecureByDefaultFlag}, diff)
 			},
+			resourceIBMContainerVpcClusterUpgradeCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -189,6 +191,12 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			workerUpdateStrategy: resourceIBMContainerVpcClusterWorkerUpdateStrategySchema(),
+
+			managedUpgrade: resourceIBMContainerVpcClusterManagedUpgradeSchema(),
+
+			upgradeStatus: resourceIBMContainerVpcClusterUpgradeStatusSchema(),
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -543,13 +551,13 @@ func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
 	return &ibmContainerVpcClusteresourceValidator
 }
 
-func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	vpcProvider := "vpc-gen2"
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	disablePublicServiceEndpoint := d.Get("disable_public_service_endpoint").(bool)
@@ -657,12 +665,12 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(cls.ID)
@@ -670,13 +678,13 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 	if imageSecurityEnabled {
 		err = csClient.Clusters().EnableImageSecurityEnforcement(cls.ID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = waitForVpcCluster(d, meta, timeoutStage, d.Timeout(schema.TimeoutCreate))
+	err = waitForVpcCluster(ctx, d, meta, timeoutStage, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var taints []interface{}
@@ -684,22 +692,22 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 		taints = taintRes.(*schema.Set).List()
 	}
 	if err := updateWorkerpoolTaints(d, meta, cls.ID, "default", taints); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMContainerVpcClusterUpdate(d, meta)
+	return resourceIBMContainerVpcClusterUpdate(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
@@ -709,7 +717,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -758,10 +766,10 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			if err != nil {
 				log.Printf(
 					"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-				return err
+				return diag.FromErr(err)
 			}
 			if waitForApply {
-				waitForVpcClusterMasterKMSApply(d, meta)
+				waitForVpcClusterMasterKMSApply(ctx, d, meta)
 			}
 		}
 	}
@@ -769,37 +777,44 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange(DisableOutboundTrafficProtectionFlag) || d.HasChange(EnableSecureByDefaultFlag) {
 		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		Env, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if d.HasChange(DisableOutboundTrafficProtectionFlag) {
 			outbound_traffic_protection := !d.Get(DisableOutboundTrafficProtectionFlag).(bool)
 			if err := ClusterClient.VPCs().SetOutboundTrafficProtection(clusterID, outbound_traffic_protection, Env); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if d.HasChange(EnableSecureByDefaultFlag) {
 			enableSecureByDefault := d.Get(EnableSecureByDefaultFlag).(bool)
 			if err := ClusterClient.VPCs().EnableSecureByDefault(clusterID, enableSecureByDefault, Env); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
 
 	}
 
+	managed := len(d.Get(managedUpgrade).([]interface{})) > 0
+	if managed && (d.HasChange("kube_version") || d.HasChange(upgradeStatus)) && !d.IsNewResource() {
+		if err := upgradeVpcCluster(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
 
-		if d.HasChange("kube_version") {
+		if d.HasChange("kube_version") && !managed {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			var masterVersion string
 			if v, ok := d.GetOk("kube_version"); ok {
@@ -814,25 +829,25 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			Env, err := getClusterTargetHeader(d, meta)
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			Error := ClusterClient.Clusters().Update(clusterID, params, Env)
 			if Error != nil {
-				return Error
+				return diag.FromErr(Error)
 			}
-			_, err = waitForVpcClusterVersionUpdate(d, meta, targetEnv)
+			_, err = waitForVpcClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 
 		csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		clusterID := d.Id()
@@ -842,13 +857,21 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		workersInfo := make(map[string]int)
 
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		strategy, batched := expandVpcClusterWorkerUpdateStrategy(d)
+		if batched && (updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) {
+			if err := updateVpcClusterWorkersInBatches(ctx, d, csClient, targetEnv, strategy); err != nil {
+				// Leave a diff behind so the next apply resumes the rollout with the workers that are left.
+				d.Set("patch_version", nil)
+				d.Set("update_all_workers", false)
+				return diag.FromErr(err)
+			}
+		} else if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 
 			// patchVersion := d.Get("patch_version").(string)
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 			if err != nil {
 				d.Set("patch_version", nil)
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 			}
 
 			for index, worker := range workers {
@@ -861,7 +884,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			for _, worker := range workers {
 				workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, worker.PoolID, targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err))
 				}
 
 				// check if change is present in MAJOR.MINOR version or in PATCH version
@@ -870,40 +893,40 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 					// As API returns http response 204 NO CONTENT, error raised will be exempted.
 					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err))
 					}
 
 					if waitForWorkerUpdate {
 						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+						_, deleteError := waitForWorkerNodetoDelete(ctx, d, meta, targetEnv, worker.ID)
 						if deleteError != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
+							return diag.FromErr(fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID))
 						}
 
 						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
+						_, newWorkerError := waitForNewWorker(ctx, d, meta, targetEnv, workersCount)
 						if newWorkerError != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Failed to spawn new worker node")
+							return diag.FromErr(fmt.Errorf("[ERROR] Failed to spawn new worker node"))
 						}
 
 						//3. Get new worker node ID and update the map
 						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
 						if newNodeError != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Unable to find the new worker node info")
+							return diag.FromErr(fmt.Errorf("[ERROR] Unable to find the new worker node info"))
 						}
 
 						delete(workersInfo, worker.ID)
 						workersInfo[newWorkerID] = index
 
 						//4. wait for the worker's version update and normal state
-						_, Err := waitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, newWorkerID)
+						_, Err := waitForVpcClusterWokersVersionUpdate(ctx, d, meta, targetEnv, newWorkerID)
 						if Err != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
+							return diag.FromErr(fmt.Errorf(
+								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err))
 						}
 					}
 				}
@@ -931,31 +954,31 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return resourceIBMContainerVpcClusterRead(d, meta)
+	return resourceIBMContainerVpcClusterRead(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	albsAPI := csClient.Albs()
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	albs, err := albsAPI.ListClusterAlbs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") {
-		return fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -995,7 +1018,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	d.Set("tags", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(flex.ResourceName, cls.Name)
@@ -1006,15 +1029,15 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := d.Id()
 
@@ -1036,11 +1059,11 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting cluster: %s", err))
 	}
-	_, err = waitForVpcClusterDelete(d, meta)
+	_, err = waitForVpcClusterDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sess1, err := vpcClient(meta)
@@ -1055,7 +1078,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 				if strings.Contains(*lb.Name, clusterID) {
 					log.Println("Deleting Load Balancer", *lb.Name)
 					id := *lb.ID
-					_, err = isWaitForLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+					_, err = isWaitForLBDeleted(ctx, sess1, id, d.Timeout(schema.TimeoutDelete))
 					if err != nil {
 						log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -1097,7 +1120,7 @@ func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	return sess, err
 }
 
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1109,7 +1132,7 @@ func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1129,31 +1152,31 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 	}
 }
 
-func waitForVpcCluster(d *schema.ResourceData, meta interface{}, timeoutStage string, timeout time.Duration) error {
+func waitForVpcCluster(ctx context.Context, d *schema.ResourceData, meta interface{}, timeoutStage string, timeout time.Duration) error {
 	var err error
 	switch timeoutStage {
 
 	case strings.ToLower(clusterNormal):
 		pendingStates := []string{clusterDeploying, clusterRequested, clusterPending, clusterDeployed, clusterCritical, clusterWarning}
-		_, err = waitForVpcClusterState(d, meta, clusterNormal, pendingStates, timeout)
+		_, err = waitForVpcClusterState(ctx, d, meta, clusterNormal, pendingStates, timeout)
 		if err != nil {
 			return err
 		}
 
 	case strings.ToLower(masterNodeReady):
-		_, err = waitForVpcClusterMasterAvailable(d, meta, timeout)
+		_, err = waitForVpcClusterMasterAvailable(ctx, d, meta, timeout)
 		if err != nil {
 			return err
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
-		_, err = waitForVpcClusterOneWorkerAvailable(d, meta, timeout)
+		_, err = waitForVpcClusterOneWorkerAvailable(ctx, d, meta, timeout)
 		if err != nil {
 			return err
 		}
 
 	case strings.ToLower(ingressReady):
-		_, err = waitForVpcClusterIngressAvailable(d, meta, timeout)
+		_, err = waitForVpcClusterIngressAvailable(ctx, d, meta, timeout)
 		if err != nil {
 			return err
 		}
@@ -1162,7 +1185,7 @@ func waitForVpcCluster(d *schema.ResourceData, meta interface{}, timeoutStage st
 	return nil
 }
 
-func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
 		return nil, err
@@ -1191,10 +1214,10 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 		PollInterval: 5 * time.Second,
 	}
 
-	return deleteStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, deleteStateConf)
 }
 
-func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForVpcClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
 		return nil, err
@@ -1232,10 +1255,10 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 3,
 	}
-	return createStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, createStateConf)
 }
 
-func waitForVpcClusterState(d *schema.ResourceData, meta interface{}, waitForState string, pendingState []string, timeout time.Duration) (interface{}, error) {
+func waitForVpcClusterState(ctx context.Context, d *schema.ResourceData, meta interface{}, waitForState string, pendingState []string, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
 		return nil, err
@@ -1268,10 +1291,10 @@ func waitForVpcClusterState(d *schema.ResourceData, meta interface{}, waitForSta
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 3,
 	}
-	return createStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, createStateConf)
 }
 
-func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForVpcClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
 		return nil, err
@@ -1302,10 +1325,10 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{},
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 3,
 	}
-	return createStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, createStateConf)
 }
 
-func waitForVpcClusterMasterKMSApply(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterMasterKMSApply(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("[DEBUG] Wait for KMS to apply to master")
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
@@ -1344,10 +1367,10 @@ func waitForVpcClusterMasterKMSApply(d *schema.ResourceData, meta interface{}) (
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 1,
 	}
-	return createStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, createStateConf)
 }
 
-func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForVpcClusterIngressAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
 		return nil, err
@@ -1378,7 +1401,7 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{},
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 3,
 	}
-	return createStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, createStateConf)
 }
 
 func getVpcClusterTargetHeader(d *schema.ResourceData) (v2.ClusterTargetHeader, error) {
@@ -1393,7 +1416,7 @@ func getVpcClusterTargetHeader(d *schema.ResourceData) (v2.ClusterTargetHeader,
 }
 
 // waitForVpcClusterVersionUpdate Waits for cluster creation
-func waitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
+func waitForVpcClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1411,7 +1434,7 @@ func waitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, ta
 		ContinuousTargetOccurence: 3,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1431,7 +1454,7 @@ func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *sche
 }
 
 // waitForVpcClusterWokersVersionUpdate Waits for Cluster version Update
-func waitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForVpcClusterWokersVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, workerID string) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1449,7 +1472,7 @@ func waitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface
 		ContinuousTargetOccurence: 3,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1466,7 +1489,7 @@ func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID
 	}
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForWorkerNodetoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -1492,10 +1515,10 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return deleteStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, deleteStateConf)
 }
 
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
+func waitForNewWorker(ctx context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1520,7 +1543,7 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
@@ -233,6 +233,72 @@ func TestAccIBMContainerVPCClusterUpdateDisableOutboundTrafficProtection(t *test
 	})
 }
 
+func TestAccIBMContainerVpcClusterWorkerUpdateStrategy(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, acc.KubeVersion, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_strategy.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_update_strategy.0.max_unavailable", "50%"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, acc.KubeUpdateVersion, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "kube_version", acc.KubeUpdateVersion),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "update_all_workers", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerVpcClusterManagedUpgrade(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterManagedUpgrade(name, acc.KubeVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "managed_upgrade.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "upgrade_status.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterManagedUpgrade(name, acc.KubeUpdateVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "upgrade_status.0.target_version", acc.KubeUpdateVersion),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "upgrade_status.0.phase", "completed"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMContainerVPCClusterEnableSecureByDefault(t *testing.T) {
 	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
 	var conf *v2.ClusterInfo
@@ -621,6 +687,89 @@ resource "ibm_container_vpc_cluster" "cluster" {
 }`, name, disable_outbound_traffic_protection)
 }
 
+func testAccCheckIBMContainerVpcClusterWorkerUpdateStrategy(name, kubeVersion string, updateAllWorkers bool) string {
+	region := acc.Region()
+	return fmt.Sprintf(`
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "%[2]s-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_is_subnet" "subnet2" {
+	name                     = "%[1]s-2"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "%[2]s-2"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name               = "%[1]s"
+	vpc_id             = ibm_is_vpc.vpc.id
+	flavor             = "cx2.2x4"
+	worker_count       = 2
+	kube_version       = "%[3]s"
+	update_all_workers = %[4]t
+	wait_till          = "OneWorkerNodeReady"
+	resource_group_id  = data.ibm_resource_group.resource_group.id
+	zones {
+		subnet_id = ibm_is_subnet.subnet.id
+		name      = "%[2]s-1"
+	}
+	zones {
+		subnet_id = ibm_is_subnet.subnet2.id
+		name      = "%[2]s-2"
+	}
+	worker_update_strategy {
+		max_unavailable   = "50%%"
+		zone_batching     = true
+		worker_pool_order = ["default"]
+	}
+}`, name, region, kubeVersion, updateAllWorkers)
+}
+
+func testAccCheckIBMContainerVpcClusterManagedUpgrade(name, kubeVersion string) string {
+	region := acc.Region()
+	return fmt.Sprintf(`
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "%[2]s-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name              = "%[1]s"
+	vpc_id            = ibm_is_vpc.vpc.id
+	flavor            = "cx2.2x4"
+	worker_count      = 2
+	kube_version      = "%[3]s"
+	wait_till         = "OneWorkerNodeReady"
+	resource_group_id = data.ibm_resource_group.resource_group.id
+	zones {
+		subnet_id = ibm_is_subnet.subnet.id
+		name      = "%[2]s-1"
+	}
+	managed_upgrade {
+		check_versions = true
+		check_addons   = true
+	}
+	worker_update_strategy {
+		max_unavailable = "1"
+	}
+}`, name, region, kubeVersion)
+}
+
 // preveously you have to create securitygroups and use them instead
 func testAccCheckIBMContainerVpcClusterSecurityGroups(name string) string {
 	return fmt.Sprintf(`
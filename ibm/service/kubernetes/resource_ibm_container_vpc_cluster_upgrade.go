@@ -0,0 +1,389 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const (
+	managedUpgrade              = "managed_upgrade"
+	managedUpgradeCheckVersions = "check_versions"
+	managedUpgradeCheckAddons   = "check_addons"
+	managedUpgradeUpdateWorkers = "update_workers"
+	upgradeStatus               = "upgrade_status"
+	upgradeStatusTargetVersion  = "target_version"
+	upgradeStatusPhase          = "phase"
+
+	// The phases of a managed upgrade, in order. The upgrade status records
+	// the last phase that completed.
+	upgradePhasePreflightChecked = "preflight_checked"
+	upgradePhaseMasterUpdated    = "master_updated"
+	upgradePhaseCompleted        = "completed"
+)
+
+func resourceIBMContainerVpcClusterManagedUpgradeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Upgrades the cluster when kube_version changes: runs the preflight checks, updates the master and then the worker pools, and resumes at the failed phase on the next apply.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				managedUpgradeCheckVersions: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Checks that kube_version is a supported version, and at most one minor version above the version of the master.",
+				},
+				managedUpgradeCheckAddons: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Checks that the versions of the add-ons that are enabled in the cluster support kube_version.",
+				},
+				managedUpgradeUpdateWorkers: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Updates the worker nodes after the master, as set in worker_update_strategy.",
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMContainerVpcClusterUpgradeStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The status of the last managed upgrade of the cluster.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				upgradeStatusTargetVersion: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Kubernetes version of the upgrade.",
+				},
+				upgradeStatusPhase: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The last phase of the upgrade that completed: preflight_checked, master_updated or completed.",
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMContainerVpcClusterUpgradeCustomizeDiff plans a managed upgrade
+// when kube_version changes, or when the last managed upgrade to kube_version
+// did not complete.
+func resourceIBMContainerVpcClusterUpgradeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if len(diff.Get(managedUpgrade).([]interface{})) == 0 {
+		return nil
+	}
+	oldVersion, newVersion := diff.GetChange("kube_version")
+	if newVersion.(string) == "" {
+		return nil
+	}
+	if !isSameKubeMinorVersion(oldVersion.(string), newVersion.(string)) {
+		return diff.SetNewComputed(upgradeStatus)
+	}
+	target, phase := getVpcClusterUpgradeStatus(diff.Get(upgradeStatus))
+	if target != "" && phase != upgradePhaseCompleted && isSameKubeMinorVersion(target, newVersion.(string)) {
+		return diff.SetNewComputed(upgradeStatus)
+	}
+	return nil
+}
+
+func getVpcClusterUpgradeStatus(status interface{}) (string, string) {
+	statusList, ok := status.([]interface{})
+	if !ok || len(statusList) == 0 || statusList[0] == nil {
+		return "", ""
+	}
+	statusMap := statusList[0].(map[string]interface{})
+	return statusMap[upgradeStatusTargetVersion].(string), statusMap[upgradeStatusPhase].(string)
+}
+
+// upgradeVpcCluster runs the phases of a managed upgrade to kube_version that
+// did not complete yet, and records each phase in upgrade_status as it
+// completes, so that a failed upgrade resumes at the failed phase.
+func upgradeVpcCluster(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Id()
+	target := d.Get("kube_version").(string)
+	oldStatus, _ := d.GetChange(upgradeStatus)
+	statusTarget, phase := getVpcClusterUpgradeStatus(oldStatus)
+	if !isSameKubeMinorVersion(statusTarget, target) {
+		phase = ""
+	}
+	setPhase := func(p string) {
+		phase = p
+		d.Set(upgradeStatus, []map[string]interface{}{
+			{
+				upgradeStatusTargetVersion: target,
+				upgradeStatusPhase:         p,
+			},
+		})
+	}
+	setPhase(phase)
+	upgrade := map[string]interface{}{
+		managedUpgradeCheckVersions: true,
+		managedUpgradeCheckAddons:   true,
+		managedUpgradeUpdateWorkers: true,
+	}
+	if upgrades := d.Get(managedUpgrade).([]interface{}); len(upgrades) > 0 && upgrades[0] != nil {
+		upgrade = upgrades[0].(map[string]interface{})
+	}
+
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d)
+	if err != nil {
+		return err
+	}
+
+	if phase == "" {
+		log.Printf("[INFO] Upgrading cluster (%s) to %s: running preflight checks", clusterID, target)
+		if err := checkVpcClusterUpgrade(d, meta, csClient, targetEnv, target, upgrade); err != nil {
+			return err
+		}
+		setPhase(upgradePhasePreflightChecked)
+	}
+
+	if phase == upgradePhasePreflightChecked {
+		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+		}
+		// The master may already be updated by an apply that was interrupted before it recorded the phase.
+		if !isSameKubeMinorVersion(cls.MasterKubeVersion, target) {
+			log.Printf("[INFO] Upgrading cluster (%s) to %s: updating the master", clusterID, target)
+			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
+			if err != nil {
+				return err
+			}
+			params := v1.ClusterUpdateParam{
+				Action:  "update",
+				Force:   true,
+				Version: target,
+			}
+			Env, err := getClusterTargetHeader(d, meta)
+			if err != nil {
+				return err
+			}
+			if err := ClusterClient.Clusters().Update(clusterID, params, Env); err != nil {
+				return fmt.Errorf("[ERROR] Error updating the master of cluster (%s) to %s: %s", clusterID, target, err)
+			}
+		}
+		_, err = waitForVpcClusterVersionUpdate(ctx, d, meta, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", clusterID, err)
+		}
+		setPhase(upgradePhaseMasterUpdated)
+	}
+
+	if phase == upgradePhaseMasterUpdated {
+		if upgrade[managedUpgradeUpdateWorkers].(bool) {
+			log.Printf("[INFO] Upgrading cluster (%s) to %s: updating the workers", clusterID, target)
+			strategy, ok := expandVpcClusterWorkerUpdateStrategy(d)
+			if !ok {
+				strategy = &vpcClusterWorkerUpdateStrategy{
+					maxUnavailable: "1",
+					zoneBatching:   true,
+				}
+			}
+			if err := updateVpcClusterWorkersInBatches(ctx, d, csClient, targetEnv, strategy); err != nil {
+				return err
+			}
+		}
+		setPhase(upgradePhaseCompleted)
+		log.Printf("[INFO] Upgraded cluster (%s) to %s", clusterID, target)
+	}
+	return nil
+}
+
+// checkVpcClusterUpgrade runs the preflight checks of a managed upgrade of the
+// cluster to target.
+func checkVpcClusterUpgrade(d *schema.ResourceData, meta interface{}, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, target string, upgrade map[string]interface{}) error {
+	clusterID := d.Id()
+	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+	}
+	if cls.Lifecycle.MasterStatus != ready || strings.Contains(cls.MasterKubeVersion, "(pending)") {
+		return fmt.Errorf("[ERROR] The master of cluster (%s) is not ready to be upgraded, its status is %q and its version is %s", clusterID, cls.Lifecycle.MasterStatus, cls.MasterKubeVersion)
+	}
+
+	v1Client, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	v1Env, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if upgrade[managedUpgradeCheckVersions].(bool) {
+		availableVersions, err := v1Client.KubeVersions().ListV1(v1Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving the supported cluster versions: %s", err)
+		}
+		if err := checkVpcClusterUpgradeVersion(cls.MasterKubeVersion, target, availableVersions); err != nil {
+			return err
+		}
+	}
+
+	if upgrade[managedUpgradeCheckAddons].(bool) && !strings.HasSuffix(target, "_openshift") {
+		installed, err := v1Client.AddOns().GetAddons(clusterID, v1Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving the add-ons of cluster (%s): %s", clusterID, err)
+		}
+		available, err := v1Client.AddOns().ListAddons()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving the add-on versions: %s", err)
+		}
+		if err := checkVpcClusterUpgradeAddons(target, installed, available); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkVpcClusterUpgradeVersion checks that target is one of the supported
+// versions, and that it is at most one minor version above the master.
+func checkVpcClusterUpgradeVersion(masterVersion, target string, availableVersions v1.V1Version) error {
+	platform := "kubernetes"
+	if strings.HasSuffix(target, "_openshift") {
+		platform = "openshift"
+	}
+	targetParts := kubeVersionParts(target)
+	if len(targetParts) < 2 {
+		return fmt.Errorf("[ERROR] Invalid kube_version %s", target)
+	}
+
+	supported := false
+	valid := []string{}
+	for _, v := range availableVersions[platform] {
+		valid = append(valid, fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch))
+		if v.Major == targetParts[0] && v.Minor == targetParts[1] && (len(targetParts) < 3 || v.Patch == targetParts[2]) {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf("[ERROR] kube_version %s is not a supported %s version, the supported versions are: %s", target, platform, strings.Join(valid, ", "))
+	}
+
+	masterParts := kubeVersionParts(masterVersion)
+	if len(masterParts) >= 2 {
+		if targetParts[0] < masterParts[0] || (targetParts[0] == masterParts[0] && targetParts[1] < masterParts[1]) {
+			return fmt.Errorf("[ERROR] kube_version %s is lower than the version of the master %s, clusters cannot be downgraded", target, masterVersion)
+		}
+		if targetParts[0] == masterParts[0] && targetParts[1] > masterParts[1]+1 {
+			return fmt.Errorf("[ERROR] kube_version %s is more than one minor version above the version of the master %s, upgrade to %d.%d first", target, masterVersion, masterParts[0], masterParts[1]+1)
+		}
+	}
+	return nil
+}
+
+// checkVpcClusterUpgradeAddons checks that the installed add-ons support
+// target, and suggests the add-on versions to update to when they do not.
+func checkVpcClusterUpgradeAddons(target string, installed, available []v1.AddOn) error {
+	targetParts := kubeVersionParts(target)
+	if len(targetParts) < 2 {
+		return fmt.Errorf("[ERROR] Invalid kube_version %s", target)
+	}
+	targetVersion, err := version.NewVersion(fmt.Sprintf("%d.%d.0", targetParts[0], targetParts[1]))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Invalid kube_version %s: %s", target, err)
+	}
+	incompatible := []string{}
+	for _, addOn := range installed {
+		compatible, err := isAddOnCompatible(addOn, targetVersion)
+		if err != nil {
+			log.Printf("[WARN] Skipping the check of add-on %s: %s", addOn.Name, err)
+			continue
+		}
+		if compatible {
+			continue
+		}
+		supportedVersions := []string{}
+		for _, candidate := range available {
+			if candidate.Name != addOn.Name {
+				continue
+			}
+			if ok, _ := isAddOnCompatible(candidate, targetVersion); ok {
+				supportedVersions = append(supportedVersions, candidate.Version)
+			}
+		}
+		if len(supportedVersions) == 0 {
+			incompatible = append(incompatible, fmt.Sprintf("%s %s supports %s and no version of it supports %s", addOn.Name, addOn.Version, addOn.SupportedKubeRange, target))
+		} else {
+			incompatible = append(incompatible, fmt.Sprintf("%s %s supports %s, update it to %s", addOn.Name, addOn.Version, addOn.SupportedKubeRange, strings.Join(supportedVersions, " or ")))
+		}
+	}
+	if len(incompatible) > 0 {
+		return fmt.Errorf("[ERROR] The add-ons of the cluster do not support kube_version %s: %s", target, strings.Join(incompatible, "; "))
+	}
+	return nil
+}
+
+// isAddOnCompatible reports whether the supported Kubernetes range of the
+// add-on, such as ">=1.29.0 <1.32.0", includes kubeVersion.
+func isAddOnCompatible(addOn v1.AddOn, kubeVersion *version.Version) (bool, error) {
+	if addOn.SupportedKubeRange == "" {
+		return true, nil
+	}
+	constraints := []string{}
+	for _, field := range strings.Fields(addOn.SupportedKubeRange) {
+		// Join the operators that are separated from their version, such as ">= 1.29.0".
+		if len(constraints) > 0 && strings.Trim(constraints[len(constraints)-1], "<>=!~") == "" {
+			constraints[len(constraints)-1] += field
+			continue
+		}
+		constraints = append(constraints, field)
+	}
+	constraint, err := version.NewConstraint(strings.Join(constraints, ","))
+	if err != nil {
+		return false, err
+	}
+	return constraint.Check(kubeVersion), nil
+}
+
+// kubeVersionParts returns the major, minor and patch numbers of a version
+// such as 1.30.4_1535 or 4.15_openshift.
+func kubeVersionParts(kubeVersion string) []int {
+	parts := []int{}
+	for _, part := range strings.Split(strings.Split(strings.TrimSpace(kubeVersion), "_")[0], ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+func isSameKubeMinorVersion(a, b string) bool {
+	aParts, bParts := kubeVersionParts(a), kubeVersionParts(b)
+	if len(aParts) < 2 || len(bParts) < 2 {
+		return a == b
+	}
+	return aParts[0] == bParts[0] && aParts[1] == bParts[1]
+}
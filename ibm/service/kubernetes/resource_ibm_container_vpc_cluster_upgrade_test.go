@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/hashicorp/go-version"
+)
+
+func TestKubeVersionParts(t *testing.T) {
+	cases := []struct {
+		kubeVersion string
+		expected    []int
+	}{
+		{"1.30.4", []int{1, 30, 4}},
+		{"1.30", []int{1, 30}},
+		{"1.30.4_1534", []int{1, 30, 4}},
+		{"4.16_openshift", []int{4, 16}},
+		{" 1.31.1 ", []int{1, 31, 1}},
+		{"1.x", []int{1}},
+		{"", []int{}},
+	}
+	for _, c := range cases {
+		t.Run(c.kubeVersion, func(t *testing.T) {
+			if parts := kubeVersionParts(c.kubeVersion); !reflect.DeepEqual(parts, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, parts)
+			}
+		})
+	}
+}
+
+func TestCheckVpcClusterUpgradeVersion(t *testing.T) {
+	available := v1.V1Version{
+		"kubernetes": {
+			{Major: 1, Minor: 29, Patch: 8},
+			{Major: 1, Minor: 30, Patch: 4},
+			{Major: 1, Minor: 31, Patch: 1},
+		},
+		"openshift": {
+			{Major: 4, Minor: 15, Patch: 30},
+			{Major: 4, Minor: 16, Patch: 14},
+		},
+	}
+	cases := []struct {
+		name   string
+		master string
+		target string
+		err    string
+	}{
+		{
+			name:   "next minor",
+			master: "1.29.8_1540",
+			target: "1.30",
+		},
+		{
+			name:   "next minor with patch",
+			master: "1.29.8_1540",
+			target: "1.30.4",
+		},
+		{
+			name:   "same minor",
+			master: "1.30.2_1530",
+			target: "1.30.4",
+		},
+		{
+			name:   "openshift",
+			master: "4.15.30_openshift",
+			target: "4.16_openshift",
+		},
+		{
+			name:   "skipped minor",
+			master: "1.29.8_1540",
+			target: "1.31",
+			err:    "upgrade to 1.30 first",
+		},
+		{
+			name:   "downgrade",
+			master: "1.30.4_1530",
+			target: "1.29",
+			err:    "cannot be downgraded",
+		},
+		{
+			name:   "unsupported version",
+			master: "1.29.8_1540",
+			target: "1.28",
+			err:    "is not a supported kubernetes version",
+		},
+		{
+			name:   "unsupported patch",
+			master: "1.29.8_1540",
+			target: "1.30.2",
+			err:    "is not a supported kubernetes version",
+		},
+		{
+			name:   "kubernetes version for openshift",
+			master: "4.15.30_openshift",
+			target: "1.30_openshift",
+			err:    "is not a supported openshift version",
+		},
+		{
+			name:   "invalid version",
+			master: "1.29.8_1540",
+			target: "latest",
+			err:    "Invalid kube_version",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkVpcClusterUpgradeVersion(c.master, c.target, available)
+			if c.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Errorf("expected an error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestIsAddOnCompatible(t *testing.T) {
+	kubeVersion := version.Must(version.NewVersion("1.30.0"))
+	cases := []struct {
+		name      string
+		kubeRange string
+		expected  bool
+		err       bool
+	}{
+		{name: "no range", expected: true},
+		{name: "in range", kubeRange: ">=1.28.0 <1.31.0", expected: true},
+		{name: "operator separated from the version", kubeRange: ">= 1.28.0 < 1.31.0", expected: true},
+		{name: "above range", kubeRange: ">=1.26.0 <1.30.0"},
+		{name: "below range", kubeRange: ">=1.31.0"},
+		{name: "invalid range", kubeRange: "1.28 to 1.31", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			compatible, err := isAddOnCompatible(v1.AddOn{Name: "istio", SupportedKubeRange: c.kubeRange}, kubeVersion)
+			if c.err != (err != nil) {
+				t.Fatalf("expected error %t, got %v", c.err, err)
+			}
+			if compatible != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, compatible)
+			}
+		})
+	}
+}
+
+func TestCheckVpcClusterUpgradeAddons(t *testing.T) {
+	available := []v1.AddOn{
+		{Name: "istio", Version: "1.21", SupportedKubeRange: ">=1.27.0 <1.30.0"},
+		{Name: "istio", Version: "1.22", SupportedKubeRange: ">=1.28.0 <1.32.0"},
+		{Name: "alb-oauth-proxy", Version: "2.0.0", SupportedKubeRange: ">=1.25.0 <1.30.0"},
+	}
+	cases := []struct {
+		name      string
+		installed []v1.AddOn
+		err       []string
+	}{
+		{
+			name: "no add-on",
+		},
+		{
+			name:      "compatible add-on",
+			installed: []v1.AddOn{{Name: "istio", Version: "1.22", SupportedKubeRange: ">=1.28.0 <1.32.0"}},
+		},
+		{
+			name:      "add-on without a range",
+			installed: []v1.AddOn{{Name: "vpc-block-csi-driver", Version: "5.2"}},
+		},
+		{
+			name:      "add-on with an invalid range is skipped",
+			installed: []v1.AddOn{{Name: "debug-tool", Version: "2.0.0", SupportedKubeRange: "any"}},
+		},
+		{
+			name:      "incompatible add-on with a newer version",
+			installed: []v1.AddOn{{Name: "istio", Version: "1.21", SupportedKubeRange: ">=1.27.0 <1.30.0"}},
+			err:       []string{"istio 1.21 supports >=1.27.0 <1.30.0, update it to 1.22"},
+		},
+		{
+			name:      "incompatible add-on without a newer version",
+			installed: []v1.AddOn{{Name: "alb-oauth-proxy", Version: "2.0.0", SupportedKubeRange: ">=1.25.0 <1.30.0"}},
+			err:       []string{"no version of it supports 1.30"},
+		},
+		{
+			name: "all the incompatible add-ons",
+			installed: []v1.AddOn{
+				{Name: "istio", Version: "1.21", SupportedKubeRange: ">=1.27.0 <1.30.0"},
+				{Name: "alb-oauth-proxy", Version: "2.0.0", SupportedKubeRange: ">=1.25.0 <1.30.0"},
+			},
+			err: []string{"istio 1.21", "alb-oauth-proxy 2.0.0"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkVpcClusterUpgradeAddons("1.30", c.installed, available)
+			if len(c.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %q", c.err)
+			}
+			for _, expected := range c.err {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected an error containing %q, got %v", expected, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	workerUpdateStrategy                = "worker_update_strategy"
+	workerUpdateStrategyMaxUnavailable  = "max_unavailable"
+	workerUpdateStrategyZoneBatching    = "zone_batching"
+	workerUpdateStrategyWorkerPoolOrder = "worker_pool_order"
+
+	workerPoolUpdating = "updating"
+)
+
+func resourceIBMContainerVpcClusterWorkerUpdateStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Replaces the outdated worker nodes in batches when update_all_workers, patch_version or retry_patch_version changes, instead of one at a time.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				workerUpdateStrategyMaxUnavailable: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "1",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|([1-9][0-9]?|100)%)$`), "must be a count, such as 3, or a percent of the workers of the worker pool, such as 25%"),
+					Description:  "The number of worker nodes of a worker pool that are replaced at the same time, as a count or as a percent of the workers of the worker pool.",
+				},
+				workerUpdateStrategyZoneBatching: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Replaces the worker nodes of one zone at a time, so that the other zones keep serving while a batch is replaced.",
+				},
+				workerUpdateStrategyWorkerPoolOrder: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The names or IDs of the worker pools that are updated first, in order. The other worker pools are updated afterwards.",
+				},
+			},
+		},
+	}
+}
+
+type vpcClusterWorkerUpdateStrategy struct {
+	maxUnavailable  string
+	zoneBatching    bool
+	workerPoolOrder []string
+}
+
+func expandVpcClusterWorkerUpdateStrategy(d *schema.ResourceData) (*vpcClusterWorkerUpdateStrategy, bool) {
+	strategies, ok := d.GetOk(workerUpdateStrategy)
+	if !ok || len(strategies.([]interface{})) == 0 || strategies.([]interface{})[0] == nil {
+		return nil, false
+	}
+	strategyMap := strategies.([]interface{})[0].(map[string]interface{})
+	strategy := &vpcClusterWorkerUpdateStrategy{
+		maxUnavailable: strategyMap[workerUpdateStrategyMaxUnavailable].(string),
+		zoneBatching:   strategyMap[workerUpdateStrategyZoneBatching].(bool),
+	}
+	for _, pool := range strategyMap[workerUpdateStrategyWorkerPoolOrder].([]interface{}) {
+		strategy.workerPoolOrder = append(strategy.workerPoolOrder, pool.(string))
+	}
+	return strategy, true
+}
+
+// batchSize returns the number of workers of a worker pool with poolSize workers that are replaced at the same time.
+func (s *vpcClusterWorkerUpdateStrategy) batchSize(poolSize int) int {
+	size := 1
+	if percent, ok := strings.CutSuffix(s.maxUnavailable, "%"); ok {
+		p, _ := strconv.Atoi(percent)
+		size = poolSize * p / 100
+	} else {
+		size, _ = strconv.Atoi(s.maxUnavailable)
+	}
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// orderWorkerPools returns the worker pools listed in worker_pool_order first, followed by the other worker pools.
+func (s *vpcClusterWorkerUpdateStrategy) orderWorkerPools(pools []v2.GetWorkerPoolResponse) []v2.GetWorkerPoolResponse {
+	ordered := make([]v2.GetWorkerPoolResponse, 0, len(pools))
+	added := map[string]bool{}
+	for _, nameOrID := range s.workerPoolOrder {
+		found := false
+		for _, pool := range pools {
+			if pool.PoolName == nameOrID || pool.ID == nameOrID {
+				found = true
+				if !added[pool.ID] {
+					added[pool.ID] = true
+					ordered = append(ordered, pool)
+				}
+			}
+		}
+		if !found {
+			log.Printf("[WARN] Worker pool %s of worker_pool_order is not found in the cluster", nameOrID)
+		}
+	}
+	for _, pool := range pools {
+		if !added[pool.ID] {
+			ordered = append(ordered, pool)
+		}
+	}
+	return ordered
+}
+
+// nextBatch returns the outdated workers that are replaced in the next batch, taken from a single zone when zone_batching is set.
+func (s *vpcClusterWorkerUpdateStrategy) nextBatch(outdated []v2.Worker, size int) []v2.Worker {
+	sort.SliceStable(outdated, func(i, j int) bool {
+		if outdated[i].Location != outdated[j].Location {
+			return outdated[i].Location < outdated[j].Location
+		}
+		return outdated[i].ID < outdated[j].ID
+	})
+	batch := []v2.Worker{}
+	for _, worker := range outdated {
+		if len(batch) == size {
+			break
+		}
+		if s.zoneBatching && len(batch) > 0 && worker.Location != batch[0].Location {
+			break
+		}
+		batch = append(batch, worker)
+	}
+	return batch
+}
+
+func isVpcClusterWorkerOutdated(worker v2.Worker, pool v2.GetWorkerPoolResponse) bool {
+	// check if change is present in MAJOR.MINOR version or in PATCH version
+	return worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != pool.OperatingSystem
+}
+
+// updateVpcClusterWorkersInBatches replaces the outdated workers of the cluster pool by pool, in batches of at most
+// max_unavailable workers, and waits for all the workers of the pool to be ready before the next batch. The outdated
+// workers are read again before each batch, so a rollout that was interrupted resumes with the workers left to replace.
+func updateVpcClusterWorkersInBatches(ctx context.Context, d *schema.ResourceData, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, strategy *vpcClusterWorkerUpdateStrategy) error {
+	clusterID := d.Id()
+	pools, err := csClient.WorkerPools().ListWorkerPools(clusterID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pools of cluster (%s): %s", clusterID, err)
+	}
+	for _, pool := range strategy.orderWorkerPools(pools) {
+		if err := updateVpcClusterWorkerPoolInBatches(ctx, d, csClient, targetEnv, strategy, pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateVpcClusterWorkerPoolInBatches(ctx context.Context, d *schema.ResourceData, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, strategy *vpcClusterWorkerUpdateStrategy, pool v2.GetWorkerPoolResponse) error {
+	clusterID := d.Id()
+	var initialWorkers map[string]bool
+	for batch := 1; ; batch++ {
+		// Wait for the workers replaced by the previous batch, or by an interrupted apply, to be ready.
+		workers, err := waitForVpcClusterWorkerPoolReady(ctx, d, csClient, targetEnv, pool)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the workers of cluster (%s) worker pool (%s) to be ready: %s", clusterID, pool.PoolName, err)
+		}
+		if initialWorkers == nil {
+			initialWorkers = make(map[string]bool, len(workers))
+			for _, worker := range workers {
+				initialWorkers[worker.ID] = true
+			}
+		}
+
+		outdated := []v2.Worker{}
+		for _, worker := range workers {
+			if !isVpcClusterWorkerOutdated(worker, pool) {
+				continue
+			}
+			if !initialWorkers[worker.ID] {
+				return fmt.Errorf("[ERROR] Worker node - %s of worker pool (%s) is still outdated after it is replaced", worker.ID, pool.PoolName)
+			}
+			outdated = append(outdated, worker)
+		}
+		if len(outdated) == 0 {
+			log.Printf("[INFO] Workers of cluster (%s) worker pool (%s) are updated", clusterID, pool.PoolName)
+			return nil
+		}
+
+		next := strategy.nextBatch(outdated, strategy.batchSize(len(workers)))
+		workerIDs := make([]string, 0, len(next))
+		for _, worker := range next {
+			workerIDs = append(workerIDs, worker.ID)
+		}
+		log.Printf("[INFO] Updating workers of cluster (%s) worker pool (%s): batch %d replaces %d of %d outdated workers in zone %s: %s",
+			clusterID, pool.PoolName, batch, len(next), len(outdated), next[0].Location, strings.Join(workerIDs, ", "))
+
+		for _, worker := range next {
+			_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+			// As API returns http response 204 NO CONTENT, error raised will be exempted.
+			if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+				return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+			}
+		}
+		for _, worker := range next {
+			if _, err := waitForVpcClusterWorkerReplaced(ctx, d, csClient, targetEnv, worker.ID); err != nil {
+				return fmt.Errorf("[ERROR] Worker node - %s is failed to replace: %s", worker.ID, err)
+			}
+		}
+	}
+}
+
+func waitForVpcClusterWorkerReplaced(ctx context.Context, d *schema.ResourceData, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+	clusterID := d.Id()
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{workerDeletePending},
+		Target:  []string{workerDeleteState},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := csClient.Workers().Get(clusterID, workerID, targetEnv)
+			if err != nil {
+				return worker, workerDeletePending, nil
+			}
+			if worker.LifeCycle.ActualState == "deleted" {
+				return worker, workerDeleteState, nil
+			}
+			return worker, workerDeletePending, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	return conns.WaitForStateContext(ctx, deleteStateConf)
+}
+
+// isVpcClusterWorkerReady returns whether a worker is deployed and its Kubernetes node is Ready. The health message of
+// a worker is the status of its node, and its health state is normal as soon as the node is registered.
+func isVpcClusterWorkerReady(worker v2.Worker) bool {
+	return worker.LifeCycle.ActualState == workerDesired && worker.Health.State == workerNormal && worker.Health.Message == workerReadyState
+}
+
+// waitForVpcClusterWorkerPoolReady waits for the worker pool to have all of its workers, deployed and with their node Ready.
+func waitForVpcClusterWorkerPoolReady(ctx context.Context, d *schema.ResourceData, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader, pool v2.GetWorkerPoolResponse) ([]v2.Worker, error) {
+	clusterID := d.Id()
+	expected := pool.WorkerCount * len(pool.Zones)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", workerPoolUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			poolWorkers, err := csClient.Workers().ListByWorkerPool(clusterID, pool.ID, false, targetEnv)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+			workers := []v2.Worker{}
+			for _, worker := range poolWorkers {
+				if worker.PoolID == pool.ID {
+					workers = append(workers, worker)
+				}
+			}
+			if len(workers) < expected {
+				log.Printf("[INFO] Worker pool (%s) has %d of %d workers", pool.PoolName, len(workers), expected)
+				return workers, workerPoolUpdating, nil
+			}
+			for _, worker := range workers {
+				if !isVpcClusterWorkerReady(worker) {
+					log.Printf("[INFO] Worker (%s) of worker pool (%s) is %s with health %s: %s", worker.ID, pool.PoolName, worker.LifeCycle.ActualState, worker.Health.State, worker.Health.Message)
+					return workers, workerPoolUpdating, nil
+				}
+			}
+			return workers, workerNormal, nil
+		},
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+	workers, err := conns.WaitForStateContext(ctx, stateConf)
+	if err != nil {
+		return nil, err
+	}
+	return workers.([]v2.Worker), nil
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestVpcClusterWorkerUpdateStrategyBatchSize(t *testing.T) {
+	cases := []struct {
+		maxUnavailable string
+		poolSize       int
+		expected       int
+	}{
+		{"1", 6, 1},
+		{"3", 6, 3},
+		{"10", 6, 10},
+		{"50%", 6, 3},
+		{"25%", 6, 1},
+		{"10%", 6, 1},
+		{"100%", 6, 6},
+		{"33%", 0, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.maxUnavailable, func(t *testing.T) {
+			strategy := &vpcClusterWorkerUpdateStrategy{maxUnavailable: c.maxUnavailable}
+			if size := strategy.batchSize(c.poolSize); size != c.expected {
+				t.Errorf("expected %d workers of %d, got %d", c.expected, c.poolSize, size)
+			}
+		})
+	}
+}
+
+func TestVpcClusterWorkerUpdateStrategyOrderWorkerPools(t *testing.T) {
+	pools := []v2.GetWorkerPoolResponse{
+		{ID: "pool-1", PoolName: "default"},
+		{ID: "pool-2", PoolName: "edge"},
+		{ID: "pool-3", PoolName: "gpu"},
+	}
+	cases := []struct {
+		name            string
+		workerPoolOrder []string
+		expected        []string
+	}{
+		{
+			name:     "no order",
+			expected: []string{"pool-1", "pool-2", "pool-3"},
+		},
+		{
+			name:            "by name",
+			workerPoolOrder: []string{"gpu", "edge"},
+			expected:        []string{"pool-3", "pool-2", "pool-1"},
+		},
+		{
+			name:            "by ID",
+			workerPoolOrder: []string{"pool-2"},
+			expected:        []string{"pool-2", "pool-1", "pool-3"},
+		},
+		{
+			name:            "listed twice",
+			workerPoolOrder: []string{"edge", "pool-2", "default"},
+			expected:        []string{"pool-2", "pool-1", "pool-3"},
+		},
+		{
+			name:            "unknown worker pool",
+			workerPoolOrder: []string{"unknown", "gpu"},
+			expected:        []string{"pool-3", "pool-1", "pool-2"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			strategy := &vpcClusterWorkerUpdateStrategy{workerPoolOrder: c.workerPoolOrder}
+			ordered := []string{}
+			for _, pool := range strategy.orderWorkerPools(pools) {
+				ordered = append(ordered, pool.ID)
+			}
+			if !reflect.DeepEqual(ordered, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, ordered)
+			}
+		})
+	}
+}
+
+func TestVpcClusterWorkerUpdateStrategyNextBatch(t *testing.T) {
+	outdated := func() []v2.Worker {
+		return []v2.Worker{
+			{ID: "worker-4", Location: "us-south-2"},
+			{ID: "worker-2", Location: "us-south-1"},
+			{ID: "worker-5", Location: "us-south-3"},
+			{ID: "worker-1", Location: "us-south-1"},
+			{ID: "worker-3", Location: "us-south-2"},
+		}
+	}
+	cases := []struct {
+		name         string
+		zoneBatching bool
+		size         int
+		expected     []string
+	}{
+		{
+			name:         "one zone at a time",
+			zoneBatching: true,
+			size:         3,
+			expected:     []string{"worker-1", "worker-2"},
+		},
+		{
+			name:         "smaller than the zone",
+			zoneBatching: true,
+			size:         1,
+			expected:     []string{"worker-1"},
+		},
+		{
+			name:     "across zones",
+			size:     3,
+			expected: []string{"worker-1", "worker-2", "worker-3"},
+		},
+		{
+			name:     "all the outdated workers",
+			size:     10,
+			expected: []string{"worker-1", "worker-2", "worker-3", "worker-4", "worker-5"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			strategy := &vpcClusterWorkerUpdateStrategy{zoneBatching: c.zoneBatching}
+			batch := []string{}
+			for _, worker := range strategy.nextBatch(outdated(), c.size) {
+				batch = append(batch, worker.ID)
+			}
+			if !reflect.DeepEqual(batch, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, batch)
+			}
+		})
+	}
+}
+
+func TestIsVpcClusterWorkerReady(t *testing.T) {
+	cases := []struct {
+		name     string
+		worker   v2.Worker
+		expected bool
+	}{
+		{
+			name:     "node ready",
+			worker:   v2.Worker{LifeCycle: v2.WorkerLifeCycle{ActualState: "deployed"}, Health: v2.HealthStatus{State: "normal", Message: "Ready"}},
+			expected: true,
+		},
+		{
+			name:   "node not ready",
+			worker: v2.Worker{LifeCycle: v2.WorkerLifeCycle{ActualState: "deployed"}, Health: v2.HealthStatus{State: "normal", Message: "NotReady"}},
+		},
+		{
+			name:   "provisioning",
+			worker: v2.Worker{LifeCycle: v2.WorkerLifeCycle{ActualState: "provisioning"}, Health: v2.HealthStatus{State: "pending"}},
+		},
+		{
+			name:   "critical",
+			worker: v2.Worker{LifeCycle: v2.WorkerLifeCycle{ActualState: "deployed"}, Health: v2.HealthStatus{State: "critical", Message: "Ready"}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if ready := isVpcClusterWorkerReady(c.worker); ready != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, ready)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -53,11 +54,11 @@ const (
 func ResourceIBMContainerVpcWorker() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcWorkerCreate,
-		Read:     resourceIBMContainerVpcWorkerRead,
-		Delete:   resourceIBMContainerVpcWorkerDelete,
-		Exists:   resourceIBMContainerVpcWorkerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcWorkerCreate,
+		ReadContext:   resourceIBMContainerVpcWorkerRead,
+		DeleteContext: resourceIBMContainerVpcWorkerDelete,
+		Exists:        resourceIBMContainerVpcWorkerExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
@@ -182,7 +183,7 @@ func ResourceIBMContainerVPCWorkerValidator() *validate.ResourceValidator {
 
 // Since Worker is being managed by Worker Pool, we can't create new workers
 // but we can update/replace the existing workers to the new workers.
-func resourceIBMContainerVpcWorkerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	//Current Resource status
 	currentStatus := false
@@ -205,22 +206,22 @@ func resourceIBMContainerVpcWorkerCreate(d *schema.ResourceData, meta interface{
 	if check_ptx_status || len(sds) != 0 {
 		//Validate & Check kubeconfig
 		if !cc_ok {
-			return fmt.Errorf("[ERROR] kube_config_path argument must be specified if check_ptx_status is true or sds is set")
+			return diag.FromErr(fmt.Errorf("[ERROR] kube_config_path argument must be specified if check_ptx_status is true or sds is set"))
 		} else {
 			//1. Load the cluster config
 			config, err := clientcmd.BuildConfigFromFlags("", cluster_config.(string))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Invalid kubeconfig, failed to set context: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Invalid kubeconfig, failed to set context: %s", err))
 			}
 			//2. create the clientset
 			clientset, err := kubernetes.NewForConfig(config)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Invalid kubeconfig,, failed to create clientset: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Invalid kubeconfig,, failed to create clientset: %s", err))
 			}
 			//3. List pods from kube-system namespace
-			_, err = clientset.CoreV1().Pods("kube-system").List(context.TODO(), metav1.ListOptions{})
+			_, err = clientset.CoreV1().Pods("kube-system").List(ctx, metav1.ListOptions{})
 			if err != nil {
-				return fmt.Errorf("[ERROR] Invalid kubeconfig, failed to list resource: %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Invalid kubeconfig, failed to list resource: %s", err))
 			}
 			//4. Set globals
 			softwaredefinedstorage.SetGlobals(&softwaredefinedstorage.ClusterConfig{
@@ -243,33 +244,33 @@ func resourceIBMContainerVpcWorkerCreate(d *schema.ResourceData, meta interface{
 	//Continue only if the previous resource status is success
 	err = waitForPreviousResource(workerID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	defer resourceIBMContainerVpcWorkerCreateMutex.Unlock()
 
 	wkClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	worker, err := wkClient.Workers().Get(clusterNameorID, workerID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting container vpc worker node: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting container vpc worker node: %s", err))
 	}
 
-	err = t.PreWorkerReplace(worker)
+	err = t.PreWorkerReplace(ctx, worker)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cls, err := wkClient.Clusters().GetCluster(clusterNameorID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	// Update the worker nodes after master node kube-version is updated.
@@ -278,7 +279,7 @@ func resourceIBMContainerVpcWorkerCreate(d *schema.ResourceData, meta interface{
 
 	workers, err := wkClient.Workers().ListWorkers(cls.ID, false, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err))
 	}
 
 	for index, _worker := range workers {
@@ -291,34 +292,34 @@ func resourceIBMContainerVpcWorkerCreate(d *schema.ResourceData, meta interface{
 		_, err = wkClient.Workers().ReplaceWokerNode(cls.ID, worker.ID, targetEnv)
 		// As API returns http response 204 NO CONTENT, error raised will be exempted.
 		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
-			return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err))
 		}
 
 		//1. wait for worker node to delete
-		_, deleteError := waitForVpcWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+		_, deleteError := waitForVpcWorkerNodetoDelete(ctx, d, meta, targetEnv, worker.ID)
 		if deleteError != nil {
-			return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
+			return diag.FromErr(fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID))
 		}
 
 		//2. wait for new workerNode
-		_, newWorkerError := waitForNewVpcWorker(d, meta, targetEnv, workersCount)
+		_, newWorkerError := waitForNewVpcWorker(ctx, d, meta, targetEnv, workersCount)
 		if newWorkerError != nil {
-			return fmt.Errorf("[ERROR] Failed to spawn new worker node")
+			return diag.FromErr(fmt.Errorf("[ERROR] Failed to spawn new worker node"))
 		}
 
 		//3. Get new worker node ID and update the map
 		newWorkerID, _, newNodeError := getNewVpcWorkerID(d, meta, targetEnv, workersInfo)
 		if newNodeError != nil {
-			return fmt.Errorf("[ERROR] Unable to find the new worker node info")
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable to find the new worker node info"))
 		}
 
 		d.SetId(newWorkerID)
 
 		//4. wait for the worker's version update and normal state
-		_worker, err := WaitForVpcClusterVpcWokersVersionUpdate(d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
+		_worker, err := WaitForVpcClusterVpcWokersVersionUpdate(ctx, d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
 		if err != nil {
-			return fmt.Errorf(
-				"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), err))
 		}
 		worker = _worker.(v2.Worker)
 
@@ -333,29 +334,29 @@ func resourceIBMContainerVpcWorkerCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err = t.PostWorkerReplace(worker)
+	err = t.PostWorkerReplace(ctx, worker)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if check_ptx_status {
-		err = checkPortworxStatus(d, cluster_config.(string))
+		err = checkPortworxStatus(ctx, d, cluster_config.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	//Worker reloaded successfully
 	currentStatus = true
-	return resourceIBMContainerVpcWorkerRead(d, meta)
+	return resourceIBMContainerVpcWorkerRead(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Not importing this resource.
 	return nil
 }
 
-func resourceIBMContainerVpcWorkerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Delete operation clears only the entries from the statefiles as
 	// the replace operation involves both deletion & creation of the
 	// resource
@@ -421,7 +422,7 @@ func waitForPreviousResource(worker_id string) error {
 	}
 }
 
-func checkPortworxStatus(d *schema.ResourceData, cluster_config string) error {
+func checkPortworxStatus(ctx context.Context, d *schema.ResourceData, cluster_config string) error {
 	//Get worker ip
 	worker_ip := d.Get("ip").(string)
 	//1. Load the cluster config
@@ -436,13 +437,13 @@ func checkPortworxStatus(d *schema.ResourceData, cluster_config string) error {
 	}
 
 	//2. Retrieve portworx pod of current worker
-	pod_name, err := WaitForPortworxPod(d, clientset, worker_ip)
+	pod_name, err := WaitForPortworxPod(ctx, d, clientset, worker_ip)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to retrieve portworx pods: %s", err)
 	}
 
 	//3. Fetch portworx status json
-	ptx_content, err := WaitForPortworxStatus(d, clientset, config, pod_name.(string))
+	ptx_content, err := WaitForPortworxStatus(ctx, d, clientset, config, pod_name.(string))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Failed to fetch portworx status: %s", err)
 	}
@@ -466,7 +467,7 @@ func checkPortworxStatus(d *schema.ResourceData, cluster_config string) error {
 	return fmt.Errorf("[ERROR] No pods found with label name=portworx in kube-system namespace")
 }
 
-func WaitForPortworxPod(d *schema.ResourceData, clientset *kubernetes.Clientset, worker_ip string) (interface{}, error) {
+func WaitForPortworxPod(ctx context.Context, d *schema.ResourceData, clientset *kubernetes.Clientset, worker_ip string) (interface{}, error) {
 	log.Printf("Waiting for the portworx pod to be Available & Ready")
 
 	ptx_timeout, err := time.ParseDuration(d.Get("ptx_timeout").(string))
@@ -483,7 +484,7 @@ func WaitForPortworxPod(d *schema.ResourceData, clientset *kubernetes.Clientset,
 		PollInterval: 30 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func ptxPodRefreshFunc(clientset *kubernetes.Clientset, worker_ip string) resource.StateRefreshFunc {
@@ -512,7 +513,7 @@ func ptxPodRefreshFunc(clientset *kubernetes.Clientset, worker_ip string) resour
 	}
 }
 
-func WaitForPortworxStatus(d *schema.ResourceData, clientset *kubernetes.Clientset, config *rest.Config, pod_name string) (interface{}, error) {
+func WaitForPortworxStatus(ctx context.Context, d *schema.ResourceData, clientset *kubernetes.Clientset, config *rest.Config, pod_name string) (interface{}, error) {
 	log.Printf("Waiting to fetch portworx status json")
 
 	ptx_timeout, err := time.ParseDuration(d.Get("ptx_timeout").(string))
@@ -529,7 +530,7 @@ func WaitForPortworxStatus(d *schema.ResourceData, clientset *kubernetes.Clients
 		PollInterval: 30 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func ptxStatusRefreshFunc(clientset *kubernetes.Clientset, config *rest.Config, pod_name string) resource.StateRefreshFunc {
@@ -593,7 +594,7 @@ func ptxStatusRefreshFunc(clientset *kubernetes.Clientset, config *rest.Config,
 	}
 }
 
-func waitForVpcWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForVpcWorkerNodetoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -619,10 +620,10 @@ func waitForVpcWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targ
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return deleteStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, deleteStateConf)
 }
 
-func waitForNewVpcWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
+func waitForNewVpcWorker(ctx context.Context, d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -647,7 +648,7 @@ func waitForNewVpcWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func getNewVpcWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
@@ -673,7 +674,7 @@ func getNewVpcWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.Cl
 }
 
 // WaitForVpcClusterVpcWokersVersionUpdate Waits for Cluster version Update
-func WaitForVpcClusterVpcWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
+func WaitForVpcClusterVpcWokersVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -691,7 +692,7 @@ func WaitForVpcClusterVpcWokersVersionUpdate(d *schema.ResourceData, meta interf
 		ContinuousTargetOccurence: 3,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func vpcClusterVpcWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
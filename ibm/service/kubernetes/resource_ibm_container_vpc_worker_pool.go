@@ -4,11 +4,13 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,12 +29,12 @@ const (
 func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcWorkerPoolCreate,
-		Update:   resourceIBMContainerVpcWorkerPoolUpdate,
-		Read:     resourceIBMContainerVpcWorkerPoolRead,
-		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
-		Exists:   resourceIBMContainerVpcWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcWorkerPoolCreate,
+		UpdateContext: resourceIBMContainerVpcWorkerPoolUpdate,
+		ReadContext:   resourceIBMContainerVpcWorkerPoolRead,
+		DeleteContext: resourceIBMContainerVpcWorkerPoolDelete,
+		Exists:        resourceIBMContainerVpcWorkerPoolExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
@@ -268,13 +270,13 @@ func ResourceIBMContainerVPCWorkerPoolValidator() *validate.ResourceValidator {
 	return &containerVPCWorkerPoolTaintsValidator
 }
 
-func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	clusterNameorID := d.Get("cluster").(string)
 
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ioc, ok := d.GetOk("import_on_create"); ok && ioc.(bool) {
@@ -284,17 +286,17 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		wp, err := wpClient.WorkerPools().GetWorkerPool(clusterNameorID, "default", targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, wp.ID))
 
-		return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+		return resourceIBMContainerVpcWorkerPoolRead(ctx, d, meta)
 
 	}
 
@@ -371,32 +373,32 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
 	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 	}
 
 	if taintRes, ok := d.GetOk("taints"); ok {
 		if err := updateWorkerpoolTaints(d, meta, clusterNameorID, params.Name, taintRes.(*schema.Set).List()); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+	return resourceIBMContainerVpcWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterNameOrID := d.Get("cluster").(string)
 	workerPoolName := d.Get("worker_pool_name").(string)
 
@@ -413,17 +415,17 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolName, labels, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s", err))
 		}
 	}
 
@@ -433,7 +435,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 			taints = taintRes.(*schema.Set).List()
 		}
 		if err := updateWorkerpoolTaints(d, meta, clusterNameOrID, workerPoolName, taints); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -443,17 +445,17 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err))
 		}
 	}
 
@@ -462,7 +464,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		workerPoolName := d.Get("worker_pool_name").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldList, newList := d.GetChange("zones")
 		if oldList == nil {
@@ -478,7 +480,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if len(add) > 0 {
 			csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			for _, zone := range add {
 				newZone := zone.(map[string]interface{})
@@ -490,11 +492,11 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 				}
 
 			}
@@ -504,16 +506,16 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(ctx, clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err))
 				}
 			}
 		}
@@ -525,11 +527,11 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		operatingSystem := d.Get("operating_system").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v2.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
@@ -539,14 +541,14 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 			OperatingSystem: operatingSystem,
 		}, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the operating_system %s: %s", operatingSystem, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the operating_system %s: %s", operatingSystem, err))
 		}
 	}
 
-	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+	return resourceIBMContainerVpcWorkerPoolRead(ctx, d, meta)
 }
 
-func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForV2WorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -560,7 +562,7 @@ func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -630,14 +632,14 @@ func flattenWorkerPoolTaints(taints v2.GetWorkerPoolResponse) []map[string]inter
 	}
 	return taintslist
 }
-func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -645,12 +647,12 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var zones = make([]map[string]interface{}, 0)
@@ -666,7 +668,7 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 
 	cls, err := wpClient.Clusters().GetCluster(cluster, targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	d.Set("worker_pool_name", workerPool.PoolName)
@@ -697,20 +699,20 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	d.Set("autoscale_enabled", workerPool.AutoscaleEnabled)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -718,7 +720,7 @@ func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var orphan_on_delete bool = false
 	if orod, ok := d.GetOk("orphan_on_delete"); ok {
@@ -730,11 +732,11 @@ func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interf
 	} else {
 		err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		_, err = WaitForVpcWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+		_, err = WaitForVpcWorkerDelete(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err))
 		}
 	}
 	d.SetId("")
@@ -779,7 +781,7 @@ func resourceIBMContainerVpcWorkerPoolExists(d *schema.ResourceData, meta interf
 }
 
 // WaitForWorkerPoolAvailable Waits for worker creation
-func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerPoolAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -796,7 +798,7 @@ func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, cluste
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -820,7 +822,7 @@ func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerP
 	}
 }
 
-func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -835,7 +837,7 @@ func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
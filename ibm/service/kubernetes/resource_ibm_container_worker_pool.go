@@ -4,12 +4,14 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -23,12 +25,12 @@ import (
 func ResourceIBMContainerWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolCreate,
-		Read:     resourceIBMContainerWorkerPoolRead,
-		Update:   resourceIBMContainerWorkerPoolUpdate,
-		Delete:   resourceIBMContainerWorkerPoolDelete,
-		Exists:   resourceIBMContainerWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerWorkerPoolCreate,
+		ReadContext:   resourceIBMContainerWorkerPoolRead,
+		UpdateContext: resourceIBMContainerWorkerPoolUpdate,
+		DeleteContext: resourceIBMContainerWorkerPoolDelete,
+		Exists:        resourceIBMContainerWorkerPoolExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
@@ -237,11 +239,11 @@ func ResourceIBMContainerWorkerPoolValidator() *validate.ResourceValidator {
 	containerWorkerPoolTaintsValidator := validate.ResourceValidator{ResourceName: "ibm_container_worker_pool", Schema: validateSchema}
 	return &containerWorkerPoolTaintsValidator
 }
-func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterNameorID := d.Get("cluster").(string)
@@ -251,17 +253,17 @@ func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface
 		workerPoolsAPI := csClient.WorkerPools()
 		targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		res, err := workerPoolsAPI.GetWorkerPool(clusterNameorID, "default", targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
-		return resourceIBMContainerWorkerPoolRead(d, meta)
+		return resourceIBMContainerWorkerPoolRead(ctx, d, meta)
 
 	}
 
@@ -306,33 +308,33 @@ func resourceIBMContainerWorkerPoolCreate(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(clusterNameorID, params, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
 	if taintRes, ok := d.GetOk("taints"); ok {
 		if err := updateWorkerpoolTaints(d, meta, clusterNameorID, workerPoolConfig.Name, taintRes.(*schema.Set).List()); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolRead(d, meta)
+	return resourceIBMContainerWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -340,12 +342,12 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	machineType := workerPool.MachineType
@@ -376,38 +378,38 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	d.Set("autoscale_enabled", workerPool.AutoscaleEnabled)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("size_per_zone") {
 		err = workerPoolsAPI.ResizeWorkerPool(clusterNameorID, workerPoolNameorID, d.Get("size_per_zone").(int), targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = WaitForWorkerNormal(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerNormal(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err))
 		}
 	}
 
@@ -420,12 +422,12 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 		}
 		err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterNameorID, workerPoolNameorID, labels, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		_, err = WaitForWorkerNormal(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerNormal(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPoolNameorID, clusterNameorID, err))
 		}
 	}
 
@@ -435,7 +437,7 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 			taints = taintRes.(*schema.Set).List()
 		}
 		if err := updateWorkerpoolTaints(d, meta, clusterNameorID, workerPoolNameorID, taints); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -443,11 +445,11 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 		operatingSystem := d.Get("operating_system").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v2.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
@@ -457,21 +459,21 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 			OperatingSystem: operatingSystem,
 		}, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the operating_system %s: %s", operatingSystem, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the operating_system %s: %s", operatingSystem, err))
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolRead(d, meta)
+	return resourceIBMContainerWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -479,7 +481,7 @@ func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var orphan_on_delete bool = false
@@ -492,11 +494,11 @@ func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface
 	} else {
 		err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		_, err = WaitForWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = WaitForWorkerDelete(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err))
 		}
 	}
 	return nil
@@ -536,7 +538,7 @@ func resourceIBMContainerWorkerPoolExists(d *schema.ResourceData, meta interface
 	return workerPool.ID == workerPoolID, nil
 }
 
-func WaitForWorkerNormal(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerNormal(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -551,7 +553,7 @@ func WaitForWorkerNormal(clusterNameOrID, workerPoolNameOrID string, meta interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -572,7 +574,7 @@ func workerPoolStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrI
 	}
 }
 
-func WaitForWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -587,7 +589,7 @@ func WaitForWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func workerPoolDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1,6 +1,7 @@
 package softwaredefinedstorage
 
 import (
+	"context"
 	"log"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -14,12 +15,12 @@ func NewSdsNoop() Sds {
 	return &noopSds{}
 }
 
-func (noop noopSds) PreWorkerReplace(worker v2.Worker) error {
+func (noop noopSds) PreWorkerReplace(ctx context.Context, worker v2.Worker) error {
 	log.Println("In NoopSds PreWorkerReplace")
 	return nil
 }
 
-func (noop noopSds) PostWorkerReplace(worker v2.Worker) error {
+func (noop noopSds) PostWorkerReplace(ctx context.Context, worker v2.Worker) error {
 	log.Println("In NoopSds PostWorkerReplace")
 	return nil
 }
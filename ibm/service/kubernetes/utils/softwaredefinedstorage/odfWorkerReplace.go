@@ -10,6 +10,7 @@ import (
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	templatev1 "github.com/openshift/api/template/v1"
 	templatev1client "github.com/openshift/client-go/template/clientset/versioned/typed/template/v1"
@@ -36,7 +37,7 @@ func NewSdsOdf() Sds {
 }
 
 // Steps before Worker Replace for ODF
-func (o odf) PreWorkerReplace(worker v2.Worker) error {
+func (o odf) PreWorkerReplace(ctx context.Context, worker v2.Worker) error {
 	log.Println("Inside preWorkerReplace for ODF")
 	workerName := worker.NetworkInterfaces[0].IpAddress
 	log.Println("This is the Worker to be replaced", workerName)
@@ -49,7 +50,7 @@ func (o odf) PreWorkerReplace(worker v2.Worker) error {
 	}
 	// Check if Replica Set is 0
 	for _, v := range deploymentList {
-		_, err := waitForOdfDeploymentStatus(0, v)
+		_, err := waitForOdfDeploymentStatus(ctx, 0, v)
 		if err != nil {
 			return err
 		}
@@ -59,13 +60,13 @@ func (o odf) PreWorkerReplace(worker v2.Worker) error {
 	node := workerName
 
 	// Cordon the node
-	_, err := waitForNodeCordonStatus(node)
+	_, err := waitForNodeCordonStatus(ctx, node)
 	if err != nil {
 		return err
 	}
 
 	//Drain the node
-	pods, err := clientSet.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{FieldSelector: "spec.nodeName=" + workerName})
+	pods, err := clientSet.CoreV1().Pods("").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=" + workerName})
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting Pods from worker node %s - %s", workerName, err)
 	}
@@ -123,19 +124,19 @@ func scalePods(workerName string, appLabel string, appLabelValue string, idLabel
 }
 
 // Steps after worker replace has been complete
-func (o odf) PostWorkerReplace(worker v2.Worker) error {
+func (o odf) PostWorkerReplace(ctx context.Context, worker v2.Worker) error {
 	log.Println("In Post Worker Replace")
 	deploymentsClient := clientSet.AppsV1().Deployments(odfNamespace)
 	log.Println("Scaling Up Deployments")
 	// Scale Up Deployments that have been Brought down in pre worker replace
 	for _, v := range deploymentList {
 		if !strings.Contains(v, crashcollectorLabel) {
-			result, err := deploymentsClient.Get(context.TODO(), v, metav1.GetOptions{})
+			result, err := deploymentsClient.Get(ctx, v, metav1.GetOptions{})
 			if err != nil {
 				return fmt.Errorf("[ERROR] Failed to get latest version of deployment: %v - %v", v, err)
 			}
 			result.Spec.Replicas = int32Ptr(1) // increase replica count
-			scaleDeployment, err := deploymentsClient.Update(context.TODO(), result, metav1.UpdateOptions{})
+			scaleDeployment, err := deploymentsClient.Update(ctx, result, metav1.UpdateOptions{})
 			if err != nil {
 				return fmt.Errorf("[Error] Error updating scale object in deployment: %v - %v", v, err)
 			}
@@ -147,7 +148,7 @@ func (o odf) PostWorkerReplace(worker v2.Worker) error {
 	// Check if the deployment replicas are 1
 	for _, v := range deploymentList {
 		if !strings.Contains(v, crashcollectorLabel) {
-			_, err := waitForOdfDeploymentStatus(1, v)
+			_, err := waitForOdfDeploymentStatus(ctx, 1, v)
 			if err != nil {
 				return err
 			}
@@ -165,7 +166,7 @@ func (o odf) PostWorkerReplace(worker v2.Worker) error {
 		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
 		FieldSelector: "spec.nodeName=" + workerName}
 
-	pods, err := clientSet.CoreV1().Pods(odfNamespace).List(context.TODO(), listOptions)
+	pods, err := clientSet.CoreV1().Pods(odfNamespace).List(ctx, listOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting Pods in the openshift-storage namespace %v", err)
 	}
@@ -179,7 +180,7 @@ func (o odf) PostWorkerReplace(worker v2.Worker) error {
 	}
 	osdID = strings.TrimRight(osdID, ",")
 	if len(osdID) > 0 {
-		err := executeTemplate(osdID)
+		err := executeTemplate(ctx, osdID)
 		if err != nil {
 			return err
 		}
@@ -187,7 +188,7 @@ func (o odf) PostWorkerReplace(worker v2.Worker) error {
 
 	// Check Ceph Status if HEALTH OK then return success!
 	log.Println("Fetching Ceph Cluster Status")
-	_, err = waitForCephClusterStatus()
+	_, err = waitForCephClusterStatus(ctx)
 	if err != nil {
 		return err
 	}
@@ -197,7 +198,7 @@ func (o odf) PostWorkerReplace(worker v2.Worker) error {
 func int32Ptr(i int32) *int32 { return &i }
 
 // To execute a given template using the openshift go client
-func executeTemplate(osdID string) error {
+func executeTemplate(ctx context.Context, osdID string) error {
 	// Create an OpenShift template/v1 client.
 	templateclient, err := templatev1client.NewForConfig(restConfig)
 	if err != nil {
@@ -245,7 +246,7 @@ func executeTemplate(osdID string) error {
 		return fmt.Errorf("[ERROR] Error deploying ocs-osd-removal template %s", err)
 	}
 
-	_, err = waitForTemplateInstanceStatus(templateInstance)
+	_, err = waitForTemplateInstanceStatus(ctx, templateInstance)
 	if err != nil {
 		return err
 	}
@@ -256,14 +257,14 @@ func executeTemplate(osdID string) error {
 	// itself disappears.
 	foreground := metav1.DeletePropagationForeground
 	deleteOptions := metav1.DeleteOptions{PropagationPolicy: &foreground}
-	err = templateclient.TemplateInstances(odfNamespace).Delete(context.TODO(), templateInstance.Name,
+	err = templateclient.TemplateInstances(odfNamespace).Delete(ctx, templateInstance.Name,
 		deleteOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting TemplateInstance resource %s", err)
 	}
 
 	// Finally delete the "parameters" Secret.
-	err = clientSet.CoreV1().Secrets(odfNamespace).Delete(context.TODO(), secret.Name,
+	err = clientSet.CoreV1().Secrets(odfNamespace).Delete(ctx, secret.Name,
 		metav1.DeleteOptions{})
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting parameter Secret resource %s", err)
@@ -272,7 +273,7 @@ func executeTemplate(osdID string) error {
 	return nil
 }
 
-func waitForOdfDeploymentStatus(replicas int32, deploymentName string) (interface{}, error) {
+func waitForOdfDeploymentStatus(ctx context.Context, replicas int32, deploymentName string) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -282,7 +283,7 @@ func waitForOdfDeploymentStatus(replicas int32, deploymentName string) (interfac
 		MinTimeout:     5 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func odfDeploymentRefreshFunc(replicas int32, deploymentName string) resource.StateRefreshFunc {
@@ -302,7 +303,7 @@ func odfDeploymentRefreshFunc(replicas int32, deploymentName string) resource.St
 	}
 }
 
-func waitForCephClusterStatus() (interface{}, error) {
+func waitForCephClusterStatus(ctx context.Context) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -312,7 +313,7 @@ func waitForCephClusterStatus() (interface{}, error) {
 		MinTimeout:     5 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func cephClusterRefreshFunc() resource.StateRefreshFunc {
@@ -354,7 +355,7 @@ func cephClusterRefreshFunc() resource.StateRefreshFunc {
 	}
 }
 
-func waitForNodeCordonStatus(node string) (interface{}, error) {
+func waitForNodeCordonStatus(ctx context.Context, node string) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -364,7 +365,7 @@ func waitForNodeCordonStatus(node string) (interface{}, error) {
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func nodeCordonRefreshFunc(node string) resource.StateRefreshFunc {
@@ -390,7 +391,7 @@ func nodeCordonRefreshFunc(node string) resource.StateRefreshFunc {
 	}
 }
 
-func waitForTemplateInstanceStatus(templateInstance *templatev1.TemplateInstance) (interface{}, error) {
+func waitForTemplateInstanceStatus(ctx context.Context, templateInstance *templatev1.TemplateInstance) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -400,7 +401,7 @@ func waitForTemplateInstanceStatus(templateInstance *templatev1.TemplateInstance
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func templateInstanceRefreshFunc(templateInstance *templatev1.TemplateInstance) resource.StateRefreshFunc {
@@ -1,6 +1,7 @@
 package softwaredefinedstorage
 
 import (
+	"context"
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -23,8 +24,8 @@ var sdsTimeout time.Duration
 
 // Common Interface for different Software Defined Solutions
 type Sds interface {
-	PreWorkerReplace(worker v2.Worker) error
-	PostWorkerReplace(worker v2.Worker) error
+	PreWorkerReplace(ctx context.Context, worker v2.Worker) error
+	PostWorkerReplace(ctx context.Context, worker v2.Worker) error
 }
 
 // Set global variables frequently used in Pre/Post Worker replace actions
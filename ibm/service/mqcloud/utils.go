@@ -73,7 +73,7 @@ func waitForQmStatusUpdate(context context.Context, d *schema.ResourceData, meta
 		Delay:      10 * time.Second,
 		MinTimeout: 30 * time.Second,
 	}
-	return stateConf.WaitForStateContext(context)
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func waitForQueueManagerToDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -110,7 +110,7 @@ func waitForQueueManagerToDelete(context context.Context, d *schema.ResourceData
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context)
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func IsVersionDowngrade(oldVersion, newVersion string) bool {
@@ -24,12 +24,12 @@ func ResourceIBMPag() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create:   resourcecontroller.ResourceIBMResourceInstanceCreate,
-		Read:     resourcecontroller.ResourceIBMResourceInstanceRead,
-		Update:   resourcecontroller.ResourceIBMResourceInstanceUpdate,
-		Delete:   resourcecontroller.ResourceIBMResourceInstanceDelete,
-		Exists:   resourcecontroller.ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourcecontroller.ResourceIBMResourceInstanceCreate,
+		ReadContext:   resourcecontroller.ResourceIBMResourceInstanceRead,
+		UpdateContext: resourcecontroller.ResourceIBMResourceInstanceUpdate,
+		DeleteContext: resourcecontroller.ResourceIBMResourceInstanceDelete,
+		Exists:        resourcecontroller.ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -37,6 +37,7 @@ const (
 	Arg_ConsistencyGroupName                 = "pi_consistency_group_name"
 	Arg_Datacenter                           = "pi_datacenter"
 	Arg_DatacenterZone                       = "pi_datacenter_zone"
+	Arg_DeleteImage                          = "pi_delete_image"
 	Arg_DeploymentTarget                     = "pi_deployment_target"
 	Arg_DeploymentType                       = "pi_deployment_type"
 	Arg_Description                          = "pi_description"
@@ -143,7 +144,9 @@ const (
 	Arg_StorageType                          = "pi_storage_type"
 	Arg_SysType                              = "pi_sys_type"
 	Arg_Target                               = "pi_target"
+	Arg_TargetCloudInstanceID                = "pi_target_cloud_instance_id"
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
+	Arg_TargetZone                           = "pi_target_zone"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
 	Arg_UserTags                             = "pi_user_tags"
@@ -187,6 +190,7 @@ const (
 	Attr_Capabilities                    = "capabilities"
 	Attr_CapabilityDetails               = "capability_details"
 	Attr_Capacity                        = "capacity"
+	Attr_CaptureName                     = "capture_name"
 	Attr_Certified                       = "certified"
 	Attr_CIDR                            = "cidr"
 	Attr_ClassicEnabled                  = "classic_enabled"
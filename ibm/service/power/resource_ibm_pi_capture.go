@@ -205,7 +205,7 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 		if imagedata.Crn != "" {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imagedata.Crn), "", UserTagType)
+			err = flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(imagedata.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi capture (%s) pi_user_tags during creation: %s", *imagedata.ImageID, err)
 			}
@@ -298,7 +298,7 @@ func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, met
 	if capturedestination != CloudStorage && d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi capture (%s) pi_user_tags: %s", captureID, err)
 			}
@@ -282,7 +282,7 @@ func waitForIBMPIDhcpStatus(ctx context.Context, client *instance.IBMPIDhcpClien
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func waitForIBMPIDhcpDeleted(ctx context.Context, client *instance.IBMPIDhcpClient, dhcpID string, timeout time.Duration) (interface{}, error) {
@@ -301,5 +301,5 @@ func waitForIBMPIDhcpDeleted(ctx context.Context, client *instance.IBMPIDhcpClie
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
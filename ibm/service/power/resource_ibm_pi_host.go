@@ -221,7 +221,7 @@ func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta i
 	tags := flex.FlattenSet(host[Attr_UserTags].(*schema.Set))
 	if hostResponse[0].Crn != "" && len(tags) > 0 {
 		oldList, newList := d.GetChange(Arg_Host + ".0." + Attr_UserTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(hostResponse[0].Crn), "", UserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(hostResponse[0].Crn), "", UserTagType)
 		if err != nil {
 			log.Printf("Error on update of pi host (%s) user_tags during creation: %s", hostResponse[0].ID, err)
 		}
@@ -317,7 +317,7 @@ func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			userTagsOld := oldHost.(map[string]interface{})[Attr_UserTags].(*schema.Set)
 			userTagsNew := newHost.(map[string]interface{})[Attr_UserTags].(*schema.Set)
 			if !userTagsNew.Equal(userTagsOld) {
-				err = flex.UpdateGlobalTagsUsingCRN(userTagsOld, userTagsNew, meta, crn.(string), "", UserTagType)
+				err = flex.UpdateGlobalTagsUsingCRN(ctx, userTagsOld, userTagsNew, meta, crn.(string), "", UserTagType)
 				if err != nil {
 					log.Printf("Error on update of pi host (%s) pi_host user_tags: %s", d.Get(Attr_HostID), err)
 				}
@@ -362,7 +362,7 @@ func isWaitForPIHostDeleted(ctx context.Context, client *instance.IBMPIHostGroup
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIHostDeleteRefreshFunc(client *instance.IBMPIHostGroupsClient, id string) retry.StateRefreshFunc {
@@ -431,7 +431,7 @@ func isWaitForIBMPIHostAvailable(ctx context.Context, client *instance.IBMPIHost
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIHostRefreshFunc(client *instance.IBMPIHostGroupsClient, id string) retry.StateRefreshFunc {
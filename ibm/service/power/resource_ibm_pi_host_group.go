@@ -315,7 +315,7 @@ func isWaitForHostGroupDeleted(ctx context.Context, client *instance.IBMPIHostGr
 		MinTimeout: 10 * time.Second,
 		Timeout:    timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isHostGroupDeleteRefresh(client *instance.IBMPIHostGroupsClient, id string) retry.StateRefreshFunc {
@@ -342,7 +342,7 @@ func isWaitForHostDeleted(ctx context.Context, client *instance.IBMPIHostGroupsC
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isHostDeleteRefreshFunc(client *instance.IBMPIHostGroupsClient, id string) retry.StateRefreshFunc {
@@ -260,7 +260,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		if _, ok := d.GetOk(Arg_UserTags); ok {
 			if imageResponse.Crn != "" {
 				oldList, newList := d.GetChange(Arg_UserTags)
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imageResponse.Crn), "", UserTagType)
+				err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(imageResponse.Crn), "", UserTagType)
 				if err != nil {
 					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *IBMPIImageID, err)
 				}
@@ -355,7 +355,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		if _, ok := d.GetOk(Arg_UserTags); ok {
 			if image.Crn != "" {
 				oldList, newList := d.GetChange(Arg_UserTags)
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(image.Crn), "", UserTagType)
+				err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(image.Crn), "", UserTagType)
 				if err != nil {
 					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *image.ImageID, err)
 				}
@@ -417,7 +417,7 @@ func resourceIBMPIImageUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi image (%s) pi_user_tags: %s", imageID, err)
 			}
@@ -460,7 +460,7 @@ func isWaitForIBMPIImageAvailable(ctx context.Context, client *instance.IBMPIIma
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIImageRefreshFunc(client *instance.IBMPIImageClient, id string) retry.StateRefreshFunc {
@@ -504,5 +504,5 @@ func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClie
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
@@ -561,7 +561,7 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		oldList, newList := d.GetChange(Arg_UserTags)
 		for _, s := range *pvmList {
 			if s.Crn != "" {
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(s.Crn), "", UserTagType)
+				err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(s.Crn), "", UserTagType)
 				if err != nil {
 					log.Printf("Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err)
 				}
@@ -1005,7 +1005,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err)
 			}
@@ -1137,7 +1137,7 @@ func isWaitForPIInstanceDeleted(ctx context.Context, client *instance.IBMPIInsta
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceDeleteRefreshFunc(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
@@ -1168,7 +1168,7 @@ func isWaitForPIInstanceAvailable(ctx context.Context, client *instance.IBMPIIns
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceRefreshFunc(client *instance.IBMPIInstanceClient, id, instanceReadyStatus string) retry.StateRefreshFunc {
@@ -1207,7 +1207,7 @@ func isWaitForPIInstanceAvailableOrShutoffAfterUpdate(ctx context.Context, clien
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceShutoffOrActiveAfterResourceChange(client *instance.IBMPIInstanceClient, id string, instanceReadyStatus string) retry.StateRefreshFunc {
@@ -1242,7 +1242,7 @@ func isWaitForPIInstancePlacementGroupAdd(ctx context.Context, client *instance.
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstancePlacementGroupAddRefreshFunc(client *instance.IBMPIPlacementGroupClient, pgID string, id string) retry.StateRefreshFunc {
@@ -1274,7 +1274,7 @@ func isWaitForPIInstancePlacementGroupDelete(ctx context.Context, client *instan
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstancePlacementGroupDeleteRefreshFunc(client *instance.IBMPIPlacementGroupClient, pgID string, id string) retry.StateRefreshFunc {
@@ -1306,7 +1306,7 @@ func isWaitForPIInstanceSoftwareLicenses(ctx context.Context, client *instance.I
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceSoftwareLicensesRefreshFunc(client *instance.IBMPIInstanceClient, id string, softwareLicenses *models.SoftwareLicenses) retry.StateRefreshFunc {
@@ -1362,7 +1362,7 @@ func isWaitForPIInstanceShutoff(ctx context.Context, client *instance.IBMPIInsta
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceShutoffRefreshFunc(client *instance.IBMPIInstanceClient, id, instanceReadyStatus string) retry.StateRefreshFunc {
@@ -1409,7 +1409,7 @@ func isWaitForPIInstanceStopped(ctx context.Context, client *instance.IBMPIInsta
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceRefreshFuncOff(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
@@ -1512,7 +1512,7 @@ func isWaitForPIInstanceShutoffAfterUpdate(ctx context.Context, client *instance
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceShutAfterResourceChange(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
@@ -0,0 +1,539 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPIInstanceMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIInstanceMigrationCreate,
+		ReadContext:   resourceIBMPIInstanceMigrationRead,
+		UpdateContext: resourceIBMPIInstanceMigrationUpdate,
+		DeleteContext: resourceIBMPIInstanceMigrationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CaptureCloudStorageAccessKey: {
+				Description:  "Cloud Storage access key of the bucket that the instance is captured to",
+				ForceNew:     true,
+				Required:     true,
+				Sensitive:    true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CaptureCloudStorageRegion: {
+				Description:  "Cloud Storage region of the bucket that the instance is captured to",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CaptureCloudStorageSecretKey: {
+				Description:  "Cloud Storage secret key of the bucket that the instance is captured to",
+				ForceNew:     true,
+				Required:     true,
+				Sensitive:    true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CaptureStorageImagePath: {
+				Description:  "Cloud Storage image path that the instance is captured to (bucket-name [/folder/../..])",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance of the source instance.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_DeleteImage: {
+				Default:     true,
+				Description: "Delete the image imported in the target service instance once the new instance is deployed.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_InstanceID: {
+				Description:  "The ID or name of the source instance.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceName: {
+				Computed:    true,
+				Description: "Name of the new instance. Defaults to the name of the source instance.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_KeyPairName: {
+				Description: "SSH key name of the new instance.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_Network: {
+				Description: "List of one or more networks of the target service instance to attach to the new instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_IPAddress: {
+							Optional: true,
+							Type:     schema.TypeString,
+						},
+						Attr_NetworkID: {
+							Required: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+				ForceNew: true,
+				MinItems: 1,
+				Required: true,
+				Type:     schema.TypeList,
+			},
+			Arg_StorageType: {
+				Computed:    true,
+				Description: "Storage type of the new instance. Defaults to the storage type of the source instance.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_SysType: {
+				Computed:    true,
+				Description: "System type of the new instance. Defaults to the system type of the source instance.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_TargetCloudInstanceID: {
+				Description:  "The GUID of the service instance to migrate the instance to.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_TargetZone: {
+				Description: "The zone of the target service instance, when it is not in the zone of the provider.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+
+			// Attributes
+			Attr_CaptureName: {
+				Computed:    true,
+				Description: "The name of the capture of the source instance in Cloud Storage.",
+				Type:        schema.TypeString,
+			},
+			Attr_HealthStatus: {
+				Computed:    true,
+				Description: "The health status of the new instance.",
+				Type:        schema.TypeString,
+			},
+			Attr_InstanceID: {
+				Computed:    true,
+				Description: "The ID of the new instance.",
+				Type:        schema.TypeString,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the new instance.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIInstanceMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetSess, err := getIBMPITargetSession(sess, d.Get(Arg_TargetZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	targetCloudInstanceID := d.Get(Arg_TargetCloudInstanceID).(string)
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	source, err := client.Get(d.Get(Arg_InstanceID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sourceID := *source.PvmInstanceID
+	sourceName := *source.ServerName
+
+	// Capture the instance with all of its data volumes to Cloud Storage,
+	// which both service instances can reach.
+	volumeClient := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumes, err := volumeClient.GetAllInstanceVolumes(sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	volumeIDs := make([]string, 0)
+	for _, v := range volumes.Volumes {
+		if v.BootVolume == nil || !*v.BootVolume {
+			volumeIDs = append(volumeIDs, *v.VolumeID)
+		}
+	}
+
+	captureName := fmt.Sprintf("%s-migration-%d", sourceName, time.Now().Unix())
+	captureDestination := CloudStorage
+	imagePath := d.Get(Arg_CaptureStorageImagePath).(string)
+	accessKey := d.Get(Arg_CaptureCloudStorageAccessKey).(string)
+	secretKey := d.Get(Arg_CaptureCloudStorageSecretKey).(string)
+	bucketRegion := d.Get(Arg_CaptureCloudStorageRegion).(string)
+	bucketName, imageFilename := ibmPIInstanceMigrationCaptureObject(imagePath, captureName)
+	cosClient, err := newIBMPIInstanceMigrationCOSClient(ibmPIInstanceMigrationCOSEndpoint(bucketRegion), bucketRegion, accessKey, secretKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	captureBody := &models.PVMInstanceCapture{
+		CaptureDestination:    &captureDestination,
+		CaptureName:           &captureName,
+		CaptureVolumeIDs:      volumeIDs,
+		CloudStorageAccessKey: accessKey,
+		CloudStorageImagePath: imagePath,
+		CloudStorageRegion:    bucketRegion,
+		CloudStorageSecretKey: secretKey,
+	}
+	log.Printf("[INFO] Capturing pvm instance (%s) with %d data volumes to %s", sourceID, len(volumeIDs), imagePath)
+	captureResponse, err := client.CaptureInstanceToImageCatalogV2(sourceID, captureBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		// A failed capture can leave a partial object in the bucket.
+		deleteIBMPIInstanceMigrationCapture(cosClient, bucketName, imageFilename)
+		return diag.Errorf("failed to capture pvm instance (%s): %s", sourceID, err)
+	}
+	d.Set(Attr_CaptureName, captureName)
+
+	// Import the capture in the target service instance.
+	bucketAccess := "private"
+	importBody := &models.CreateCosImageImportJob{
+		AccessKey:     accessKey,
+		BucketAccess:  &bucketAccess,
+		BucketName:    &bucketName,
+		ImageFilename: &imageFilename,
+		ImageName:     &captureName,
+		Region:        &bucketRegion,
+		SecretKey:     secretKey,
+	}
+	if v, ok := d.GetOk(Arg_StorageType); ok {
+		importBody.StorageType = v.(string)
+	} else if source.StorageType != nil {
+		importBody.StorageType = *source.StorageType
+	}
+	log.Printf("[INFO] Importing capture (%s) in service instance (%s)", captureName, targetCloudInstanceID)
+	imageClient := instance.NewIBMPIImageClient(ctx, targetSess, targetCloudInstanceID)
+	importResponse, err := imageClient.CreateCosImage(importBody)
+	if err == nil {
+		targetJobClient := instance.NewIBMPIJobClient(ctx, targetSess, targetCloudInstanceID)
+		_, err = waitForIBMPIJobCompleted(ctx, targetJobClient, *importResponse.ID, d.Timeout(schema.TimeoutCreate))
+	}
+	// The capture is not needed anymore once it is imported, or when the
+	// import failed.
+	deleteIBMPIInstanceMigrationCapture(cosClient, bucketName, imageFilename)
+	if err != nil {
+		deleteIBMPIInstanceMigrationImportedImage(imageClient, captureName)
+		return diag.Errorf("failed to import capture (%s) in service instance (%s): %s", captureName, targetCloudInstanceID, err)
+	}
+	image, err := imageClient.Get(captureName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	imageID := *image.ImageID
+
+	// Deploy the new instance from the imported image.
+	name := sourceName
+	if v, ok := d.GetOk(Arg_InstanceName); ok {
+		name = v.(string)
+	}
+	body := &models.PVMInstanceCreate{
+		ImageID:     &imageID,
+		Memory:      source.Memory,
+		Networks:    expandPIInstanceMigrationNetworks(d.Get(Arg_Network).([]interface{})),
+		ProcType:    source.ProcType,
+		Processors:  source.Processors,
+		ServerName:  &name,
+		StorageType: importBody.StorageType,
+		SysType:     source.SysType,
+	}
+	if v, ok := d.GetOk(Arg_SysType); ok {
+		body.SysType = v.(string)
+	}
+	if v, ok := d.GetOk(Arg_KeyPairName); ok {
+		body.KeyPairName = v.(string)
+	}
+	targetClient := instance.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+	pvmList, err := targetClient.Create(body)
+	if err == nil && (pvmList == nil || len(*pvmList) == 0) {
+		err = fmt.Errorf("no instance was created")
+	}
+	if err != nil {
+		deleteIBMPIInstanceMigrationImage(imageClient, imageID)
+		return diag.Errorf("failed to deploy image (%s) in service instance (%s): %s", imageID, targetCloudInstanceID, err)
+	}
+	instanceID := *(*pvmList)[0].PvmInstanceID
+	d.SetId(fmt.Sprintf("%s/%s", targetCloudInstanceID, instanceID))
+
+	_, err = isWaitForPIInstanceAvailable(ctx, targetClient, instanceID, OK, d.Timeout(schema.TimeoutCreate))
+	if d.Get(Arg_DeleteImage).(bool) {
+		deleteIBMPIInstanceMigrationImage(imageClient, imageID)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIInstanceMigrationRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetSess, err := getIBMPITargetSession(sess, d.Get(Arg_TargetZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targetCloudInstanceID, instanceID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := instance.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+	powervmdata, err := client.Get(instanceID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[DEBUG] pvm instance (%s) does not exist %v", instanceID, err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_InstanceName, powervmdata.ServerName)
+	d.Set(Arg_StorageType, powervmdata.StorageType)
+	d.Set(Arg_SysType, powervmdata.SysType)
+	d.Set(Arg_TargetCloudInstanceID, targetCloudInstanceID)
+	d.Set(Attr_InstanceID, instanceID)
+	d.Set(Attr_Status, powervmdata.Status)
+	if powervmdata.Health != nil {
+		d.Set(Attr_HealthStatus, powervmdata.Health.Status)
+	}
+
+	return nil
+}
+
+func resourceIBMPIInstanceMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// pi_delete_image only applies while the instance is migrated.
+	return resourceIBMPIInstanceMigrationRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetSess, err := getIBMPITargetSession(sess, d.Get(Arg_TargetZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targetCloudInstanceID, instanceID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := instance.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+	err = client.Delete(instanceID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[DEBUG] pvm instance (%s) is already deleted %v", instanceID, err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getIBMPITargetSession returns a session to the zone of the target service
+// instance, which shares the credentials, account, endpoint and transport of
+// the provider session.
+func getIBMPITargetSession(sess *ibmpisession.IBMPISession, zone string) (*ibmpisession.IBMPISession, error) {
+	if zone == "" || zone == sess.Options.Zone {
+		return sess, nil
+	}
+	options := *sess.Options
+	region := options.Region
+	if region == "" {
+		region = ibmPIRegionFromZone(options.Zone)
+	}
+	options.Zone = zone
+	options.Region = ibmPIRegionFromZone(zone)
+	options.URL = ibmPITargetURL(options.URL, region, options.Region)
+	targetSess, err := ibmpisession.NewIBMPISession(&options)
+	if err != nil {
+		return nil, fmt.Errorf("error occured while configuring ibmpisession for zone %s: %q", zone, err)
+	}
+	// Keep the retry policy that the provider sets up on its transport.
+	if runtime, ok := sess.Power.Transport.(*httptransport.Runtime); ok {
+		if targetRuntime, ok := targetSess.Power.Transport.(*httptransport.Runtime); ok {
+			targetRuntime.Transport = runtime.Transport
+		}
+	}
+	return targetSess, nil
+}
+
+// ibmPIRegionFromZone returns the region of a zone the way the power client
+// does: dal10 is in dal and eu-de-1 in eu-de.
+func ibmPIRegionFromZone(zone string) string {
+	if strings.Contains(zone, "-") {
+		return regexp.MustCompile(`-[0-9]+$`).ReplaceAllString(zone, "")
+	}
+	return regexp.MustCompile(`[0-9]+$`).ReplaceAllString(zone, "")
+}
+
+// ibmPITargetURL returns the endpoint of the target region, which replaces
+// the region in the host of the provider endpoint, so that private endpoints
+// stay private. An endpoint without the region is used as it is.
+func ibmPITargetURL(url, region, targetRegion string) string {
+	if url == "" || region == "" || region == targetRegion {
+		return url
+	}
+	scheme, host, found := strings.Cut(url, "://")
+	if !found {
+		scheme, host = "", url
+	}
+	host, path, _ := strings.Cut(host, "/")
+	labels := strings.Split(host, ".")
+	for i, label := range labels {
+		if label == region {
+			labels[i] = targetRegion
+			host = strings.Join(labels, ".")
+			if path != "" {
+				host += "/" + path
+			}
+			if found {
+				return scheme + "://" + host
+			}
+			return host
+		}
+	}
+	return url
+}
+
+// ibmPIInstanceMigrationCaptureObject returns the bucket and the key of the
+// object that an instance is captured to in Cloud Storage.
+func ibmPIInstanceMigrationCaptureObject(imagePath, captureName string) (string, string) {
+	bucketName, imageFolder, _ := strings.Cut(strings.Trim(imagePath, "/"), "/")
+	imageFilename := captureName + ".ova.gz"
+	if imageFolder = strings.Trim(imageFolder, "/"); imageFolder != "" {
+		imageFilename = imageFolder + "/" + imageFilename
+	}
+	return bucketName, imageFilename
+}
+
+func ibmPIInstanceMigrationCOSEndpoint(region string) string {
+	return fmt.Sprintf("https://s3.%s.cloud-object-storage.appdomain.cloud", region)
+}
+
+// newIBMPIInstanceMigrationCOSClient returns a Cloud Storage client that
+// authenticates with the HMAC keys the instance is captured with.
+func newIBMPIInstanceMigrationCOSClient(endpoint, region, accessKey, secretKey string) (*s3.S3, error) {
+	s3Sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("error occured while configuring the Cloud Storage client: %s", err)
+	}
+	s3Conf := aws.NewConfig().WithEndpoint(endpoint).WithRegion(region).WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, "")).WithS3ForcePathStyle(true)
+	return s3.New(s3Sess, s3Conf), nil
+}
+
+func deleteIBMPIInstanceMigrationCapture(client *s3.S3, bucketName, key string) {
+	_, err := client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		log.Printf("[WARN] failed to delete the capture (%s) from bucket (%s): %s", key, bucketName, err)
+	}
+}
+
+// ibmPIInstanceMigrationImageClient is the part of the image client that the
+// cleanup of the imported image uses.
+type ibmPIInstanceMigrationImageClient interface {
+	Get(id string) (*models.Image, error)
+	Delete(id string) error
+}
+
+// deleteIBMPIInstanceMigrationImportedImage deletes the image that a failed
+// import may have left in the target service instance.
+func deleteIBMPIInstanceMigrationImportedImage(client ibmPIInstanceMigrationImageClient, imageName string) {
+	image, err := client.Get(imageName)
+	if err != nil || image.ImageID == nil {
+		return
+	}
+	deleteIBMPIInstanceMigrationImage(client, *image.ImageID)
+}
+
+func deleteIBMPIInstanceMigrationImage(client ibmPIInstanceMigrationImageClient, imageID string) {
+	if err := client.Delete(imageID); err != nil {
+		log.Printf("[WARN] failed to delete the migrated image (%s): %s", imageID, err)
+	}
+}
+
+func expandPIInstanceMigrationNetworks(networks []interface{}) []*models.PVMInstanceAddNetwork {
+	pvmNetworks := make([]*models.PVMInstanceAddNetwork, 0, len(networks))
+	for _, v := range networks {
+		network := v.(map[string]interface{})
+		pvmNetworks = append(pvmNetworks, &models.PVMInstanceAddNetwork{
+			IPAddress: network[Attr_IPAddress].(string),
+			NetworkID: flex.PtrToString(network[Attr_NetworkID].(string)),
+		})
+	}
+	return pvmNetworks
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	httptransport "github.com/go-openapi/runtime/client"
+)
+
+func TestIBMPIRegionFromZone(t *testing.T) {
+	cases := map[string]string{
+		"dal10":    "dal",
+		"wdc06":    "wdc",
+		"eu-de-1":  "eu-de",
+		"us-south": "us-south",
+	}
+	for zone, expected := range cases {
+		t.Run(zone, func(t *testing.T) {
+			if region := ibmPIRegionFromZone(zone); region != expected {
+				t.Errorf("expected %q, got %q", expected, region)
+			}
+		})
+	}
+}
+
+func TestIBMPITargetURL(t *testing.T) {
+	cases := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{"public", "https://dal.power-iaas.cloud.ibm.com", "https://wdc.power-iaas.cloud.ibm.com"},
+		{"private", "https://private.dal.power-iaas.cloud.ibm.com", "https://private.wdc.power-iaas.cloud.ibm.com"},
+		{"without a scheme", "dal.power-iaas.cloud.ibm.com", "wdc.power-iaas.cloud.ibm.com"},
+		{"with a path", "https://dal.power-iaas.cloud.ibm.com/dal", "https://wdc.power-iaas.cloud.ibm.com/dal"},
+		{"custom", "https://power.example.com", "https://power.example.com"},
+		{"empty", "", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if url := ibmPITargetURL(c.url, "dal", "wdc"); url != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, url)
+			}
+		})
+	}
+}
+
+type testIBMPIRoundTripper struct{}
+
+func (testIBMPIRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func TestGetIBMPITargetSession(t *testing.T) {
+	sess, err := ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: &core.NoAuthAuthenticator{},
+		Region:        "dal",
+		URL:           "https://private.dal.power-iaas.cloud.ibm.com",
+		UserAccount:   "account",
+		Zone:          "dal10",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transport := testIBMPIRoundTripper{}
+	sess.Power.Transport.(*httptransport.Runtime).Transport = transport
+
+	if targetSess, err := getIBMPITargetSession(sess, "dal10"); err != nil || targetSess != sess {
+		t.Errorf("expected the provider session in its own zone, got %v, %v", targetSess, err)
+	}
+
+	targetSess, err := getIBMPITargetSession(sess, "wdc06")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if targetSess.Options.Zone != "wdc06" || targetSess.Options.Region != "wdc" {
+		t.Errorf("expected zone wdc06 in region wdc, got %q in %q", targetSess.Options.Zone, targetSess.Options.Region)
+	}
+	runtime := targetSess.Power.Transport.(*httptransport.Runtime)
+	if runtime.Host != "private.wdc.power-iaas.cloud.ibm.com" {
+		t.Errorf("expected the private endpoint of wdc, got %q", runtime.Host)
+	}
+	if runtime.Transport != transport {
+		t.Errorf("expected the transport of the provider session, got %v", runtime.Transport)
+	}
+	if sess.Options.Zone != "dal10" || sess.Options.URL != "https://private.dal.power-iaas.cloud.ibm.com" {
+		t.Errorf("expected the provider session to be unchanged, got %+v", sess.Options)
+	}
+}
+
+func TestIBMPIInstanceMigrationCaptureObject(t *testing.T) {
+	cases := []struct {
+		imagePath string
+		bucket    string
+		key       string
+	}{
+		{"bucket", "bucket", "capture.ova.gz"},
+		{"/bucket/", "bucket", "capture.ova.gz"},
+		{"bucket/images", "bucket", "images/capture.ova.gz"},
+		{"bucket/images/power/", "bucket", "images/power/capture.ova.gz"},
+	}
+	for _, c := range cases {
+		t.Run(c.imagePath, func(t *testing.T) {
+			bucket, key := ibmPIInstanceMigrationCaptureObject(c.imagePath, "capture")
+			if bucket != c.bucket || key != c.key {
+				t.Errorf("expected %s %s, got %s %s", c.bucket, c.key, bucket, key)
+			}
+		})
+	}
+}
+
+func TestDeleteIBMPIInstanceMigrationCapture(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+	}{
+		{"deleted", http.StatusNoContent},
+		{"failure is ignored", http.StatusForbidden},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				if auth := r.Header.Get("Authorization"); !strings.Contains(auth, "Credential=access-key/") || !strings.Contains(auth, "/us-south/s3/") {
+					t.Errorf("expected the request to be signed with the access key, got %q", auth)
+				}
+				w.WriteHeader(c.status)
+			}))
+			defer server.Close()
+
+			client, err := newIBMPIInstanceMigrationCOSClient(server.URL, "us-south", "access-key", "secret-key")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			deleteIBMPIInstanceMigrationCapture(client, "bucket", "images/capture.ova.gz")
+			if len(requests) == 0 || requests[0] != "DELETE /bucket/images/capture.ova.gz" {
+				t.Errorf("expected the capture to be deleted, got %v", requests)
+			}
+		})
+	}
+}
+
+type testIBMPIInstanceMigrationImageClient struct {
+	images  map[string]string
+	deleted []string
+}
+
+func (c *testIBMPIInstanceMigrationImageClient) Get(id string) (*models.Image, error) {
+	imageID, ok := c.images[id]
+	if !ok {
+		return nil, fmt.Errorf("image %s: not found", id)
+	}
+	return &models.Image{ImageID: &imageID, Name: &id}, nil
+}
+
+func (c *testIBMPIInstanceMigrationImageClient) Delete(id string) error {
+	c.deleted = append(c.deleted, id)
+	return nil
+}
+
+func TestDeleteIBMPIInstanceMigrationImportedImage(t *testing.T) {
+	cases := []struct {
+		name     string
+		images   map[string]string
+		expected []string
+	}{
+		{"partially imported", map[string]string{"capture": "image-id"}, []string{"image-id"}},
+		{"not imported", map[string]string{}, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := &testIBMPIInstanceMigrationImageClient{images: c.images}
+			deleteIBMPIInstanceMigrationImportedImage(client, "capture")
+			if fmt.Sprint(client.deleted) != fmt.Sprint(c.expected) {
+				t.Errorf("expected %v to be deleted, got %v", c.expected, client.deleted)
+			}
+		})
+	}
+}
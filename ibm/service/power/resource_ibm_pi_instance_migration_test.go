@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIInstanceMigrationBasic(t *testing.T) {
+	migrationRes := "ibm_pi_instance_migration.migration"
+	name := fmt.Sprintf("tf-pi-migration-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceMigrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceMigrationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceMigrationExists(migrationRes),
+					resource.TestCheckResourceAttr(migrationRes, "pi_instance_name", name),
+					resource.TestCheckResourceAttrSet(migrationRes, "instance_id"),
+					resource.TestCheckResourceAttrSet(migrationRes, "capture_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceMigrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIInstanceClient(context.Background(), sess, parts[0])
+
+		_, err = client.Get(parts[1])
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIBMPIInstanceMigrationDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_instance_migration" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIInstanceClient(context.Background(), sess, parts[0])
+		_, err = client.Get(parts[1])
+		if err == nil {
+			return fmt.Errorf("PI Instance still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIInstanceMigrationConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_instance_migration" "migration" {
+		pi_cloud_instance_id                = "%[1]s"
+		pi_instance_id                      = "%[2]s"
+		pi_instance_name                    = "%[3]s"
+		pi_target_cloud_instance_id         = "%[4]s"
+		pi_capture_cloud_storage_region     = "%[5]s"
+		pi_capture_cloud_storage_access_key = "%[6]s"
+		pi_capture_cloud_storage_secret_key = "%[7]s"
+		pi_capture_storage_image_path       = "%[8]s"
+
+		pi_network {
+			network_id = "%[9]s"
+		}
+	}`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, name, acc.Pi_target_cloud_instance_id, acc.Pi_capture_cloud_storage_region, acc.Pi_capture_cloud_storage_access_key, acc.Pi_capture_cloud_storage_secret_key, acc.Pi_capture_storage_image_path, acc.Pi_target_network_id)
+}
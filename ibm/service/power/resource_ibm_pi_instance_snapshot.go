@@ -157,7 +157,7 @@ func resourceIBMPIInstanceSnapshotCreate(ctx context.Context, d *schema.Resource
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if snapshotResponse.Crn != "" {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
 			}
@@ -234,7 +234,7 @@ func resourceIBMPIInstanceSnapshotUpdate(ctx context.Context, d *schema.Resource
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
 			}
@@ -280,7 +280,7 @@ func isWaitForPIInstanceSnapshotAvailable(ctx context.Context, client *instance.
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceSnapshotRefreshFunc(client *instance.IBMPISnapshotClient, id string) retry.StateRefreshFunc {
@@ -309,7 +309,7 @@ func isWaitForPIInstanceSnapshotDeleted(ctx context.Context, client *instance.IB
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIInstanceSnapshotDeleteRefreshFunc(client *instance.IBMPISnapshotClient, id string) retry.StateRefreshFunc {
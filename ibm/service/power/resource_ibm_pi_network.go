@@ -318,7 +318,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if networkResponse.Crn != "" {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(networkResponse.Crn), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(networkResponse.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", networkID, err)
 			}
@@ -429,7 +429,7 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi network (%s) pi_user_tags: %s", networkID, err)
 			}
@@ -476,7 +476,7 @@ func isWaitForIBMPINetworkAvailable(ctx context.Context, client *instance.IBMPIN
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkRefreshFunc(client *instance.IBMPINetworkClient, id string) retry.StateRefreshFunc {
@@ -504,7 +504,7 @@ func isWaitForIBMPINetworkDeleted(ctx context.Context, client *instance.IBMPINet
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkRefreshDeleteFunc(client *instance.IBMPINetworkClient, id string) retry.StateRefreshFunc {
@@ -586,7 +586,7 @@ func waitForPERWorkspaceActive(ctx context.Context, client *instance.IBMPIWorksp
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPERWorkspaceRefreshFunc(client *instance.IBMPIWorkspacesClient, id string) retry.StateRefreshFunc {
@@ -657,7 +657,7 @@ func createNetworkWithRetry(ctx context.Context, client *instance.IBMPINetworkCl
 		Timeout:        10 * time.Minute,
 	}
 
-	network, err := stateConf.WaitForStateContext(ctx)
+	network, err := conns.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return nil, fmt.Errorf("%s", lastErr)
 	}
@@ -702,7 +702,7 @@ func deleteNetworkWithRetry(ctx context.Context, client *instance.IBMPINetworkCl
 		Timeout:        10 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := conns.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return fmt.Errorf("%s", lastErr)
 	}
@@ -120,7 +120,7 @@ func resourceIBMPINetworkAddressGroupCreate(ctx context.Context, d *schema.Resou
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if networkAddressGroup.Crn != nil {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkAddressGroup.Crn), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(*networkAddressGroup.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi network address group (%s) pi_user_tags during creation: %s", *networkAddressGroup.ID, err)
 			}
@@ -190,7 +190,7 @@ func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.Resou
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi network address group (%s) pi_user_tags: %s", parts[1], err)
 			}
@@ -245,7 +245,7 @@ func isWaitForIBMPINetworkAddressGroupDeleted(ctx context.Context, client *insta
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isIBMPINetworkAddressGroupDeleteRefreshFunc(client *instance.IBMPINetworkAddressGroupClient, nagID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
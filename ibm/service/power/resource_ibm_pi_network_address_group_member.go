@@ -217,7 +217,7 @@ func isWaitForIBMPINetworkAddressGroupMemberAdd(ctx context.Context, client *ins
 		MinTimeout: 30 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isIBMPINetworkAddressGroupMemberAddRefreshFunc(client *instance.IBMPINetworkAddressGroupClient, id, memberID string) retry.StateRefreshFunc {
 
@@ -250,7 +250,7 @@ func isWaitForIBMPINetworkAddressGroupMemberRemove(ctx context.Context, client *
 		MinTimeout: 30 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isIBMPINetworkAddressGroupMemberRemoveRefreshFunc(client *instance.IBMPINetworkAddressGroupClient, id, memberID string) retry.StateRefreshFunc {
 
@@ -182,7 +182,7 @@ func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.Resource
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if crn != nil {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *crn, "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, *crn, "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", networkInterfaceID, err)
 			}
@@ -265,7 +265,7 @@ func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.Resource
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", parts[2], err)
 			}
@@ -327,7 +327,7 @@ func isWaitForIBMPINetworkInterfaceAvailable(ctx context.Context, client *instan
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkInterfaceRefreshFunc(client *instance.IBMPINetworkClient, networkID, networkInterfaceID string) retry.StateRefreshFunc {
@@ -354,7 +354,7 @@ func isWaitForIBMPINetworkPortUpdateAvailable(ctx context.Context, client *insta
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkInterfaceUpdateRefreshFunc(client *instance.IBMPINetworkClient, networkID, networkInterfaceID, instanceid string) retry.StateRefreshFunc {
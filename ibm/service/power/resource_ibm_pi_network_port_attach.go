@@ -235,7 +235,7 @@ func isWaitForIBMPINetworkportAvailable(ctx context.Context, client *instance.IB
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkportRefreshFunc(client *instance.IBMPINetworkClient, id, networkname string) retry.StateRefreshFunc {
@@ -267,7 +267,7 @@ func isWaitForIBMPINetworkPortAttachAvailable(ctx context.Context, client *insta
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkPortAttachRefreshFunc(client *instance.IBMPINetworkClient, id, networkname, instanceid string) retry.StateRefreshFunc {
@@ -250,7 +250,7 @@ func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.Reso
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if networkSecurityGroup.Crn != nil {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkSecurityGroup.Crn), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(*networkSecurityGroup.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi network security group (%s) pi_user_tags during creation: %s", *networkSecurityGroup.ID, err)
 			}
@@ -331,7 +331,7 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi network security group (%s) pi_user_tags: %s", nsgID, err)
 			}
@@ -382,7 +382,7 @@ func isWaitForIBMPINetworkSecurityGroupDeleted(ctx context.Context, client *inst
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkSecurityGroupDeleteRefreshFunc(client *instance.IBMPINetworkSecurityGroupClient, nsgID string) retry.StateRefreshFunc {
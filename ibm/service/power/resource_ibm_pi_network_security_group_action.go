@@ -143,7 +143,7 @@ func isWaitForWorkspaceActive(ctx context.Context, client *instance.IBMPIWorkspa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isWorkspaceRefreshFunc(client *instance.IBMPIWorkspacesClient, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -173,7 +173,7 @@ func isWaitForNSGStatus(ctx context.Context, client *instance.IBMPIWorkspacesCli
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPERWorkspaceNSGRefreshFunc(client *instance.IBMPIWorkspacesClient, id, action string) retry.StateRefreshFunc {
@@ -575,7 +575,7 @@ func isWaitForIBMPINetworkSecurityGroupRuleAdd(ctx context.Context, client *inst
 		MinTimeout: time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkSecurityGroupRuleAddRefreshFunc(client *instance.IBMPINetworkSecurityGroupClient, id, ruleID string) retry.StateRefreshFunc {
@@ -609,7 +609,7 @@ func isWaitForIBMPINetworkSecurityGroupRuleRemove(ctx context.Context, client *i
 		MinTimeout: time.Minute,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPINetworkSecurityGroupRuleRemoveRefreshFunc(client *instance.IBMPINetworkSecurityGroupClient, id, ruleID string) retry.StateRefreshFunc {
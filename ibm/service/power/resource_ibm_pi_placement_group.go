@@ -167,7 +167,7 @@ func isWaitForPIPlacementGroupDeleted(ctx context.Context, client *instance.IBMP
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPIPlacementGroupDeleteRefreshFunc(client *instance.IBMPIPlacementGroupClient, id string) retry.StateRefreshFunc {
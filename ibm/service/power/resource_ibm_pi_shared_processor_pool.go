@@ -240,7 +240,7 @@ func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.Resou
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		if spp.Crn != "" {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(spp.Crn), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(spp.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi shared processor pool (%s) pi_user_tags during creation: %s", *spp.ID, err)
 			}
@@ -262,7 +262,7 @@ func isWaitForPISharedProcessorPoolAvailable(ctx context.Context, d *schema.Reso
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPISharedProcessorPoolRefreshFunc(client *instance.IBMPISharedProcessorPoolClient, id string) retry.StateRefreshFunc {
@@ -402,7 +402,7 @@ func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.Resou
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi shared processor pool (%s) pi_user_tags: %s", sppID, err)
 			}
@@ -326,7 +326,7 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	if _, ok := d.GetOk(Arg_UserTags); ok {
 		oldList, newList := d.GetChange(Arg_UserTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(vol.Crn), "", UserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, string(vol.Crn), "", UserTagType)
 		if err != nil {
 			log.Printf("Error on update of volume (%s) pi_user_tags during creation: %s", volumeid, err)
 		}
@@ -448,7 +448,7 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		crn := d.Get(Attr_CRN)
 		if crn != nil && crn != "" {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi volume (%s) pi_user_tags: %s", volumeID, err)
 			}
@@ -493,7 +493,7 @@ func isWaitForIBMPIVolumeAvailable(ctx context.Context, client *instance.IBMPIVo
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIVolumeRefreshFunc(client *instance.IBMPIVolumeClient, id string) retry.StateRefreshFunc {
@@ -520,7 +520,7 @@ func isWaitForIBMPIVolumeDeleted(ctx context.Context, client *instance.IBMPIVolu
 		MinTimeout: 2 * time.Minute,
 		Timeout:    timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIVolumeDeleteRefreshFunc(client *instance.IBMPIVolumeClient, id string) retry.StateRefreshFunc {
@@ -271,7 +271,7 @@ func isWaitForIBMPIVolumeGroupAvailable(ctx context.Context, client *instance.IB
 		Timeout:    timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIVolumeGroupRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string) retry.StateRefreshFunc {
@@ -298,7 +298,7 @@ func isWaitForIBMPIVolumeGroupDeleted(ctx context.Context, client *instance.IBMP
 		MinTimeout: 2 * time.Minute,
 		Timeout:    timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIVolumeGroupDeleteRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string) retry.StateRefreshFunc {
@@ -123,7 +123,7 @@ func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 	if tags, ok := d.GetOk(Arg_UserTags); ok {
 		if len(flex.FlattenSet(tags.(*schema.Set))) > 0 {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *controller.CRN, "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, *controller.CRN, "", UserTagType)
 			if err != nil {
 				log.Printf("Error on creation of workspace (%s) pi_user_tags: %s", *controller.CRN, err)
 			}
@@ -141,7 +141,7 @@ func waitForResourceWorkspaceCreate(ctx context.Context, client *instance.IBMPIW
 		MinTimeout: 1 * time.Minute,
 		Timeout:    timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIWorkspaceCreateRefreshFunc(client *instance.IBMPIWorkspacesClient, id string) retry.StateRefreshFunc {
@@ -219,7 +219,7 @@ func waitForResourceWorkspaceDelete(ctx context.Context, client *instance.IBMPIW
 		MinTimeout: 1 * time.Second,
 		Timeout:    timeout,
 	}
-	return stateConf.WaitForStateContext(ctx)
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isIBMPIResourceDeleteRefreshFunc(client *instance.IBMPIWorkspacesClient, id string) retry.StateRefreshFunc {
@@ -246,7 +246,7 @@ func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of workspace (%s) pi_user_tags: %s", crn, err)
 			}
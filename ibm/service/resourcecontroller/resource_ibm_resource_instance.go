@@ -14,6 +14,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,12 +38,12 @@ const (
 
 func ResourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMResourceInstanceCreate,
-		Read:     ResourceIBMResourceInstanceRead,
-		Update:   ResourceIBMResourceInstanceUpdate,
-		Delete:   ResourceIBMResourceInstanceDelete,
-		Exists:   ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMResourceInstanceCreate,
+		ReadContext:   ResourceIBMResourceInstanceRead,
+		UpdateContext: ResourceIBMResourceInstanceUpdate,
+		DeleteContext: ResourceIBMResourceInstanceDelete,
+		Exists:        ResourceIBMResourceInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -404,10 +405,10 @@ func ResourceIBMResourceInstanceValidator() *validate.ResourceValidator {
 	return &ibmResourceInstanceResourceValidator
 }
 
-func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceName := d.Get("service").(string)
@@ -421,35 +422,35 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 	}
 
 	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
 		if !metadata.Service.RCProvisionable {
-			return fmt.Errorf("%s cannot be provisioned by resource controller", serviceName)
+			return diag.FromErr(fmt.Errorf("%s cannot be provisioned by resource controller", serviceName))
 		}
 	} else {
-		return fmt.Errorf("[ERROR] Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName)
+		return diag.FromErr(fmt.Errorf("[ERROR] Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := FilterDeployments(deployments, location)
 
@@ -458,7 +459,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service", plan, location, locationList)
+		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service", plan, location, locationList))
 	}
 
 	rsInst.Target = &deployments[0].CatalogCRN
@@ -469,7 +470,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := flex.DefaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
@@ -519,14 +520,14 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp))
 	}
 
 	d.SetId(*instance.ID)
 
-	_, err = waitForResourceInstanceCreate(d, meta)
+	_, err = waitForResourceInstanceCreate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -539,12 +540,12 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return ResourceIBMResourceInstanceRead(d, meta)
+	return ResourceIBMResourceInstanceRead(ctx, d, meta)
 }
-func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -554,7 +555,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.CRN)
@@ -577,13 +578,13 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.GetServiceName(*instance.ResourceID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
@@ -595,13 +596,13 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set(flex.ResourceControllerURL, rcontroller+"/services/")
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 	d.Set("guid", instance.GUID)
@@ -664,10 +665,10 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -685,18 +686,18 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving service offering: %s", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
 		}
 
 		resourceInstanceUpdate.ResourcePlanID = &servicePlan
@@ -715,7 +716,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("parameters") {
 		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp))
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
@@ -768,7 +769,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp))
 	}
 
 	if d.HasChange("tags") {
@@ -782,21 +783,21 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp))
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
+	_, err = waitForResourceInstanceUpdate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return ResourceIBMResourceInstanceRead(d, meta)
+	return ResourceIBMResourceInstanceRead(ctx, d, meta)
 }
 
-func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	recursive := true
@@ -810,12 +811,12 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 410 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting resource instance: %s with resp code: %s", error, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s with resp code: %s", error, resp))
 	}
 
-	_, err = waitForResourceInstanceDelete(d, meta)
+	_, err = waitForResourceInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -848,7 +849,7 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
-func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -887,10 +888,10 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -928,10 +929,10 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -961,7 +962,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context.Background())
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func FilterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -173,27 +173,60 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 
 	scriptContent := string(resp)
 
-	//if this is a RHEL host, find insert point for custom code
+	//if this is a RHEL host, insert the custom code
 	if !coreos_enabled {
-		lines := strings.Split(scriptContent, "\n")
-		var index int
-		for i, line := range lines {
-			if strings.Contains(line, `export OPERATING_SYSTEM`) {
-				index = i
-				break
-			}
+		customScript := ""
+		if script, ok := d.GetOk("custom_script"); ok {
+			customScript = script.(string)
+		}
+		scriptContent = insertSatelliteHostScript(scriptContent, satelliteHostScriptInsertion(hostProvider, customScript))
+	}
+
+	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
+	}
+
+	d.Set("location", location)
+	d.Set("host_script", scriptContent)
+	d.Set("host_provider", hostProvider)
+	d.Set("script_dir", scriptDir)
+	d.Set("script_path", scriptPath)
+	d.SetId(*locData.ID)
+
+	log.Printf("[INFO] Generated satellite location script : %s", *locData.Name)
+
+	return nil
+}
+
+// insertSatelliteHostScript inserts commands in the attach host script of RHEL
+// hosts, after the operating system is detected.
+func insertSatelliteHostScript(scriptContent, insertionText string) string {
+	lines := strings.Split(scriptContent, "\n")
+	var index int
+	for i, line := range lines {
+		if strings.Contains(line, `export OPERATING_SYSTEM`) {
+			index = i
+			break
 		}
+	}
+	lines[index] = lines[index] + "\n" + insertionText
+	return strings.Join(lines, "\n")
+}
 
-		var insertionText string
+// satelliteHostScriptInsertion returns the commands that prepare the hosts of
+// a provider, which are inserted in the attach host script of RHEL hosts.
+func satelliteHostScriptInsertion(hostProvider, customScript string) string {
+	var insertionText string
 
-		switch {
-		case strings.ToLower(hostProvider) == "aws":
-			insertionText = `
+	switch {
+	case strings.ToLower(hostProvider) == "aws":
+		insertionText = `
 yum-config-manager --enable '*'
 yum install container-selinux -y
 `
-		case strings.ToLower(hostProvider) == "ibm":
-			insertionText = `
+	case strings.ToLower(hostProvider) == "ibm":
+		insertionText = `
 subscription-manager refresh
 if [[ "${OPERATING_SYSTEM}" == "RHEL7" ]]; then
 	subscription-manager repos --enable rhel-server-rhscl-7-rpms
@@ -209,45 +242,24 @@ elif [[ "${OPERATING_SYSTEM}" == "RHEL8" ]]; then
 fi
 yum install container-selinux -y
 `
-		case strings.ToLower(hostProvider) == "azure":
-			insertionText = `
+	case strings.ToLower(hostProvider) == "azure":
+		insertionText = `
 #if [[ "${OPERATING_SYSTEM}" == "RHEL8" ]]; then
 #	update-alternatives --install /usr/bin/python3 python3 /usr/bin/python3.8 1
 #	update-alternatives --set python3 /usr/bin/python3.8
 #fi
 yum install container-selinux -y
 `
-		case strings.ToLower(hostProvider) == "google":
-			insertionText = `
+	case strings.ToLower(hostProvider) == "google":
+		insertionText = `
 #if [[ "${OPERATING_SYSTEM}" == "RHEL8" ]]; then
 #	update-alternatives --install /usr/bin/python3 python3 /usr/bin/python3.8 1
 #	update-alternatives --set python3 /usr/bin/python3.8
 #fi
 yum install container-selinux -y
 `
-		default:
-			if script, ok := d.GetOk("custom_script"); ok {
-				insertionText = script.(string)
-			}
-		}
-
-		lines[index] = lines[index] + "\n" + insertionText
-		scriptContent = strings.Join(lines, "\n")
+	default:
+		insertionText = customScript
 	}
-
-	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
-	}
-
-	d.Set("location", location)
-	d.Set("host_script", scriptContent)
-	d.Set("host_provider", hostProvider)
-	d.Set("script_dir", scriptDir)
-	d.Set("script_path", scriptPath)
-	d.SetId(*locData.ID)
-
-	log.Printf("[INFO] Generated satellite location script : %s", *locData.Name)
-
-	return nil
+	return insertionText
 }
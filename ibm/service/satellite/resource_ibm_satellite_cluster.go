@@ -17,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,10 +38,10 @@ const (
 
 func ResourceIBMSatelliteCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMSatelliteClusterCreate,
-		Read:   resourceIBMSatelliteClusterRead,
-		Update: resourceIBMSatelliteClusterUpdate,
-		Delete: resourceIBMSatelliteClusterDelete,
+		CreateContext: resourceIBMSatelliteClusterCreate,
+		ReadContext:   resourceIBMSatelliteClusterRead,
+		UpdateContext: resourceIBMSatelliteClusterUpdate,
+		DeleteContext: resourceIBMSatelliteClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				ID := d.Id()
@@ -307,17 +308,17 @@ func ResourceIBMSatelliteClusterValidator() *validate.ResourceValidator {
 	return &ibmSatelliteClusteresourceValidator
 }
 
-func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var resourceGrp, clusterId string
 	pathParamsMap := make(map[string]string)
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createClusterOptions := &kubernetesserviceapiv1.CreateSatelliteClusterOptions{}
@@ -338,9 +339,9 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 	//Wait for location to get normal
 	_, ok := d.GetOk("crn_token")
 	if !ok {
-		_, err = waitForLocationNormal(location, d, meta)
+		_, err = waitForLocationNormal(ctx, location, d, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err))
 		}
 	}
 
@@ -424,14 +425,14 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 		instance, response, err := satClient.CreateSatelliteClusterRemote(createRemoteClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response))
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster for remote location: %s", clusterId)
 	} else {
 		instance, response, err := satClient.CreateSatelliteCluster(createClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response))
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster : %s", clusterId)
@@ -459,13 +460,13 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 					response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response))
 					}
 				}
 			}
-			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterId, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+			_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, clusterId, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for default workerpool (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for default workerpool (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 	}
@@ -487,7 +488,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response))
 		}
 	}
 
@@ -512,19 +513,19 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	//Wait for cluster to get warning state
-	_, err = waitForClusterToReady(clusterId, d, meta)
+	_, err = waitForClusterToReady(ctx, clusterId, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for getting cluster (%s) to be warning state: %s", clusterId, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for getting cluster (%s) to be warning state: %s", clusterId, err))
 	}
 
-	return resourceIBMSatelliteClusterRead(d, meta)
+	return resourceIBMSatelliteClusterRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
@@ -537,7 +538,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", *cluster.Name)
@@ -599,23 +600,23 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := d.Id()
 	workerPoolName := "default"
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wrkAPI := csClient.Workers()
@@ -633,21 +634,21 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			_, err = WaitForSatelliteClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForSatelliteClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 		satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		workerOpts := satClient.NewGetWorkers1Options(clusterID)
 		workerFields, response, err := satClient.GetWorkers1(workerOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response))
 		}
 
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
@@ -657,7 +658,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response))
 		}
 		waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
 		if workerFields != nil {
@@ -670,16 +671,16 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 					err = wrkAPI.Update(clusterID, *w.ID, params, targetEnv)
 					if err != nil {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error updating worker %s: %s", *w.ID, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error updating worker %s: %s", *w.ID, err))
 					}
 				}
 			}
 
 			if waitForWorkerUpdate {
-				_, err = WaitForSatelliteWorkerVersionUpdate(d, meta, *cluster.MasterKubeVersion, targetEnv)
+				_, err = WaitForSatelliteWorkerVersionUpdate(ctx, d, meta, *cluster.MasterKubeVersion, targetEnv)
 				if err != nil {
 					d.Set("patch_version", nil)
-					return fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to update kube version: %s", clusterID, err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workers of cluster (%s) to update kube version: %s", clusterID, err))
 				}
 			}
 		}
@@ -696,7 +697,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		response, err := satClient.V2ResizeWorkerPool(resizeOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response))
 		}
 	}
 
@@ -716,7 +717,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response))
 		}
 	}
 
@@ -730,7 +731,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *cluster.Crn)
 		if err != nil {
@@ -762,12 +763,12 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 				}
 				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
-			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
+			_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 		if len(remove) > 0 {
@@ -781,19 +782,19 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 				}
 				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
 		}
 	}
 
-	return resourceIBMSatelliteClusterRead(d, meta)
+	return resourceIBMSatelliteClusterRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeClusterOptions := &kubernetesserviceapiv1.RemoveClusterOptions{}
@@ -802,13 +803,13 @@ func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{})
 
 	response, err := satClient.RemoveCluster(removeClusterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response))
 	}
 
 	//Wait for cluster to get delete
-	_, err = waitForClusterToDelete(name, d, meta)
+	_, err = waitForClusterToDelete(ctx, name, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting while deleteing cluster (%s) : %s", name, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting while deleteing cluster (%s) : %s", name, err))
 	}
 
 	log.Printf("[INFO] Deleted satellite cluster : %s", name)
@@ -817,7 +818,7 @@ func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func waitForLocationNormal(location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationNormal(ctx context.Context, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -862,10 +863,10 @@ func waitForLocationNormal(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterToReady(ctx context.Context, cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -896,10 +897,10 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterToDelete(ctx context.Context, cluster string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -926,11 +927,11 @@ func waitForClusterToDelete(cluster string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 // WaitForSatelliteWorkerVersionUpdate Waits for worker creation
-func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{}, masterVersion string, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, masterVersion string, target v1.ClusterTargetHeader) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return nil, err
@@ -965,11 +966,11 @@ func WaitForSatelliteWorkerVersionUpdate(d *schema.ResourceData, meta interface{
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 // WaitForSatelliteClusterVersionUpdate Waits for cluster creation
-func WaitForSatelliteClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -987,7 +988,7 @@ func WaitForSatelliteClusterVersionUpdate(d *schema.ResourceData, meta interface
 		ContinuousTargetOccurence: 3,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func satelliteClusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -37,10 +39,10 @@ const (
 
 func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMSatelliteClusterWorkerPoolCreate,
-		Read:   resourceIBMSatelliteClusterWorkerPoolRead,
-		Update: resourceIBMSatelliteClusterWorkerPoolUpdate,
-		Delete: resourceIBMSatelliteClusterWorkerPoolDelete,
+		CreateContext: resourceIBMSatelliteClusterWorkerPoolCreate,
+		ReadContext:   resourceIBMSatelliteClusterWorkerPoolRead,
+		UpdateContext: resourceIBMSatelliteClusterWorkerPoolUpdate,
+		DeleteContext: resourceIBMSatelliteClusterWorkerPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts, err := flex.IdParts(d.Id())
@@ -189,15 +191,15 @@ func getClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v1.Cluste
 	}
 	return targetEnv, nil
 }
-func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createWorkerPoolOptions := &kubernetesserviceapiv1.CreateSatelliteWorkerPoolOptions{}
@@ -270,31 +272,31 @@ func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta in
 
 	instance, response, err := satClient.CreateSatelliteWorkerPool(createWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cluster, *instance.WorkerPoolID))
 	log.Printf("[INFO] Created satellite cluster worker pool: %s", *instance.WorkerPoolID)
 
-	_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, cluster, *instance.WorkerPoolID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, cluster, *instance.WorkerPoolID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 	}
 
-	return resourceIBMSatelliteClusterWorkerPoolRead(d, meta)
+	return resourceIBMSatelliteClusterWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	workerPoolID := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getWorkerPoolOptions := &kubernetesserviceapiv1.GetWorkerPoolOptions{
@@ -308,7 +310,7 @@ func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", workerPool.PoolName)
@@ -323,22 +325,22 @@ func resourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterNameOrID := parts[0]
 	workerPoolName := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("worker_pool_labels") {
@@ -356,7 +358,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 		}
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response))
 		}
 	}
 
@@ -366,17 +368,17 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err))
 		}
 	}
 
@@ -406,12 +408,12 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 				}
 				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
-			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+			_, err = WaitForSatelliteWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 		if len(remove) > 0 {
@@ -425,30 +427,30 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 				}
 				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response))
 				}
 			}
 		}
 	}
-	return resourceIBMSatelliteClusterWorkerPoolRead(d, meta)
+	return resourceIBMSatelliteClusterWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteClusterWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	clusterID := parts[0]
 	workerPoolID := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	wpOptions := &kubernetesserviceapiv1.RemoveWorkerPoolOptions{
@@ -461,12 +463,12 @@ func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta in
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response))
 	}
 
-	_, err = WaitForSatelliteWorkerDelete(clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForSatelliteWorkerDelete(ctx, clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolID, clusterID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolID, clusterID, err))
 	}
 
 	d.SetId("")
@@ -484,7 +486,7 @@ func getVpcClusterTargetHeader(d *schema.ResourceData) (v2.ClusterTargetHeader,
 }
 
 // WaitForSatelliteWorkerPoolAvailable Waits for workerpool deployed
-func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerPoolAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	clusterID := clusterNameOrID
 	workerPoolID := workerPoolNameOrID
 
@@ -524,10 +526,10 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func WaitForSatelliteWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForSatelliteWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return nil, err
@@ -542,7 +544,7 @@ func WaitForSatelliteWorkerDelete(clusterNameOrID, workerPoolNameOrID string, me
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, clusterID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
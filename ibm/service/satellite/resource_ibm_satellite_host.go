@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -36,11 +38,11 @@ const (
 
 func ResourceIBMSatelliteHost() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSatelliteHostCreate,
-		Read:     resourceIBMSatelliteHostRead,
-		Update:   resourceIBMSatelliteHostUpdate,
-		Delete:   resourceIBMSatelliteHostDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSatelliteHostCreate,
+		ReadContext:   resourceIBMSatelliteHostRead,
+		UpdateContext: resourceIBMSatelliteHostUpdate,
+		DeleteContext: resourceIBMSatelliteHostDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -122,19 +124,19 @@ func ResourceIBMSatelliteHostValidator() *validate.ResourceValidator {
 	return &satelliteHostResourceValidator
 }
 
-func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	hostNameOrID := d.Get(hostID).(string)
 	location := d.Get(hostLocation).(string)
 
 	//Check host attached to location
-	hostStatus, err := waitForHostAttachment(hostNameOrID, location, d, meta)
+	hostStatus, err := waitForHostAttachment(ctx, hostNameOrID, location, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for attaching host (%s) to be succeeded: %s", hostNameOrID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for attaching host (%s) to be succeeded: %s", hostNameOrID, err))
 	}
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{}
@@ -167,42 +169,42 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	if hostStatus == rsHostReadyStatus {
 		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response))
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", location, hostNameOrID))
 
 	//Wait for host to reach normal state
-	_, err = waitForHostAttachment(hostNameOrID, location, d, meta)
+	_, err = waitForHostAttachment(ctx, hostNameOrID, location, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostNameOrID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostNameOrID, err))
 	}
 	wait, ok := d.GetOk("wait_till")
 	if ok && wait.(string) == "location_normal" {
-		_, err = waitForLocationNormal(location, d, meta)
+		_, err = waitForLocationNormal(ctx, location, d, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for getting location (%s) to be normal: %s", location, err))
 		}
 	}
 
-	return resourceIBMSatelliteHostRead(d, meta)
+	return resourceIBMSatelliteHostRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(parts) < 2 {
-		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/hostName", d.Id())
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/hostName", d.Id()))
 	}
 	location := parts[0]
 	hostNameOrID := parts[1]
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
@@ -215,7 +217,7 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 		log.Println("resourceIBMSatelliteHostRead : error in getting hostlist :", err, resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, h := range hostList {
@@ -248,17 +250,17 @@ func resourceIBMSatelliteHostRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	locationName := parts[0]
 	hostID := parts[1]
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateHostOptions := &kubernetesserviceapiv1.UpdateSatelliteHostOptions{}
@@ -274,24 +276,24 @@ func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 		response, err := satClient.UpdateSatelliteHost(updateHostOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMSatelliteHostRead(d, meta)
+	return resourceIBMSatelliteHostRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := parts[0]
 	hostNameorID := parts[1]
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{}
@@ -303,14 +305,14 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForHostAttachment(hostNameOrID, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHostAttachment(ctx context.Context, hostNameOrID, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -345,5 +347,5 @@ func waitForHostAttachment(hostNameOrID, location string, d *schema.ResourceData
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
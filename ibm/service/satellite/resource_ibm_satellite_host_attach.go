@@ -0,0 +1,749 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hostAttachVpcInstances        = "vpc_instances"
+	hostAttachPowerInstances      = "power_instances"
+	hostAttachVpcInstanceTemplate = "vpc_instance_template"
+	hostAttachTemplateID          = "id"
+	hostAttachTemplateCount       = "count"
+	hostAttachTemplateNamePrefix  = "name_prefix"
+	hostAttachReboot              = "reboot_if_not_attached"
+	hostAttachTimeout             = "attach_timeout"
+	hostAttachAssign              = "assign"
+	hostAttachHosts               = "hosts"
+	hostAttachCreated             = "created"
+
+	hostAttachProviderVpc   = "vpc"
+	hostAttachProviderPower = "power"
+
+	// hostAttachIDLabel labels the hosts of the instances created from
+	// vpc_instance_template with a unique ID, which the attach host script
+	// injected in each instance registers the host with.
+	hostAttachIDLabel = "host-attach-id"
+
+	rsHostUnassignedState = "unassigned"
+)
+
+// satelliteHostAttachInstance is a VPC or Power Virtual Server instance that
+// is expected to attach itself to the location with the attach host script.
+type satelliteHostAttachInstance struct {
+	provider   string
+	instanceID string
+	name       string
+	// attachID is the value of the hostAttachIDLabel label of the host, when
+	// the instance is created with the attach host script.
+	attachID string
+	created  bool
+	rebooted bool
+}
+
+// matches returns whether a host of the location is the host of the instance.
+// The hosts of the instances that are created with the attach host script are
+// matched by their label, and the hosts of the other instances by name.
+func (inst satelliteHostAttachInstance) matches(host kubernetesserviceapiv1.MultishiftQueueNode) bool {
+	if inst.attachID != "" {
+		return host.Labels[hostAttachIDLabel] == inst.attachID
+	}
+	return flex.StringValue(host.Name) == inst.name
+}
+
+func ResourceIBMSatelliteHostAttach() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSatelliteHostAttachCreate,
+		ReadContext:   resourceIBMSatelliteHostAttachRead,
+		UpdateContext: resourceIBMSatelliteHostAttachUpdate,
+		DeleteContext: resourceIBMSatelliteHostAttachDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			hostLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			hostAttachVpcInstances: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				AtLeastOneOf: []string{hostAttachVpcInstances, hostAttachPowerInstances, hostAttachVpcInstanceTemplate},
+				Description:  "IDs of the VPC instances to attach to the location",
+			},
+			hostAttachPowerInstances: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				AtLeastOneOf: []string{hostAttachVpcInstances, hostAttachPowerInstances, hostAttachVpcInstanceTemplate},
+				Description:  "IDs of the Power Virtual Server instances to attach to the location, in the form <cloud_instance_id>/<instance_id>",
+			},
+			hostAttachVpcInstanceTemplate: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{hostAttachVpcInstances, hostAttachPowerInstances, hostAttachVpcInstanceTemplate},
+				Description:  "Create VPC instances from an instance template, with the attach host script of the location as their user data",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						hostAttachTemplateID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "ID of the VPC instance template",
+						},
+						hostAttachTemplateCount: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of instances to create",
+						},
+						hostAttachTemplateNamePrefix: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Prefix of the names of the instances, which are followed by the index of the instance",
+						},
+					},
+				},
+			},
+			hostAttachReboot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reboot the instances that did not attach within attach_timeout once, and wait for them again",
+			},
+			hostAttachTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Minutes to wait for the instances to attach before rebooting them",
+			},
+			hostAttachAssign: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Assign the attached hosts to the location control plane or a cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						hostCluster: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The name or ID of a Satellite location or cluster to assign the hosts to. Defaults to the location",
+						},
+						hostZone: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The zone within the cluster to assign the hosts to",
+						},
+						hostWorkerPool: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The name or ID of the worker pool within the cluster to assign the hosts to",
+						},
+						hostLabels: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "List of labels for the hosts",
+						},
+					},
+				},
+			},
+			hostAttachHosts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts attached to the location",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the instance",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the host",
+						},
+						hostID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the host",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The availability state of the host",
+						},
+						hostState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the host",
+						},
+						"rebooted": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance was rebooted because it did not attach within attach_timeout",
+						},
+						hostAttachCreated: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance was created from vpc_instance_template, and is deleted with the resource",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteHostAttachCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get(hostLocation).(string)
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instances, err := getSatelliteHostAttachInstances(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	created, err := createSatelliteHostAttachInstances(context, d, meta, satClient, location)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instances = append(instances, created...)
+
+	start := time.Now()
+	attachTimeout := time.Duration(d.Get(hostAttachTimeout).(int)) * time.Minute
+	if attachTimeout > d.Timeout(schema.TimeoutCreate) || !d.Get(hostAttachReboot).(bool) {
+		attachTimeout = d.Timeout(schema.TimeoutCreate)
+	}
+	hosts, err := waitForSatelliteHostsAttached(context, satClient, location, instances, attachTimeout)
+	if _, ok := err.(*resource.TimeoutError); ok && d.Get(hostAttachReboot).(bool) {
+		// The attach host script, passed to the instances as user data,
+		// installs a service that registers the host when it boots. A reboot
+		// retries the attach of the instances that failed to reach the
+		// location, for example because their network was not ready yet.
+		for i, inst := range instances {
+			if _, ok := hosts[inst.instanceID]; ok {
+				continue
+			}
+			log.Printf("[INFO] Instance %s (%s) did not attach to location %s within %s, rebooting it", inst.name, inst.instanceID, location, attachTimeout)
+			if err := rebootSatelliteHostAttachInstance(context, meta, inst); err != nil {
+				cleanupSatelliteHostAttachInstances(context, meta, satClient, location, created, hosts, d.Timeout(schema.TimeoutCreate))
+				return diag.FromErr(err)
+			}
+			instances[i].rebooted = true
+		}
+		hosts, err = waitForSatelliteHostsAttached(context, satClient, location, instances, d.Timeout(schema.TimeoutCreate)-time.Since(start))
+	}
+	if err != nil {
+		cleanupSatelliteHostAttachInstances(context, meta, satClient, location, created, hosts, d.Timeout(schema.TimeoutCreate))
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the instances to attach to location (%s): %s", location, err))
+	}
+
+	hostIDs := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		hostIDs = append(hostIDs, flex.StringValue(hosts[inst.instanceID].ID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", location, strings.Join(hostIDs, ",")))
+	d.Set(hostAttachHosts, flattenSatelliteHostAttachHosts(instances, hosts))
+
+	if assign, ok := d.GetOk(hostAttachAssign); ok && len(assign.([]interface{})) > 0 {
+		assignment := map[string]interface{}{}
+		if assign.([]interface{})[0] != nil {
+			assignment = assign.([]interface{})[0].(map[string]interface{})
+		}
+		for _, inst := range instances {
+			host := hosts[inst.instanceID]
+			if flex.StringValue(host.State) != rsHostUnassignedState {
+				log.Printf("[INFO] Host %s is %s in location %s, skipping the assignment", inst.name, flex.StringValue(host.State), location)
+				continue
+			}
+			hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{}
+			hostAssignOptions.Controller = flex.PtrToString(location)
+			hostAssignOptions.Cluster = flex.PtrToString(location)
+			if v, ok := assignment[hostCluster]; ok && v.(string) != "" {
+				hostAssignOptions.Cluster = flex.PtrToString(v.(string))
+			}
+			hostAssignOptions.HostID = host.ID
+			hostAssignOptions.Labels = make(map[string]string)
+			if v, ok := assignment[hostLabels]; ok && v != nil {
+				hostAssignOptions.Labels = flex.FlattenKeyValues(v.(*schema.Set).List())
+			}
+			if v, ok := assignment[hostWorkerPool]; ok && v.(string) != "" {
+				hostAssignOptions.Workerpool = flex.PtrToString(v.(string))
+			}
+			if v, ok := assignment[hostZone]; ok && v.(string) != "" {
+				hostAssignOptions.Zone = flex.PtrToString(v.(string))
+			}
+			_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error Assigning Satellite Host (%s): %s\n%s", inst.name, err, response))
+			}
+		}
+		for _, inst := range instances {
+			if _, err = waitForHostAttachment(context, flex.StringValue(hosts[inst.instanceID].ID), location, d, meta); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", inst.name, err))
+			}
+		}
+	}
+
+	return resourceIBMSatelliteHostAttachRead(context, d, meta)
+}
+
+func resourceIBMSatelliteHostAttachRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/hostIDs", d.Id()))
+	}
+	location := parts[0]
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving satellite hosts of location (%s): %s\n%s", location, err, resp))
+	}
+	hostsByID := make(map[string]kubernetesserviceapiv1.MultishiftQueueNode, len(hostList))
+	for _, h := range hostList {
+		hostsByID[flex.StringValue(h.ID)] = h
+	}
+
+	hosts := make([]map[string]interface{}, 0)
+	for _, v := range d.Get(hostAttachHosts).([]interface{}) {
+		host := v.(map[string]interface{})
+		h, ok := hostsByID[host[hostID].(string)]
+		if !ok {
+			log.Printf("[WARN] Host %s was removed from location %s", host["host_name"], location)
+			continue
+		}
+		host["state"] = flex.StringValue(h.State)
+		if h.Health != nil {
+			host[hostState] = flex.StringValue(h.Health.Status)
+		}
+		hosts = append(hosts, host)
+	}
+	if len(hosts) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(hostLocation, location)
+	d.Set(hostAttachHosts, hosts)
+	return nil
+}
+
+func resourceIBMSatelliteHostAttachUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(hostAttachAssign + ".0." + hostLabels) {
+		location := d.Get(hostLocation).(string)
+		satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		labels := make(map[string]string)
+		if v, ok := d.GetOk(hostAttachAssign + ".0." + hostLabels); ok {
+			labels = flex.FlattenKeyValues(v.(*schema.Set).List())
+		}
+		for _, v := range d.Get(hostAttachHosts).([]interface{}) {
+			host := v.(map[string]interface{})
+			id := host[hostID].(string)
+			updateHostOptions := &kubernetesserviceapiv1.UpdateSatelliteHostOptions{}
+			updateHostOptions.Controller = &location
+			updateHostOptions.HostID = &id
+			updateHostOptions.Labels = labels
+			response, err := satClient.UpdateSatelliteHost(updateHostOptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Satellite Host (%s): %s\n%s", host["host_name"], err, response))
+			}
+		}
+	}
+
+	return resourceIBMSatelliteHostAttachRead(context, d, meta)
+}
+
+func resourceIBMSatelliteHostAttachDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get(hostLocation).(string)
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, v := range d.Get(hostAttachHosts).([]interface{}) {
+		host := v.(map[string]interface{})
+		id := host[hostID].(string)
+		removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{}
+		removeSatHostOptions.Controller = &location
+		removeSatHostOptions.HostID = &id
+
+		response, err := satClient.RemoveSatelliteHost(removeSatHostOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Host (%s): %s\n%s", host["host_name"], err, response))
+		}
+	}
+
+	created := make([]satelliteHostAttachInstance, 0)
+	for _, v := range d.Get(hostAttachHosts).([]interface{}) {
+		host := v.(map[string]interface{})
+		if host[hostAttachCreated].(bool) {
+			created = append(created, satelliteHostAttachInstance{
+				provider:   hostAttachProviderVpc,
+				instanceID: host["instance_id"].(string),
+				name:       host["host_name"].(string),
+				created:    true,
+			})
+		}
+	}
+	if err := deleteSatelliteHostAttachInstances(context, meta, created, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getSatelliteHostAttachInstances looks up the names of the instances, which
+// are the names that their hosts register with in the location.
+func getSatelliteHostAttachInstances(context context.Context, d *schema.ResourceData, meta interface{}) ([]satelliteHostAttachInstance, error) {
+	instances := make([]satelliteHostAttachInstance, 0)
+
+	if v, ok := d.GetOk(hostAttachVpcInstances); ok {
+		vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range flex.ExpandStringList(v.(*schema.Set).List()) {
+			ins, response, err := vpcClient.GetInstanceWithContext(context, vpcClient.NewGetInstanceOptions(id))
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error getting VPC instance (%s): %s\n%s", id, err, response)
+			}
+			instances = append(instances, satelliteHostAttachInstance{
+				provider:   hostAttachProviderVpc,
+				instanceID: id,
+				name:       flex.StringValue(ins.Name),
+			})
+		}
+	}
+
+	if v, ok := d.GetOk(hostAttachPowerInstances); ok {
+		sess, err := meta.(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range flex.ExpandStringList(v.(*schema.Set).List()) {
+			parts, err := flex.IdParts(id)
+			if err != nil {
+				return nil, err
+			}
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("[ERROR] Incorrect Power Virtual Server instance ID %s: ID should be a combination of cloud_instance_id/instance_id", id)
+			}
+			client := instance.NewIBMPIInstanceClient(context, sess, parts[0])
+			pvm, err := client.Get(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error getting Power Virtual Server instance (%s): %s", id, err)
+			}
+			instances = append(instances, satelliteHostAttachInstance{
+				provider:   hostAttachProviderPower,
+				instanceID: id,
+				name:       flex.StringValue(pvm.ServerName),
+			})
+		}
+	}
+
+	return instances, nil
+}
+
+// createSatelliteHostAttachInstances creates the instances of
+// vpc_instance_template. Each instance gets its own attach host script as user
+// data, which labels its host with a unique ID.
+func createSatelliteHostAttachInstances(context context.Context, d *schema.ResourceData, meta interface{}, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string) ([]satelliteHostAttachInstance, error) {
+	instances := make([]satelliteHostAttachInstance, 0)
+	v, ok := d.GetOk(hostAttachVpcInstanceTemplate)
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return instances, nil
+	}
+	template := v.([]interface{})[0].(map[string]interface{})
+	templateID := template[hostAttachTemplateID].(string)
+
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < template[hostAttachTemplateCount].(int); i++ {
+		name := fmt.Sprintf("%s-%d", template[hostAttachTemplateNamePrefix].(string), i)
+		inst, err := createSatelliteHostAttachInstance(context, vpcClient, satClient, location, templateID, name)
+		if err != nil {
+			cleanupSatelliteHostAttachInstances(context, meta, satClient, location, instances, nil, d.Timeout(schema.TimeoutCreate))
+			return nil, err
+		}
+		instances = append(instances, inst)
+	}
+	return instances, nil
+}
+
+func createSatelliteHostAttachInstance(context context.Context, vpcClient *vpcv1.VpcV1, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location, templateID, name string) (satelliteHostAttachInstance, error) {
+	attachID := resource.PrefixedUniqueId("")
+	script, err := generateSatelliteHostAttachScript(context, satClient, location, attachID)
+	if err != nil {
+		return satelliteHostAttachInstance{}, err
+	}
+	options := &vpcv1.CreateInstanceOptions{
+		InstancePrototype: &vpcv1.InstancePrototypeInstanceBySourceTemplate{
+			SourceTemplate: &vpcv1.InstanceTemplateIdentity{
+				ID: &templateID,
+			},
+			Name:     &name,
+			UserData: &script,
+		},
+	}
+	ins, response, err := vpcClient.CreateInstanceWithContext(context, options)
+	if err != nil {
+		return satelliteHostAttachInstance{}, fmt.Errorf("[ERROR] Error creating VPC instance %s from instance template (%s): %s\n%s", name, templateID, err, response)
+	}
+	log.Printf("[INFO] Created VPC instance %s (%s) with the attach host script of location %s", name, *ins.ID, location)
+	return satelliteHostAttachInstance{
+		provider:   hostAttachProviderVpc,
+		instanceID: *ins.ID,
+		name:       name,
+		attachID:   attachID,
+		created:    true,
+	}, nil
+}
+
+// generateSatelliteHostAttachScript returns the attach host script of the
+// location for a RHEL host on IBM Cloud, which labels the host with attachID.
+func generateSatelliteHostAttachScript(context context.Context, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location, attachID string) (string, error) {
+	options := &kubernetesserviceapiv1.AttachSatelliteHostOptions{
+		Controller:      &location,
+		Labels:          map[string]string{hostAttachIDLabel: attachID},
+		OperatingSystem: core.StringPtr("RHEL"),
+	}
+	script, err := satClient.AttachSatelliteHostWithContext(context, options)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error Generating Satellite Registration Script of location (%s): %s", location, err)
+	}
+	return insertSatelliteHostScript(string(script), satelliteHostScriptInsertion("ibm", "")), nil
+}
+
+// cleanupSatelliteHostAttachInstances removes the hosts of the instances
+// created from vpc_instance_template from the location and deletes the
+// instances when the create of the resource fails, since they are not saved in
+// the state.
+func cleanupSatelliteHostAttachInstances(context context.Context, meta interface{}, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string, instances []satelliteHostAttachInstance, hosts map[string]kubernetesserviceapiv1.MultishiftQueueNode, timeout time.Duration) {
+	for _, inst := range instances {
+		host, ok := hosts[inst.instanceID]
+		if !ok {
+			continue
+		}
+		removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{
+			Controller: &location,
+			HostID:     host.ID,
+		}
+		if response, err := satClient.RemoveSatelliteHostWithContext(context, removeSatHostOptions); err != nil {
+			log.Printf("[WARN] Error removing host %s from location %s: %s\n%s", flex.StringValue(host.Name), location, err, response)
+		}
+	}
+	if err := deleteSatelliteHostAttachInstances(context, meta, instances, timeout); err != nil {
+		log.Printf("[WARN] Error deleting the instances created from vpc_instance_template: %s", err)
+	}
+}
+
+// deleteSatelliteHostAttachInstances deletes the VPC instances that were
+// created from vpc_instance_template, and waits for them to be deleted. The
+// instances that are already deleted are skipped.
+func deleteSatelliteHostAttachInstances(context context.Context, meta interface{}, instances []satelliteHostAttachInstance, timeout time.Duration) error {
+	if len(instances) == 0 {
+		return nil
+	}
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, inst := range instances {
+		response, err := vpcClient.DeleteInstanceWithContext(context, vpcClient.NewDeleteInstanceOptions(inst.instanceID))
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error deleting VPC instance %s (%s): %s\n%s", inst.name, inst.instanceID, err, response)
+		}
+	}
+	for _, inst := range instances {
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"deleting"},
+			Target:  []string{"deleted"},
+			Refresh: func() (interface{}, string, error) {
+				ins, response, err := vpcClient.GetInstanceWithContext(context, vpcClient.NewGetInstanceOptions(inst.instanceID))
+				if err != nil {
+					if response != nil && response.StatusCode == 404 {
+						return inst.instanceID, "deleted", nil
+					}
+					return nil, "", fmt.Errorf("[ERROR] Error getting VPC instance (%s): %s\n%s", inst.instanceID, err, response)
+				}
+				return ins, "deleting", nil
+			},
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := conns.WaitForStateContext(context, stateConf); err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for VPC instance %s (%s) to be deleted: %s", inst.name, inst.instanceID, err)
+		}
+	}
+	return nil
+}
+
+func rebootSatelliteHostAttachInstance(context context.Context, meta interface{}, inst satelliteHostAttachInstance) error {
+	if inst.provider == hostAttachProviderVpc {
+		vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+		options := vpcClient.NewCreateInstanceActionOptions(inst.instanceID, vpcv1.CreateInstanceActionOptionsTypeRebootConst)
+		_, response, err := vpcClient.CreateInstanceActionWithContext(context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error rebooting VPC instance (%s): %s\n%s", inst.instanceID, err, response)
+		}
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(inst.instanceID)
+	if err != nil {
+		return err
+	}
+	client := instance.NewIBMPIInstanceClient(context, sess, parts[0])
+	body := &models.PVMInstanceAction{
+		Action: flex.PtrToString("soft-reboot"),
+	}
+	if err = client.Action(parts[1], body); err != nil {
+		return fmt.Errorf("[ERROR] Error rebooting Power Virtual Server instance (%s): %s", inst.instanceID, err)
+	}
+	return nil
+}
+
+// waitForSatelliteHostsAttached waits until a host is ready or normal in the
+// location for each of the instances. The hosts found so far are returned by
+// instance ID with the error when the wait times out.
+func waitForSatelliteHostsAttached(context context.Context, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string, instances []satelliteHostAttachInstance, timeout time.Duration) (map[string]kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	hosts := make(map[string]kubernetesserviceapiv1.MultishiftQueueNode, len(instances))
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostProvisioningStatus},
+		Target:  []string{rsHostReadyStatus},
+		Refresh: func() (interface{}, string, error) {
+			hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			}
+			hostList, response, err := satClient.GetSatelliteHostsWithContext(context, hostOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving satellite hosts of location (%s): %s\n%s", location, err, response)
+			}
+			pending := make([]string, 0)
+			for _, inst := range instances {
+				for _, h := range hostList {
+					if h.Health == nil || !inst.matches(h) {
+						continue
+					}
+					status := flex.StringValue(h.Health.Status)
+					if status == rsHostNormalStatus || status == rsHostReadyStatus {
+						hosts[inst.instanceID] = h
+					}
+				}
+				if _, ok := hosts[inst.instanceID]; !ok {
+					pending = append(pending, inst.name)
+				}
+			}
+			if len(pending) > 0 {
+				log.Printf("[INFO] Waiting for hosts %s to attach to location %s", strings.Join(pending, ", "), location)
+				return hosts, rsHostProvisioningStatus, nil
+			}
+			return hosts, rsHostReadyStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	_, err := conns.WaitForStateContext(context, stateConf)
+	return hosts, err
+}
+
+func flattenSatelliteHostAttachHosts(instances []satelliteHostAttachInstance, hosts map[string]kubernetesserviceapiv1.MultishiftQueueNode) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(instances))
+	for _, inst := range instances {
+		h := hosts[inst.instanceID]
+		host := map[string]interface{}{
+			"instance_id":     inst.instanceID,
+			"host_name":       flex.StringValue(h.Name),
+			hostID:            flex.StringValue(h.ID),
+			"state":           flex.StringValue(h.State),
+			"rebooted":        inst.rebooted,
+			hostAttachCreated: inst.created,
+		}
+		if h.Health != nil {
+			host[hostState] = flex.StringValue(h.Health.Status)
+		}
+		result = append(result, host)
+	}
+	return result
+}
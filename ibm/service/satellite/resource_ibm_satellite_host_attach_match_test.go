@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestSatelliteHostAttachInstanceMatches(t *testing.T) {
+	host := kubernetesserviceapiv1.MultishiftQueueNode{
+		ID:     core.StringPtr("host-id"),
+		Name:   core.StringPtr("satellite-host-0"),
+		Labels: map[string]string{hostAttachIDLabel: "20240101000000000000000001", "cpu": "8"},
+	}
+	cases := []struct {
+		name     string
+		instance satelliteHostAttachInstance
+		expected bool
+	}{
+		{
+			name:     "attach ID",
+			instance: satelliteHostAttachInstance{name: "satellite-host-0", attachID: "20240101000000000000000001"},
+			expected: true,
+		},
+		{
+			name:     "attach ID of another instance with the same name",
+			instance: satelliteHostAttachInstance{name: "satellite-host-0", attachID: "20240101000000000000000002"},
+		},
+		{
+			name:     "renamed host with its attach ID",
+			instance: satelliteHostAttachInstance{name: "satellite-host-1", attachID: "20240101000000000000000001"},
+			expected: true,
+		},
+		{
+			name:     "name",
+			instance: satelliteHostAttachInstance{name: "satellite-host-0"},
+			expected: true,
+		},
+		{
+			name:     "another name",
+			instance: satelliteHostAttachInstance{name: "satellite-host-1"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if matched := c.instance.matches(host); matched != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, matched)
+			}
+		})
+	}
+}
+
+func TestInsertSatelliteHostScript(t *testing.T) {
+	script := "#!/usr/bin/env bash\nexport OPERATING_SYSTEM=RHEL8\nregister_host\n"
+	inserted := insertSatelliteHostScript(script, satelliteHostScriptInsertion("ibm", ""))
+	if !strings.Contains(inserted, "export OPERATING_SYSTEM=RHEL8\n\nsubscription-manager refresh") {
+		t.Errorf("expected the commands after the operating system is detected, got %q", inserted)
+	}
+	if !strings.HasSuffix(inserted, "yum install container-selinux -y\n\nregister_host\n") {
+		t.Errorf("expected the rest of the script after the commands, got %q", inserted)
+	}
+	if custom := satelliteHostScriptInsertion("other", "echo custom"); custom != "echo custom" {
+		t.Errorf("expected the custom script for other providers, got %q", custom)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSatelliteHostAttach_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	resource_prefix := "tf-satellite"
+	rhel_image_name := "ibm-redhat-8-8-minimal-amd64-3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSatelliteHostAttachCreate(name, resource_prefix, rhel_image_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_host_attach.hosts", "hosts.#", "3"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_host_attach.hosts", "hosts.0.host_id"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_attach.hosts", "hosts.0.state", "assigned"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSatelliteHostAttach_InstanceTemplate(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	resource_prefix := "tf-satellite"
+	rhel_image_name := "ibm-redhat-8-8-minimal-amd64-3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSatelliteHostAttachInstanceTemplate(name, resource_prefix, rhel_image_name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_host_attach.hosts", "hosts.#", "3"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_host_attach.hosts", "hosts.0.host_id"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_host_attach.hosts", "hosts.0.instance_id"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_attach.hosts", "hosts.0.created", "true"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_attach.hosts", "hosts.0.state", "assigned"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteHostAttachCreate(name, resource_prefix, rhel_image_name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_location" "location" {
+		location     = "%[1]s"
+		managed_from = "dal"
+		zones        = ["location-zone-1", "location-zone-2", "location-zone-3"]
+	}
+
+	data "ibm_satellite_attach_host_script" "script" {
+		location      = ibm_satellite_location.location.id
+		host_provider = "ibm"
+	}
+
+	data "ibm_resource_group" "resource_group" {
+		is_default = true
+	}
+
+	resource "ibm_is_vpc" "satellite_vpc" {
+		name           = "%[2]s-vpc-1"
+		resource_group = data.ibm_resource_group.resource_group.id
+	}
+
+	resource "ibm_is_subnet" "satellite_subnet" {
+		count = 3
+
+		name                     = "%[2]s-subnet-${count.index}"
+		vpc                      = ibm_is_vpc.satellite_vpc.id
+		total_ipv4_address_count = 256
+		zone                     = "us-south-${count.index + 1}"
+	}
+
+	resource "ibm_is_public_gateway" "satellite_gateway" {
+		count = 3
+
+		name = "%[2]s-gateway-${count.index}"
+		vpc  = ibm_is_vpc.satellite_vpc.id
+		zone = "us-south-${count.index + 1}"
+	}
+
+	resource "ibm_is_subnet_public_gateway_attachment" "satellite_gateway" {
+		count = 3
+
+		subnet         = ibm_is_subnet.satellite_subnet[count.index].id
+		public_gateway = ibm_is_public_gateway.satellite_gateway[count.index].id
+	}
+
+	data "ibm_is_image" "rhel8" {
+		name = "%[3]s"
+	}
+
+	resource "ibm_is_instance" "satellite_instance" {
+		count      = 3
+		depends_on = [ibm_is_subnet_public_gateway_attachment.satellite_gateway]
+
+		name           = "%[2]s-instance-${count.index}"
+		vpc            = ibm_is_vpc.satellite_vpc.id
+		zone           = "us-south-${count.index + 1}"
+		image          = data.ibm_is_image.rhel8.id
+		profile        = "mx2-8x64"
+		keys           = []
+		resource_group = data.ibm_resource_group.resource_group.id
+		user_data      = data.ibm_satellite_attach_host_script.script.host_script
+
+		primary_network_interface {
+			subnet = ibm_is_subnet.satellite_subnet[count.index].id
+		}
+	}
+
+	resource "ibm_satellite_host_attach" "hosts" {
+		location               = ibm_satellite_location.location.id
+		vpc_instances          = ibm_is_instance.satellite_instance[*].id
+		reboot_if_not_attached = true
+
+		assign {
+			labels = ["env:test"]
+		}
+	}
+`, name, resource_prefix, rhel_image_name)
+}
+
+func testAccCheckSatelliteHostAttachInstanceTemplate(name, resource_prefix, rhel_image_name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_location" "location" {
+		location     = "%[1]s"
+		managed_from = "dal"
+		zones        = ["location-zone-1", "location-zone-2", "location-zone-3"]
+	}
+
+	data "ibm_resource_group" "resource_group" {
+		is_default = true
+	}
+
+	resource "ibm_is_vpc" "satellite_vpc" {
+		name           = "%[2]s-vpc-2"
+		resource_group = data.ibm_resource_group.resource_group.id
+	}
+
+	resource "ibm_is_subnet" "satellite_subnet" {
+		name                     = "%[2]s-subnet-template"
+		vpc                      = ibm_is_vpc.satellite_vpc.id
+		total_ipv4_address_count = 256
+		zone                     = "us-south-1"
+	}
+
+	resource "ibm_is_public_gateway" "satellite_gateway" {
+		name = "%[2]s-gateway-template"
+		vpc  = ibm_is_vpc.satellite_vpc.id
+		zone = "us-south-1"
+	}
+
+	resource "ibm_is_subnet_public_gateway_attachment" "satellite_gateway" {
+		subnet         = ibm_is_subnet.satellite_subnet.id
+		public_gateway = ibm_is_public_gateway.satellite_gateway.id
+	}
+
+	data "ibm_is_image" "rhel8" {
+		name = "%[3]s"
+	}
+
+	resource "ibm_is_instance_template" "satellite_host" {
+		name           = "%[2]s-template"
+		vpc            = ibm_is_vpc.satellite_vpc.id
+		zone           = "us-south-1"
+		image          = data.ibm_is_image.rhel8.id
+		profile        = "mx2-8x64"
+		keys           = []
+		resource_group = data.ibm_resource_group.resource_group.id
+
+		primary_network_interface {
+			subnet = ibm_is_subnet.satellite_subnet.id
+		}
+	}
+
+	resource "ibm_satellite_host_attach" "hosts" {
+		depends_on = [ibm_is_subnet_public_gateway_attachment.satellite_gateway]
+
+		location               = ibm_satellite_location.location.id
+		reboot_if_not_attached = true
+
+		vpc_instance_template {
+			id          = ibm_is_instance_template.satellite_host.id
+			count       = 3
+			name_prefix = "%[2]s-host"
+		}
+
+		assign {
+			labels = ["env:test"]
+		}
+	}
+`, name, resource_prefix, rhel_image_name)
+}
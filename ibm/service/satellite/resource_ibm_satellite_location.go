@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,10 +35,10 @@ const (
 
 func ResourceIBMSatelliteLocation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMSatelliteLocationCreate,
-		Read:   resourceIBMSatelliteLocationRead,
-		Update: resourceIBMSatelliteLocationUpdate,
-		Delete: resourceIBMSatelliteLocationDelete,
+		CreateContext: resourceIBMSatelliteLocationCreate,
+		ReadContext:   resourceIBMSatelliteLocationRead,
+		UpdateContext: resourceIBMSatelliteLocationUpdate,
+		DeleteContext: resourceIBMSatelliteLocationDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				ID := d.Id()
@@ -276,10 +277,10 @@ func ResourceIBMSatelliteLocationValidator() *validate.ResourceValidator {
 	return &ibmSatelliteLocationValidator
 }
 
-func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createSatLocOptions := &kubernetesserviceapiv1.CreateSatelliteLocationOptions{}
@@ -344,7 +345,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 
 	instance, response, err := satClient.CreateSatelliteLocation(createSatLocOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response))
 	}
 
 	d.SetId(*instance.ID)
@@ -361,19 +362,19 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	//Wait for location to be in ready state
-	_, err = waitForLocationToReady(*instance.ID, d, meta)
+	_, err = waitForLocationToReady(ctx, *instance.ID, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for location (%s) to reach action required state: %s", *instance.ID, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for location (%s) to reach action required state: %s", *instance.ID, err))
 	}
 
-	return resourceIBMSatelliteLocationRead(d, meta)
+	return resourceIBMSatelliteLocationRead(ctx, d, meta)
 }
 
-func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
@@ -386,7 +387,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(satLocation, *instance.Name)
@@ -443,11 +444,11 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -459,7 +460,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 
 		instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response))
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -470,10 +471,10 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSatelliteLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	removeSatLocOptions := &kubernetesserviceapiv1.RemoveSatelliteLocationOptions{}
@@ -482,20 +483,20 @@ func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}
 
 	response, err := satClient.RemoveSatelliteLocation(removeSatLocOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response))
 	}
 
 	//Wait for location to delete
-	_, err = waitForLocationDelete(name, d, meta)
+	_, err = waitForLocationDelete(ctx, name, d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for deleting location instance: %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for deleting location instance: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForLocationDelete(location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationDelete(ctx context.Context, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -530,10 +531,10 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLocationToReady(ctx context.Context, loc string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
 		return false, err
@@ -579,5 +580,5 @@ func waitForLocationToReady(loc string, d *schema.ResourceData, meta interface{}
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
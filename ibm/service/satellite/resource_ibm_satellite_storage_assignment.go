@@ -1,23 +1,25 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMSatelliteStorageAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerStorageAssignmentCreate,
-		Read:     resourceIBMContainerStorageAssignmentRead,
-		Update:   resourceIBMContainerStorageAssignmentUpdate,
-		Delete:   resourceIBMContainerStorageAssignmentDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerStorageAssignmentCreate,
+		ReadContext:   resourceIBMContainerStorageAssignmentRead,
+		UpdateContext: resourceIBMContainerStorageAssignmentUpdate,
+		DeleteContext: resourceIBMContainerStorageAssignmentDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -128,11 +130,11 @@ func ResourceIBMSatelliteStorageAssignment() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerStorageAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var result *kubernetesserviceapiv1.CreateSubscriptionData
@@ -164,12 +166,12 @@ func resourceIBMContainerStorageAssignmentCreate(d *schema.ResourceData, meta in
 		assignmentOptions.Groups = flex.ExpandStringList(groups)
 		result, _, err = satClient.CreateAssignment(assignmentOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Assignment - %v", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Assignment - %v", err))
 		}
 	} else {
 		result, _, err = satClient.CreateAssignmentByCluster(assignmentOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Assignment by Cluster - %v", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Assignment by Cluster - %v", err))
 		}
 	}
 
@@ -178,20 +180,20 @@ func resourceIBMContainerStorageAssignmentCreate(d *schema.ResourceData, meta in
 	getAssignmentOptions := &kubernetesserviceapiv1.GetAssignmentOptions{
 		UUID: result.AddSubscription.UUID,
 	}
-	_, err = waitForAssignmentCreationStatus(getAssignmentOptions, meta, d)
+	_, err = waitForAssignmentCreationStatus(ctx, getAssignmentOptions, meta, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*result.AddSubscription.UUID)
 
-	return resourceIBMContainerStorageAssignmentRead(d, meta)
+	return resourceIBMContainerStorageAssignmentRead(ctx, d, meta)
 }
 
-func resourceIBMContainerStorageAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	uuid := d.Get("uuid").(string)
@@ -204,7 +206,7 @@ func resourceIBMContainerStorageAssignmentRead(d *schema.ResourceData, meta inte
 
 	result, _, err := satClient.GetAssignment(getAssignmentOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting Assignment of UUID %s - %v", uuid, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Assignment of UUID %s - %v", uuid, err))
 	}
 	d.Set("assignment_name", *result.Name)
 	d.Set("uuid", *result.UUID)
@@ -250,7 +252,7 @@ func resourceIBMContainerStorageAssignmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMContainerStorageAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	uuid := d.Get("uuid").(string)
 	updateAssignmentOptions := &kubernetesserviceapiv1.UpdateAssignmentOptions{}
 	updateAssignmentOptions.UUID = &uuid
@@ -265,31 +267,31 @@ func resourceIBMContainerStorageAssignmentUpdate(d *schema.ResourceData, meta in
 		updateConfigRevision := d.Get("update_config_revision").(bool)
 		updateAssignmentOptions.UpdateConfigVersion = &updateConfigRevision
 
-		_, err := waitForAssignmentUpdateStatus(updateAssignmentOptions, meta, d)
+		_, err := waitForAssignmentUpdateStatus(ctx, updateAssignmentOptions, meta, d)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Assignment with UUID %s - %v", uuid, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Assignment with UUID %s - %v", uuid, err))
 		}
 	}
-	return resourceIBMContainerStorageAssignmentRead(d, meta)
+	return resourceIBMContainerStorageAssignmentRead(ctx, d, meta)
 }
 
-func resourceIBMContainerStorageAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	uuid := d.Get("uuid").(string)
 	controller := d.Get("controller").(string)
 	removeAssignmentOptions := &kubernetesserviceapiv1.RemoveAssignmentOptions{}
 	removeAssignmentOptions.UUID = &uuid
 	removeAssignmentOptions.Controller = &controller
 
-	_, err := waitForAssignmentDeletionStatus(removeAssignmentOptions, meta, d)
+	_, err := waitForAssignmentDeletionStatus(ctx, removeAssignmentOptions, meta, d)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Removing Assignment with UUID %s - %v", uuid, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Removing Assignment with UUID %s - %v", uuid, err))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func waitForAssignmentCreationStatus(getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
+func waitForAssignmentCreationStatus(ctx context.Context, getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -299,7 +301,7 @@ func waitForAssignmentCreationStatus(getAssignmentOptions *kubernetesserviceapiv
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func assignmentCreationStatusRefreshFunc(getAssignmentOptions *kubernetesserviceapiv1.GetAssignmentOptions, meta interface{}) resource.StateRefreshFunc {
@@ -320,7 +322,7 @@ func assignmentCreationStatusRefreshFunc(getAssignmentOptions *kubernetesservice
 	}
 }
 
-func waitForAssignmentUpdateStatus(updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
+func waitForAssignmentUpdateStatus(ctx context.Context, updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -330,7 +332,7 @@ func waitForAssignmentUpdateStatus(updateAssignmentOptions *kubernetesserviceapi
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func assignmentUpdateStatusRefreshFunc(updateAssignmentOptions *kubernetesserviceapiv1.UpdateAssignmentOptions, meta interface{}) resource.StateRefreshFunc {
@@ -352,7 +354,7 @@ func assignmentUpdateStatusRefreshFunc(updateAssignmentOptions *kubernetesservic
 	}
 }
 
-func waitForAssignmentDeletionStatus(removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
+func waitForAssignmentDeletionStatus(ctx context.Context, removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -362,7 +364,7 @@ func waitForAssignmentDeletionStatus(removeAssignmentOptions *kubernetesservicea
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func assignmentDeletionStatusRefreshFunc(removeAssignmentOptions *kubernetesserviceapiv1.RemoveAssignmentOptions, meta interface{}) resource.StateRefreshFunc {
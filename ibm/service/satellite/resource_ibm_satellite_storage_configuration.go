@@ -1,6 +1,7 @@
 package satellite
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/utils/strings/slices"
@@ -15,11 +17,11 @@ import (
 
 func ResourceIBMSatelliteStorageConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerStorageConfigurationCreate,
-		Read:     resourceIBMContainerStorageConfigurationRead,
-		Update:   resourceIBMContainerStorageConfigurationUpdate,
-		Delete:   resourceIBMContainerStorageConfigurationDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerStorageConfigurationCreate,
+		ReadContext:   resourceIBMContainerStorageConfigurationRead,
+		UpdateContext: resourceIBMContainerStorageConfigurationUpdate,
+		DeleteContext: resourceIBMContainerStorageConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
@@ -169,10 +171,10 @@ func validateStorageConfig(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMContainerStorageConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createStorageConfigurationOptions := &kubernetesserviceapiv1.CreateStorageConfigurationOptions{}
 	satLocation := d.Get("location").(string)
@@ -180,7 +182,7 @@ func resourceIBMContainerStorageConfigurationCreate(d *schema.ResourceData, meta
 
 	err = validateStorageConfig(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var configName string
@@ -218,25 +220,25 @@ func resourceIBMContainerStorageConfigurationCreate(d *schema.ResourceData, meta
 
 	result, _, err := satClient.CreateStorageConfiguration(createStorageConfigurationOptions)
 	if err != nil {
-		return fmt.Errorf("Unable to Create Storage Configuration - %v", err)
+		return diag.FromErr(fmt.Errorf("Unable to Create Storage Configuration - %v", err))
 	}
 	getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{
 		Name: createStorageConfigurationOptions.ConfigName,
 	}
 	// If we are able to successful get the configuration, then create is assumed to be a success
-	_, err = waitForStorageConfigurationStatus(getStorageConfigurationOptions, meta, d)
+	_, err = waitForStorageConfigurationStatus(ctx, getStorageConfigurationOptions, meta, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*result.AddChannel.UUID + "/" + configName)
-	return resourceIBMContainerStorageConfigurationRead(d, meta)
+	return resourceIBMContainerStorageConfigurationRead(ctx, d, meta)
 }
 
-func resourceIBMContainerStorageConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	satLocation := d.Get("location").(string)
@@ -257,7 +259,7 @@ func resourceIBMContainerStorageConfigurationRead(d *schema.ResourceData, meta i
 
 	result, _, err := satClient.GetStorageConfiguration(getStorageConfigurationOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("config_name", *result.ConfigName)
@@ -287,10 +289,10 @@ func resourceIBMContainerStorageConfigurationRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	updateStorageConfigurationOptions := &kubernetesserviceapiv1.UpdateStorageConfigurationOptions{}
 	updateAssignments := d.Get("update_assignments").(bool)
@@ -300,7 +302,7 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 
 	err = validateStorageConfig(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("user_config_parameters") || d.HasChange("user_secret_parameters") || d.HasChange("storage_class_parameters") && !d.IsNewResource() {
@@ -338,16 +340,16 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 
 		_, _, err := satClient.UpdateStorageConfiguration(updateStorageConfigurationOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Unable to Update Storage Configuration %s - %v", *updateStorageConfigurationOptions.ConfigName, err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Unable to Update Storage Configuration %s - %v", *updateStorageConfigurationOptions.ConfigName, err))
 		}
 
 		getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{
 			Name: updateStorageConfigurationOptions.ConfigName,
 		}
 		// If we are able to successful get the configuration, then update is assumed to be a success
-		_, err = waitForStorageConfigurationStatus(getStorageConfigurationOptions, meta, d)
+		_, err = waitForStorageConfigurationStatus(ctx, getStorageConfigurationOptions, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// if the user has set the updateAssignments parameter to true, then all the assignments are auto updated with the latest configuration revision
@@ -358,7 +360,7 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 
 			result, _, err := satClient.GetAssignmentsByConfig(getAssignmentsByConfigOptions)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			for _, v := range result {
@@ -369,7 +371,7 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 				}
 				_, _, err := satClient.UpdateAssignment(updateStorageAssignment)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -383,15 +385,13 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 		}
 		result, _, err := satClient.GetAssignmentsByConfig(getAssignmentsByConfigOptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = resourceIBMContainerStorageConfigurationDelete(d, meta)
-		if err != nil {
-			return err
+		if diags := resourceIBMContainerStorageConfigurationDelete(ctx, d, meta); diags.HasError() {
+			return diags
 		}
-		err = resourceIBMContainerStorageConfigurationCreate(d, meta)
-		if err != nil {
-			return err
+		if diags := resourceIBMContainerStorageConfigurationCreate(ctx, d, meta); diags.HasError() {
+			return diags
 		}
 		for _, v := range result {
 			if len(v.Groups) != 0 {
@@ -403,7 +403,7 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 				}
 				_, _, err = satClient.CreateAssignment(createAssignmentGroupOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Creating Assignment during Storage Configuration Upgrade - %v", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Creating Assignment during Storage Configuration Upgrade - %v", err))
 				}
 			} else {
 				createAssignmentConfigOptions := &kubernetesserviceapiv1.CreateAssignmentOptions{
@@ -414,16 +414,16 @@ func resourceIBMContainerStorageConfigurationUpdate(d *schema.ResourceData, meta
 				}
 				_, _, err = satClient.CreateAssignmentByCluster(createAssignmentConfigOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Creating Assignment during Storage Configuration Upgrade - %v", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Creating Assignment during Storage Configuration Upgrade - %v", err))
 				}
 			}
 		}
 	}
 
-	return resourceIBMContainerStorageConfigurationRead(d, meta)
+	return resourceIBMContainerStorageConfigurationRead(ctx, d, meta)
 }
 
-func resourceIBMContainerStorageConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerStorageConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	uuid := d.Get("uuid").(string)
 	name := d.Get("config_name").(string)
 	controller := d.Get("location").(string)
@@ -437,20 +437,20 @@ func resourceIBMContainerStorageConfigurationDelete(d *schema.ResourceData, meta
 
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, _, err = satClient.RemoveStorageConfiguration(removeStorageConfigurationOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Storage Configuration %s - %v", name, err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting Storage Configuration %s - %v", name, err))
 	}
 	getStorageConfigurationOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{
 		Name: &name,
 	}
 
 	// If we cannot Get the storage configuration, it is assumed to be successfully deleted.
-	_, err = waitForStorageConfigurationDeletionStatus(getStorageConfigurationOptions, meta, d)
+	_, err = waitForStorageConfigurationDeletionStatus(ctx, getStorageConfigurationOptions, meta, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -467,7 +467,7 @@ func getDefinedStorageClasses(definedMaps []map[string]string, getMaps map[strin
 	return false
 }
 
-func waitForStorageConfigurationStatus(getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
+func waitForStorageConfigurationStatus(ctx context.Context, getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -477,7 +477,7 @@ func waitForStorageConfigurationStatus(getStorageConfigurationOptions *kubernete
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func storageConfigurationStatusRefreshFunc(getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions, meta interface{}) resource.StateRefreshFunc {
@@ -498,7 +498,7 @@ func storageConfigurationStatusRefreshFunc(getStorageConfigurationOptions *kuber
 	}
 }
 
-func waitForStorageConfigurationDeletionStatus(getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
+func waitForStorageConfigurationDeletionStatus(ctx context.Context, getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions, meta interface{}, d *schema.ResourceData) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{"NotReady"},
 		Target:         []string{"Ready"},
@@ -508,7 +508,7 @@ func waitForStorageConfigurationDeletionStatus(getStorageConfigurationOptions *k
 		MinTimeout:     10 * time.Second,
 		NotFoundChecks: 100,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func storageConfigurationDeletionStatusRefreshFunc(getStorageConfigurationOptions *kubernetesserviceapiv1.GetStorageConfigurationOptions, meta interface{}) resource.StateRefreshFunc {
@@ -858,7 +858,7 @@ func isWaitForAgentDestroyResources(context context.Context, schematicsClient *s
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(context)
+	return conns.WaitForStateContext(context, stateConf)
 }
 func agentDestroyRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -157,7 +157,7 @@ func isWaitForAgentAvailable(context context.Context, schematicsClient *schemati
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForStateContext(context)
+	return conns.WaitForStateContext(context, stateConf)
 }
 func agentRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func EphemeralIbmSmArbitrarySecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    ArbitrarySecretResourceName,
+		secretType:  ArbitrarySecretType,
+		description: "Fetches the payload of an arbitrary secret without persisting it in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The arbitrary secret data payload.",
+			},
+		},
+		payload: func(secretIntf secretsmanagerv2.SecretIntf) (map[string]interface{}, error) {
+			secret, ok := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
+			if !ok {
+				return nil, unexpectedSecretTypeError(secretIntf, ArbitrarySecretType)
+			}
+			return map[string]interface{}{
+				"secret_id": secret.ID,
+				"name":      secret.Name,
+				"crn":       secret.Crn,
+				"payload":   secret.Payload,
+			}, nil
+		},
+	}
+}
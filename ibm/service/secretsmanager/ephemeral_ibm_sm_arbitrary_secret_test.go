@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmArbitrarySecretEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretEphemeralConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance", "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmArbitrarySecretEphemeralConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			name = "test_arbitrary_secret_ephemeral_terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			payload = "secret-credentials"
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func EphemeralIbmSmIamCredentialsSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    IAMCredentialsSecretResourceName,
+		secretType:  IAMCredentialsSecretType,
+		description: "Fetches the API key of an IAM credentials secret without persisting it in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+		},
+		payload: func(secretIntf secretsmanagerv2.SecretIntf) (map[string]interface{}, error) {
+			secret, ok := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
+			if !ok {
+				return nil, unexpectedSecretTypeError(secretIntf, IAMCredentialsSecretType)
+			}
+			return map[string]interface{}{
+				"secret_id":  secret.ID,
+				"name":       secret.Name,
+				"crn":        secret.Crn,
+				"api_key":    secret.ApiKey,
+				"api_key_id": secret.ApiKeyID,
+				"service_id": secret.ServiceID,
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EphemeralIbmSmKvSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    KvSecretResourceName,
+		secretType:  KvSecretType,
+		description: "Fetches the data of a key-value secret without persisting it in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"data": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret.",
+			},
+		},
+		payload: func(secretIntf secretsmanagerv2.SecretIntf) (map[string]interface{}, error) {
+			secret, ok := secretIntf.(*secretsmanagerv2.KVSecret)
+			if !ok {
+				return nil, unexpectedSecretTypeError(secretIntf, KvSecretType)
+			}
+			return map[string]interface{}{
+				"secret_id": secret.ID,
+				"name":      secret.Name,
+				"crn":       secret.Crn,
+				"data":      map[string]string(flex.Flatten(secret.Data)),
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func EphemeralIbmSmPrivateCertificate() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    PrivateCertSecretResourceName,
+		secretType:  PrivateCertSecretType,
+		description: "Fetches the certificate and private key of a private certificate without persisting them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded contents of your certificate.",
+			},
+			"issuing_ca": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded certificate of the certificate authority that signed and issued this certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key that is associated with the certificate.",
+			},
+		},
+		payload: func(secretIntf secretsmanagerv2.SecretIntf) (map[string]interface{}, error) {
+			secret, ok := secretIntf.(*secretsmanagerv2.PrivateCertificate)
+			if !ok {
+				return nil, unexpectedSecretTypeError(secretIntf, PrivateCertSecretType)
+			}
+			return map[string]interface{}{
+				"secret_id":   secret.ID,
+				"name":        secret.Name,
+				"crn":         secret.Crn,
+				"certificate": secret.Certificate,
+				"issuing_ca":  secret.IssuingCa,
+				"private_key": secret.PrivateKey,
+			}, nil
+		},
+	}
+}
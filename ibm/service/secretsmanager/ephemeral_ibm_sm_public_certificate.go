@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func EphemeralIbmSmPublicCertificate() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    PublicCertSecretResourceName,
+		secretType:  PublicCertSecretType,
+		description: "Fetches the certificate and private key of a public certificate without persisting them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded contents of your certificate.",
+			},
+			"intermediate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded intermediate certificate that is associated with the root certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key that is associated with the certificate.",
+			},
+		},
+		payload: func(secretIntf secretsmanagerv2.SecretIntf) (map[string]interface{}, error) {
+			secret, ok := secretIntf.(*secretsmanagerv2.PublicCertificate)
+			if !ok {
+				return nil, unexpectedSecretTypeError(secretIntf, PublicCertSecretType)
+			}
+			return map[string]interface{}{
+				"secret_id":    secret.ID,
+				"name":         secret.Name,
+				"crn":          secret.Crn,
+				"certificate":  secret.Certificate,
+				"intermediate": secret.Intermediate,
+				"private_key":  secret.PrivateKey,
+			}, nil
+		},
+	}
+}
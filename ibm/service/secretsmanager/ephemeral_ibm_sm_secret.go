@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &smSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smSecretEphemeralResource{}
+)
+
+// smSecretEphemeralResource fetches a secret when Terraform opens it, so that
+// its payload can be passed to other providers without being persisted in
+// the plan or state. Each secret type supplies the attributes that hold its
+// payload and how to read them from the secret.
+type smSecretEphemeralResource struct {
+	client conns.ClientSession
+
+	typeName    string
+	secretType  string
+	description string
+
+	// payloadAttributes are added to the attributes shared by all secret types.
+	payloadAttributes map[string]schema.Attribute
+	// payload returns the secret_id, name, crn and payload attributes of the
+	// secret, keyed by attribute name.
+	payload func(secret secretsmanagerv2.SecretIntf) (map[string]interface{}, error)
+}
+
+func (r *smSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *smSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "public or private.",
+		},
+		"secret_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret group.",
+		},
+		"crn": schema.StringAttribute{
+			Computed:    true,
+			Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+		},
+	}
+	for name, attribute := range r.payloadAttributes {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes:  attributes,
+	}
+}
+
+func (r *smSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// The provider is not configured when the configuration is validated.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected conns.ClientSession, got %T.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *smSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var secretId, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() || secretId.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if secretId.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid secret reference",
+			"Exactly one of \"secret_id\" or \"name\" must be specified.",
+		)
+	}
+	if !name.IsNull() && groupName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_group_name"),
+			"Missing secret group name",
+			"\"secret_group_name\" must be specified when \"name\" is specified.",
+		)
+	}
+}
+
+func (r *smSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var instanceId, region, endpointType, secretId, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("instance_id"), &instanceId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_type"), &endpointType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(r.client)
+	if err != nil {
+		resp.Diagnostics.Append(flex.FrameworkErrorDiagnostic(err))
+		return
+	}
+	if region.ValueString() == "" {
+		region = types.StringValue(getDefaultRegion(secretsManagerClient))
+	}
+	if endpointType.ValueString() == "" {
+		endpointType = types.StringValue(getDefaultEndpointType(secretsManagerClient))
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId.ValueString(), region.ValueString(), endpointType.ValueString(), endpointsFile)
+
+	var secret secretsmanagerv2.SecretIntf
+	var response *core.DetailedResponse
+	if secretId.ValueString() != "" {
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+		getSecretOptions.SetID(secretId.ValueString())
+
+		secret, response, err = secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+			resp.Diagnostics.Append(flex.FrameworkErrorDiagnostic(flex.FmtErrorf("GetSecretWithContext failed %s\n%s", err, response)))
+			return
+		}
+	} else {
+		getSecretByNameOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{}
+		getSecretByNameOptions.SetName(name.ValueString())
+		getSecretByNameOptions.SetSecretType(r.secretType)
+		getSecretByNameOptions.SetSecretGroupName(groupName.ValueString())
+
+		secret, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(ctx, getSecretByNameOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
+			resp.Diagnostics.Append(flex.FrameworkErrorDiagnostic(flex.FmtErrorf("GetSecretByNameTypeWithContext failed %s\n%s", err, response)))
+			return
+		}
+	}
+
+	values, err := r.payload(secret)
+	if err != nil {
+		resp.Diagnostics.Append(flex.FrameworkErrorDiagnostic(err))
+		return
+	}
+	values["instance_id"] = instanceId
+	values["region"] = region
+	values["endpoint_type"] = endpointType
+	values["secret_group_name"] = groupName
+	for attribute, value := range values {
+		resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
+
+// unexpectedSecretTypeError is returned when the secret that was found is not
+// of the type served by the ephemeral resource.
+func unexpectedSecretTypeError(secret secretsmanagerv2.SecretIntf, secretType string) error {
+	return fmt.Errorf("the secret is not of type %s, got %T", secretType, secret)
+}
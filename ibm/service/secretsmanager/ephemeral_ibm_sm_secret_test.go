@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const testSmInstanceId = "8a4b1a1f-0e33-4f4a-a6b2-5c7c2a0e6f01"
+
+// testSmClientSession serves a Secrets Manager client whose requests are
+// answered by a fake transport instead of an instance.
+type testSmClientSession struct {
+	conns.ClientSession
+	client *secretsmanagerv2.SecretsManagerV2
+}
+
+func (s testSmClientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	return s.client, nil
+}
+
+func (s testSmClientSession) BluemixSession() (*bxsession.Session, error) {
+	return &bxsession.Session{Config: &bluemix.Config{}}, nil
+}
+
+type testSmTransport struct {
+	body     string
+	requests []*http.Request
+}
+
+func (t *testSmTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+// testSmEphemeralOpen opens the ephemeral resource with the given string
+// arguments and returns its result.
+func testSmEphemeralOpen(t *testing.T, resource ephemeral.EphemeralResource, body string, config map[string]string) (*ephemeral.OpenResponse, *testSmTransport) {
+	t.Helper()
+	ctx := context.Background()
+
+	transport := &testSmTransport{body: body}
+	client, err := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+		URL:           "https://secrets-manager.us-south.appdomain.cloud",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.Service.SetHTTPClient(&http.Client{Transport: transport})
+
+	r := resource.(*smSecretEphemeralResource)
+	r.client = testSmClientSession{client: client}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: req.Config.Raw.Copy()},
+	}
+	r.Open(ctx, req, resp)
+	return resp, transport
+}
+
+func TestSmSecretEphemeralResourceOpen(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource ephemeral.EphemeralResource
+		body     string
+		expected map[string]string
+	}{
+		{
+			name:     ArbitrarySecretType,
+			resource: EphemeralIbmSmArbitrarySecret(),
+			body:     `{"secret_type": "arbitrary", "payload": "secret-credentials"}`,
+			expected: map[string]string{"payload": "secret-credentials"},
+		},
+		{
+			name:     IAMCredentialsSecretType,
+			resource: EphemeralIbmSmIamCredentialsSecret(),
+			body:     `{"secret_type": "iam_credentials", "api_key": "api-key", "api_key_id": "ApiKey-1", "service_id": "ServiceId-1"}`,
+			expected: map[string]string{"api_key": "api-key", "api_key_id": "ApiKey-1", "service_id": "ServiceId-1"},
+		},
+		{
+			name:     ImportedCertSecretType,
+			resource: EphemeralIbmSmImportedCertificate(),
+			body:     `{"secret_type": "imported_cert", "certificate": "cert", "intermediate": "intermediate", "private_key": "key"}`,
+			expected: map[string]string{"certificate": "cert", "intermediate": "intermediate", "private_key": "key"},
+		},
+		{
+			name:     PrivateCertSecretType,
+			resource: EphemeralIbmSmPrivateCertificate(),
+			body:     `{"secret_type": "private_cert", "certificate": "cert", "issuing_ca": "ca", "private_key": "key"}`,
+			expected: map[string]string{"certificate": "cert", "issuing_ca": "ca", "private_key": "key"},
+		},
+		{
+			name:     PublicCertSecretType,
+			resource: EphemeralIbmSmPublicCertificate(),
+			body:     `{"secret_type": "public_cert", "certificate": "cert", "intermediate": "intermediate", "private_key": "key"}`,
+			expected: map[string]string{"certificate": "cert", "intermediate": "intermediate", "private_key": "key"},
+		},
+		{
+			name:     UsernamePasswordSecretType,
+			resource: EphemeralIbmSmUsernamePasswordSecret(),
+			body:     `{"secret_type": "username_password", "username": "user", "password": "pass"}`,
+			expected: map[string]string{"username": "user", "password": "pass"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			body := strings.Replace(c.body, "{", `{"id": "secret-id", "name": "secret-name", "crn": "secret-crn", `, 1)
+			resp, transport := testSmEphemeralOpen(t, c.resource, body, map[string]string{
+				"instance_id": testSmInstanceId,
+				"secret_id":   "secret-id",
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if len(transport.requests) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(transport.requests))
+			}
+			if url := transport.requests[0].URL.String(); url != "https://"+testSmInstanceId+".us-south.secrets-manager.appdomain.cloud/api/v2/secrets/secret-id" {
+				t.Errorf("unexpected request URL %s", url)
+			}
+
+			expected := map[string]string{
+				"instance_id":   testSmInstanceId,
+				"region":        "us-south",
+				"endpoint_type": "public",
+				"secret_id":     "secret-id",
+				"name":          "secret-name",
+				"crn":           "secret-crn",
+			}
+			for attribute, value := range c.expected {
+				expected[attribute] = value
+			}
+			for attribute, value := range expected {
+				var actual types.String
+				resp.Diagnostics.Append(resp.Result.GetAttribute(context.Background(), path.Root(attribute), &actual)...)
+				if actual.ValueString() != value {
+					t.Errorf("expected %s to be %q, got %q", attribute, value, actual.ValueString())
+				}
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSmKvSecretEphemeralResourceOpenByName(t *testing.T) {
+	resp, transport := testSmEphemeralOpen(t, EphemeralIbmSmKvSecret(),
+		`{"id": "secret-id", "name": "secret-name", "crn": "secret-crn", "secret_type": "kv", "data": {"key": "value"}}`,
+		map[string]string{
+			"instance_id":       testSmInstanceId,
+			"region":            "eu-de",
+			"endpoint_type":     "private",
+			"name":              "secret-name",
+			"secret_group_name": "default",
+		})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(transport.requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(transport.requests))
+	}
+	if url := transport.requests[0].URL.String(); url != "https://"+testSmInstanceId+".private.eu-de.secrets-manager.appdomain.cloud/api/v2/secret_groups/default/secret_types/kv/secrets/secret-name" {
+		t.Errorf("unexpected request URL %s", url)
+	}
+
+	var secretId types.String
+	var data map[string]string
+	resp.Diagnostics.Append(resp.Result.GetAttribute(context.Background(), path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(resp.Result.GetAttribute(context.Background(), path.Root("data"), &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if secretId.ValueString() != "secret-id" {
+		t.Errorf("expected secret_id to be %q, got %q", "secret-id", secretId.ValueString())
+	}
+	if len(data) != 1 || data["key"] != "value" {
+		t.Errorf("expected data to be {key = value}, got %v", data)
+	}
+}
+
+func TestSmSecretEphemeralResourceOpenUnexpectedType(t *testing.T) {
+	resp, _ := testSmEphemeralOpen(t, EphemeralIbmSmArbitrarySecret(),
+		`{"id": "secret-id", "secret_type": "kv", "data": {"key": "value"}}`,
+		map[string]string{
+			"instance_id": testSmInstanceId,
+			"secret_id":   "secret-id",
+		})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func EphemeralIbmSmUsernamePasswordSecret() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    UsernamePasswordSecretResourceName,
+		secretType:  UsernamePasswordSecretType,
+		description: "Fetches the credentials of a user credentials secret without persisting them in state.",
+		payloadAttributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username that is assigned to the secret.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password that is assigned to the secret.",
+			},
+		},
+		payload: func(secretIntf secretsmanagerv2.SecretIntf) (map[string]interface{}, error) {
+			secret, ok := secretIntf.(*secretsmanagerv2.UsernamePasswordSecret)
+			if !ok {
+				return nil, unexpectedSecretTypeError(secretIntf, UsernamePasswordSecretType)
+			}
+			return map[string]interface{}{
+				"secret_id": secret.ID,
+				"name":      secret.Name,
+				"crn":       secret.Crn,
+				"username":  secret.Username,
+				"password":  secret.Password,
+			}, nil
+		},
+	}
+}
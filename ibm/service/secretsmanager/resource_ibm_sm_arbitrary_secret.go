@@ -172,7 +172,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmArbitrarySecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmArbitrarySecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmArbitrarySecret (%s) to be created: %s", d.Id(), err.Error()), ArbitrarySecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -181,7 +181,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	return resourceIbmSmArbitrarySecretRead(context, d, meta)
 }
 
-func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmArbitrarySecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 	id := strings.Split(d.Id(), "/")
 	secretId := id[2]
@@ -211,7 +211,7 @@ func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.Se
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -269,7 +269,7 @@ func resourceIbmSmCustomCredentialsSecretCreate(context context.Context, d *sche
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmCustomCredentialsSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmCustomCredentialsSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmCustomCredentialsSecret (%s) to be created: %s", d.Id(), err.Error()), CustomCredentialsSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -278,7 +278,7 @@ func resourceIbmSmCustomCredentialsSecretCreate(context context.Context, d *sche
 	return resourceIbmSmCustomCredentialsSecretRead(context, d, meta)
 }
 
-func waitForIbmSmCustomCredentialsSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmCustomCredentialsSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 	id := strings.Split(d.Id(), "/")
 	secretId := id[2]
@@ -306,7 +306,7 @@ func waitForIbmSmCustomCredentialsSecretCreate(secretsManagerClient *secretsmana
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmCustomCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -700,7 +700,7 @@ func ibmSmCustomCredentialsClearVersion(secretsManagerClient *secretsmanagerv2.S
 		MinTimeout: 5 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = conns.WaitForStateContext(context, stateConf)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmCustomCredentialsSecret (%s) to be deleted: %s", d.Id(), err.Error()), CustomCredentialsSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -251,7 +251,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmIamCredentialsSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmIamCredentialsSecret (%s) to be created: %s", d.Id(), err.Error()), IAMCredentialsSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -260,7 +260,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	return resourceIbmSmIamCredentialsSecretRead(context, d, meta)
 }
 
-func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmIamCredentialsSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -291,7 +291,7 @@ func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanager
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -163,7 +163,7 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmKvSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmKvSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmKvSecret (%s) to be created: %s", d.Id(), err.Error()), KvSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -172,7 +172,7 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	return resourceIbmSmKvSecretRead(context, d, meta)
 }
 
-func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmKvSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -203,7 +203,7 @@ func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsMa
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -348,7 +348,7 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmPrivateCertificateCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmPrivateCertificateCreate(context, secretsManagerClient, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmPrivateCertificate (%s) to be created: %s", d.Id(), err.Error()), PrivateCertSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -357,7 +357,7 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	return resourceIbmSmPrivateCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmPrivateCertificateCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -388,7 +388,7 @@ func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -439,9 +439,9 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	d.Set("secret_id", *secret.ID)
 
 	if *secret.Dns == "manual" || *secret.Dns == "akamai" {
-		_, err = waitForIbmSmPublicCertificateCreate(secretsManagerClient, d, "", "pre_activation")
+		_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, "", "pre_activation")
 	} else {
-		_, err = waitForIbmSmPublicCertificateCreate(secretsManagerClient, d, "pre_activation", "active")
+		_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, "pre_activation", "active")
 	}
 
 	if err != nil {
@@ -452,7 +452,7 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	return resourceIbmSmPublicCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, pendingStatus string, targetStatus string) (interface{}, error) {
+func waitForIbmSmPublicCertificateCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, pendingStatus string, targetStatus string) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -482,7 +482,7 @@ func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -81,7 +81,7 @@ func validateManualDns(context context.Context, d *schema.ResourceData, secretsM
 		return tfErr.GetDiag()
 	}
 
-	_, err = waitForIbmSmPublicCertificateCreate(secretsManagerClient, d, "pre_activation", "active")
+	_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d, "pre_activation", "active")
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmPublicCertificateActionValidateManualDns (%s) to be created: %s", d.Id(), err.Error()), PublicCertConfigActionValidateManualDNSResourceName, "create")
 		return tfErr.GetDiag()
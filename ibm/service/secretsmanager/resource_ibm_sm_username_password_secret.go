@@ -245,7 +245,7 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmUsernamePasswordSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error waiting for resource IbmSmUsernamePasswordSecret (%s) to be created: %s", d.Id(), err.Error()), UsernamePasswordSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -254,7 +254,7 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 	return resourceIbmSmUsernamePasswordSecretRead(context, d, meta)
 }
 
-func waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmUsernamePasswordSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 	id := strings.Split(d.Id(), "/")
 	secretId := id[2]
@@ -283,7 +283,7 @@ func waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient *secretsmanag
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
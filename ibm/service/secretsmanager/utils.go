@@ -63,27 +63,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getDefaultRegion(originalClient)
 	}
 }
 
+// Extract the region from the base URL of the provider's client
+func getDefaultRegion(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getDefaultEndpointType(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Extract the endpoint type from the base URL of the provider's client
+func getDefaultEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,12 +42,12 @@ const (
 
 func ResourceIBMTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayCreate,
-		Read:     resourceIBMTransitGatewayRead,
-		Delete:   resourceIBMTransitGatewayDelete,
-		Exists:   resourceIBMTransitGatewayExists,
-		Update:   resourceIBMTransitGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayCreate,
+		ReadContext:   resourceIBMTransitGatewayRead,
+		DeleteContext: resourceIBMTransitGatewayDelete,
+		Exists:        resourceIBMTransitGatewayExists,
+		UpdateContext: resourceIBMTransitGatewayUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -185,10 +186,10 @@ func transitgatewayClient(meta interface{}) (*transitgatewayapisv1.TransitGatewa
 	return sess, err
 }
 
-func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := d.Get(tgLocation).(string)
@@ -211,14 +212,14 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		log.Printf("[DEBUG] Create Transit Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*tgw.ID)
 
-	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -230,10 +231,10 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 				"Error on create of transit gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMTransitGatewayRead(d, meta)
+	return resourceIBMTransitGatewayRead(ctx, d, meta)
 }
 
-func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -245,7 +246,7 @@ func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
@@ -266,11 +267,11 @@ func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApis
 	}
 }
 
-func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{}
 	if id != "" {
@@ -283,7 +284,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*tgw.ID)
@@ -307,7 +308,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set(flex.ResourceControllerURL, controller+"/interconnectivity/transit")
@@ -322,11 +323,11 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -337,7 +338,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		log.Printf("Error fetching Transit Gateway: %s", resp)
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateTransitGatewayOptions := &transitgatewayapisv1.UpdateTransitGatewayOptions{}
@@ -364,17 +365,17 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 	_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMTransitGatewayRead(d, meta)
+	return resourceIBMTransitGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ID := d.Id()
@@ -387,17 +388,17 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayDeleted(client, ID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForTransitGatewayDeleted(ctx, client, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -409,7 +410,7 @@ func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayA
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
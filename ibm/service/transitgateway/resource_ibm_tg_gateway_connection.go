@@ -4,14 +4,17 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
@@ -49,12 +52,12 @@ const (
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionCreate,
-		Read:     resourceIBMTransitGatewayConnectionRead,
-		Delete:   resourceIBMTransitGatewayConnectionDelete,
-		Exists:   resourceIBMTransitGatewayConnectionExists,
-		Update:   resourceIBMTransitGatewayConnectionUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayConnectionCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionRead,
+		DeleteContext: resourceIBMTransitGatewayConnectionDelete,
+		Exists:        resourceIBMTransitGatewayConnectionExists,
+		UpdateContext: resourceIBMTransitGatewayConnectionUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -297,10 +300,10 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
-func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createTransitGatewayConnectionOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
@@ -362,7 +365,7 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		if "redundant_gre" == networkType {
 			err = fmt.Errorf("[ERROR] Error default_prefix_filter is not allowed for connection type %s,", networkType)
 			log.Printf("[ERROR] Error default_prefix_filter is not allowed for connection type %s ", networkType)
-			return err
+			return diag.FromErr(err)
 		}
 		default_prefix_filter := d.Get(tgDefaultPrefixFilter).(string)
 		createTransitGatewayConnectionOptions.SetPrefixFiltersDefault(default_prefix_filter)
@@ -411,7 +414,7 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgConnections.ID))
@@ -419,16 +422,16 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	if tgConnections.NetworkAccountID != nil {
 		d.Set(tgNetworkAccountID, *tgConnections.NetworkAccountID)
-		return resourceIBMTransitGatewayConnectionRead(d, meta)
+		return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 	}
-	_, err = isWaitForTransitGatewayConnectionAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 }
-func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway connection (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -440,7 +443,7 @@ func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.Tra
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -466,15 +469,15 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		return tgConnection, isTransitGatewayConnectionPending, nil
 	}
 }
-func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -489,7 +492,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -528,7 +531,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	}
 	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response))
 	}
 	d.Set(flex.RelatedCRN, *tgw.Crn)
 
@@ -582,15 +585,15 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -603,7 +606,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response))
 	}
 
 	updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
@@ -624,21 +627,21 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response))
 	}
 
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -653,19 +656,19 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionDeleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway Connection(%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -677,7 +680,7 @@ func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.Trans
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
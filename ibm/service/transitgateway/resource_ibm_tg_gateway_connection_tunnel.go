@@ -4,14 +4,17 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
@@ -26,12 +29,12 @@ const (
 
 func ResourceIBMTransitGatewayConnectionRgreTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionRgreTunnelCreate,
-		Read:     resourceIBMTransitGatewayConnectionRgreTunnelRead,
-		Delete:   resourceIBMTransitGatewayConnectionRgreTunnelDelete,
-		Exists:   resourceIBMTransitGatewayConnectionRgreTunnelExists,
-		Update:   resourceIBMTransitGatewayConnectionRgreTunnelUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayConnectionRgreTunnelCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionRgreTunnelRead,
+		DeleteContext: resourceIBMTransitGatewayConnectionRgreTunnelDelete,
+		Exists:        resourceIBMTransitGatewayConnectionRgreTunnelExists,
+		UpdateContext: resourceIBMTransitGatewayConnectionRgreTunnelUpdate,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -167,11 +170,11 @@ func ResourceIBMTransitGatewayConnectionRgreTunnelValidator() *validate.Resource
 
 	return &ibmTransitGatewayConnectionTunnelResourceValidator
 }
-func resourceIBMTransitGatewayConnectionRgreTunnelCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRgreTunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createTransitGatewayConnectionRgreTunnelOptions := &transitgatewayapisv1.CreateTransitGatewayGreTunnelOptions{}
@@ -217,15 +220,15 @@ func resourceIBMTransitGatewayConnectionRgreTunnelCreate(d *schema.ResourceData,
 
 	rGRETunnel, response, err := client.CreateTransitGatewayGreTunnel(createTransitGatewayConnectionRgreTunnelOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Create Transit Gateway connection  rGRE tunnel err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Create Transit Gateway connection  rGRE tunnel err %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", gatewayId, connectionId, *rGRETunnel.ID))
 	d.Set(tgGreTunnelId, *rGRETunnel.ID)
 
-	return resourceIBMTransitGatewayConnectionRgreTunnelRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRgreTunnelRead(ctx, d, meta)
 }
-func isWaitForTransitGatewayConnectionRgreTunnelAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionRgreTunnelAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for transit gateway connection tunnel (%s) to be available.", id)
 
@@ -238,7 +241,7 @@ func isWaitForTransitGatewayConnectionRgreTunnelAvailable(client *transitgateway
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isTransitGatewayConnectionRgreTunnelRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -267,15 +270,15 @@ func isTransitGatewayConnectionRgreTunnelRefreshFunc(client *transitgatewayapisv
 		return trGREunnel, isTransitGatewayConnectionTunnelPending, nil
 	}
 }
-func resourceIBMTransitGatewayConnectionRgreTunnelRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRgreTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -289,9 +292,9 @@ func resourceIBMTransitGatewayConnectionRgreTunnelRead(d *schema.ResourceData, m
 
 	// if it's  not across account wait for it became available.
 	if tgConnection.RequestStatus == nil {
-		_, err = isWaitForTransitGatewayConnectionRgreTunnelAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForTransitGatewayConnectionRgreTunnelAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -305,7 +308,7 @@ func resourceIBMTransitGatewayConnectionRgreTunnelRead(d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection  Redundant GRE Tunnel (%s): %s\n%s", rGRETunnelID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection  Redundant GRE Tunnel (%s): %s\n%s", rGRETunnelID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -346,15 +349,15 @@ func resourceIBMTransitGatewayConnectionRgreTunnelRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceIBMTransitGatewayConnectionRgreTunnelUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRgreTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -375,21 +378,21 @@ func resourceIBMTransitGatewayConnectionRgreTunnelUpdate(d *schema.ResourceData,
 
 	_, response, err := client.UpdateTransitGatewayConnectionTunnels(updateTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection Tunnel: %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection Tunnel: %s\n%s", err, response))
 	}
 
-	return resourceIBMTransitGatewayConnectionRgreTunnelRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRgreTunnelRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionRgreTunnelDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionRgreTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -408,18 +411,18 @@ func resourceIBMTransitGatewayConnectionRgreTunnelDelete(d *schema.ResourceData,
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection Tunnel (%s): %s\n%s", rGRETunnelID, err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection Tunnel (%s): %s\n%s", rGRETunnelID, err, response))
 	}
-	_, err = isWaitForTransitGatewayConnectionRgreTunnelDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionRgreTunnelDeleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayConnectionRgreTunnelDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionRgreTunnelDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway Connection Tunnel (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -431,7 +434,7 @@ func isWaitForTransitGatewayConnectionRgreTunnelDeleted(client *transitgatewayap
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTransitGatewayConnectionRgreTunnelDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
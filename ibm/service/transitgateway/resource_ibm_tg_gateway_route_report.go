@@ -4,14 +4,17 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
@@ -24,10 +27,10 @@ const (
 
 func ResourceIBMTransitGatewayRouteReport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayRouteReportCreate,
-		Read:     resourceIBMTransitGatewayRouteReportRead,
-		Delete:   resourceIBMTransitGatewayRouteReportDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayRouteReportCreate,
+		ReadContext:   resourceIBMTransitGatewayRouteReportRead,
+		DeleteContext: resourceIBMTransitGatewayRouteReportDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -147,10 +150,10 @@ func ResourceIBMTransitGatewayRouteReport() *schema.Resource {
 	}
 }
 
-func resourceIBMTransitGatewayRouteReportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRouteReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	createTransitGatewayRouteReportOptions := &transitgatewayapisv1.CreateTransitGatewayRouteReportOptions{}
@@ -160,21 +163,21 @@ func resourceIBMTransitGatewayRouteReportCreate(d *schema.ResourceData, meta int
 
 	tgRouteReport, response, err := client.CreateTransitGatewayRouteReport(createTransitGatewayRouteReportOptions)
 	if err != nil {
-		return fmt.Errorf("Create Transit Gateway Route Report err %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Create Transit Gateway Route Report err %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgRouteReport.ID))
 	d.Set(tgRouteReportId, *tgRouteReport.ID)
 
-	_, err = isWaitForTransitGatewayRouteReportAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayRouteReportAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMTransitGatewayRouteReportRead(d, meta)
+	return resourceIBMTransitGatewayRouteReportRead(ctx, d, meta)
 }
 
-func isWaitForTransitGatewayRouteReportAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayRouteReportAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway route report (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -186,7 +189,7 @@ func isWaitForTransitGatewayRouteReportAvailable(client *transitgatewayapisv1.Tr
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTransitGatewayRouteReportRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
@@ -216,15 +219,15 @@ func isTransitGatewayRouteReportRefreshFunc(client *transitgatewayapisv1.Transit
 	}
 }
 
-func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRouteReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -239,7 +242,7 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Transit Gateway Route Report (%s): %s\n%s", routeReportID, err, response)
+		return diag.FromErr(fmt.Errorf("Error Getting Transit Gateway Route Report (%s): %s\n%s", routeReportID, err, response))
 	}
 
 	d.Set(tgRouteReportId, *instance.ID)
@@ -336,15 +339,15 @@ func resourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceIBMTransitGatewayRouteReportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRouteReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -359,7 +362,7 @@ func resourceIBMTransitGatewayRouteReportDelete(d *schema.ResourceData, meta int
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway Route Report(%s): %s\n%s", ID, err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting Transit Gateway Route Report(%s): %s\n%s", ID, err, response))
 	}
 
 	d.SetId("")
@@ -69,7 +69,7 @@ func waitForVdcStatusUpdate(context context.Context, d *schema.ResourceData, met
 		Delay:      10 * time.Second,
 		MinTimeout: 30 * time.Second,
 	}
-	return stateConf.WaitForStateContext(context)
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func waitForVdcToDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
@@ -103,5 +103,5 @@ func waitForVdcToDelete(context context.Context, d *schema.ResourceData, meta in
 		MinTimeout: 60 * time.Second,
 	}
 
-	return stateConf.WaitForStateContext(context)
+	return conns.WaitForStateContext(context, stateConf)
 }
@@ -21,6 +21,7 @@ func DataSourceIBMIsBackupPolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsBackupPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Description: "Filters the collection to resources in the resource group with the specified identifier",
@@ -245,6 +246,13 @@ func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_backup_policies", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	matchBackupPolicies := []vpcv1.BackupPolicy{}
 
@@ -261,6 +269,8 @@ func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.Resour
 	var tag string
 	if v, ok := d.GetOk("tag"); ok {
 		tag = v.(string)
+	} else if tagFilter, ok := filters.pushdownTag(); ok {
+		tag = tagFilter
 	}
 
 	for {
@@ -301,7 +311,14 @@ func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.Resour
 	d.SetId(dataSourceIBMIsBackupPoliciesID(d))
 
 	if matchBackupPolicies != nil {
-		err = d.Set("backup_policies", dataSourceBackupPolicyCollectionFlattenBackupPolicies(matchBackupPolicies))
+		backupPolicies := dataSourceBackupPolicyCollectionFlattenBackupPolicies(matchBackupPolicies)
+		backupPolicies, err = filters.apply(context, meta, backupPolicies)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering backup policies: %s", err), "(Data) ibm_is_backup_policies", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("backup_policies", backupPolicies)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting backup_policies %s", err), "(Data) ibm_is_backup_policies", "read")
 			log.Printf("[DEBUG] %s", tfErr.GetDebugMessage())
@@ -23,6 +23,7 @@ func DataSourceIBMIsBackupPolicyJobs() *schema.Resource {
 		ReadContext: dataSourceIBMIsBackupPolicyJobsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"backup_policy_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -459,6 +460,13 @@ func dataSourceIBMIsBackupPolicyJobsRead(context context.Context, d *schema.Reso
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_backup_policy_jobs", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listBackupPolicyJobsOptions := &vpcv1.ListBackupPolicyJobsOptions{}
 
 	listBackupPolicyJobsOptions.SetBackupPolicyID(d.Get("backup_policy_id").(string))
@@ -530,7 +538,14 @@ func dataSourceIBMIsBackupPolicyJobsRead(context context.Context, d *schema.Reso
 	d.SetId(dataSourceIBMIsBackupPolicyJobsID(d))
 
 	if allrecs != nil {
-		err = d.Set("jobs", dataSourceBackupPolicyJobCollectionFlattenJobs(allrecs))
+		jobs := dataSourceBackupPolicyJobCollectionFlattenJobs(allrecs)
+		jobs, err = filters.apply(context, meta, jobs)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering jobs: %s", err), "(Data) ibm_is_backup_policy_jobs", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("jobs", jobs)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting jobs %s", err), "(Data) ibm_is_backup_policy_jobs", "read")
 			return tfErr.GetDiag()
@@ -21,6 +21,7 @@ func DataSourceIBMIsBackupPolicyPlans() *schema.Resource {
 		ReadContext: dataSourceIBMIsBackupPolicyPlansRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"backup_policy_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -166,6 +167,13 @@ func dataSourceIBMIsBackupPolicyPlansRead(context context.Context, d *schema.Res
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_backup_policy_plans", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listBackupPolicyPlansOptions := &vpcv1.ListBackupPolicyPlansOptions{}
 
 	listBackupPolicyPlansOptions.SetBackupPolicyID(d.Get("backup_policy_id").(string))
@@ -205,7 +213,14 @@ func dataSourceIBMIsBackupPolicyPlansRead(context context.Context, d *schema.Res
 	}
 
 	if backupPolicyPlanCollection.Plans != nil {
-		err = d.Set("plans", dataSourceBackupPolicyPlanCollectionFlattenPlans(backupPolicyPlanCollection.Plans))
+		plans := dataSourceBackupPolicyPlanCollectionFlattenPlans(backupPolicyPlanCollection.Plans)
+		plans, err = filters.apply(context, meta, plans)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering plans: %s", err), "(Data) ibm_is_backup_policy_plans", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("plans", plans)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting plans: %s", err), "(Data) ibm_is_backup_policy_plans", "read", "set-plans").GetDiag()
 		}
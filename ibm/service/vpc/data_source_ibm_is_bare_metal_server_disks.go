@@ -27,6 +27,7 @@ func DataSourceIBMIsBareMetalServerDisks() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServerDisksRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isBareMetalServerID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -86,6 +87,14 @@ func dataSourceIBMISBareMetalServerDisksRead(context context.Context, d *schema.
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_server_disks", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	options := &vpcv1.ListBareMetalServerDisksOptions{
 		BareMetalServerID: &bareMetalServerID,
 	}
@@ -110,6 +119,12 @@ func dataSourceIBMISBareMetalServerDisksRead(context context.Context, d *schema.
 		disksInfo = append(disksInfo, l)
 	}
 	d.SetId(dataSourceIBMISBMSDisksID(d))
+	disksInfo, err = filters.apply(context, meta, disksInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering disks: %s", err), "(Data) ibm_is_bare_metal_server_disks", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isBareMetalServerDisks, disksInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting disks: %s", err), "(Data) ibm_is_bare_metal_server_disks", "read", "set-disks").GetDiag()
 	}
@@ -21,6 +21,7 @@ func DataSourceIBMIsBareMetalServerNetworkAttachments() *schema.Resource {
 		ReadContext: dataSourceIBMIsBareMetalServerNetworkAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"bare_metal_server": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -239,6 +240,13 @@ func dataSourceIBMIsBareMetalServerNetworkAttachmentsRead(context context.Contex
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_server_network_attachments", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listBareMetalServerNetworkAttachmentsOptions := &vpcv1.ListBareMetalServerNetworkAttachmentsOptions{}
 
 	listBareMetalServerNetworkAttachmentsOptions.SetBareMetalServerID(d.Get("bare_metal_server").(string))
@@ -269,6 +277,12 @@ func dataSourceIBMIsBareMetalServerNetworkAttachmentsRead(context context.Contex
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering network attachments: %s", err), "(Data) ibm_is_bare_metal_server_network_attachments", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("network_attachments", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting network_attachments %s", err), "(Data) ibm_is_bare_metal_server_network_attachments", "read", "network_attachments-set").GetDiag()
 	}
@@ -26,6 +26,7 @@ func DataSourceIBMIsBareMetalServerNetworkInterfaceFloatingIPs() *schema.Resourc
 		ReadContext: dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isBareMetalServerID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -100,6 +101,14 @@ func dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsRead(context conte
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_server_network_interface_floating_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	allFloatingIPs := []vpcv1.FloatingIP{}
 	options := &vpcv1.ListBareMetalServerNetworkInterfaceFloatingIpsOptions{
 		BareMetalServerID:  &bareMetalServerID,
@@ -135,6 +144,12 @@ func dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsRead(context conte
 	}
 	d.SetId(dataSourceIBMISBareMetalServerNetworkInterfaceFloatingIPsID(d))
 
+	fipInfo, err = filters.apply(context, meta, fipInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering floating ips: %s", err), "(Data) ibm_is_bare_metal_server_network_interface_floating_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isBareMetalServerNicFloatingIPs, fipInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting floating_ips: %s", err), "(Data) ibm_is_bare_metal_server_network_interface_floating_ips", "read", "set-floating_ips").GetDiag()
 	}
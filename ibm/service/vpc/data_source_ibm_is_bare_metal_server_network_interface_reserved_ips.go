@@ -29,6 +29,7 @@ func DataSourceIBMISBareMetalServerNICReservedIPs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISBareMetalServerNICReservedIPsRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			/*
 				Request Parameters
 				==================
@@ -122,6 +123,13 @@ func dataSourceIBMISBareMetalServerNICReservedIPsRead(context context.Context, d
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_server_network_interface_reserved_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	bareMetalServerID := d.Get(isBareMetalServerID).(string)
 	nicID := d.Get(isBareMetalServerNicID).(string)
 
@@ -160,6 +168,12 @@ func dataSourceIBMISBareMetalServerNICReservedIPsRead(context context.Context, d
 	}
 
 	d.SetId(time.Now().UTC().String()) // This is not any reserved ip or BareMetalServer id but state id
+	reservedIPs, err = filters.apply(context, meta, reservedIPs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering reserved ips: %s", err), "(Data) ibm_is_bare_metal_server_network_interface_reserved_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isBareMetalServerNICReservedIPs, reservedIPs); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reserved_ips: %s", err), "(Data) ibm_is_bare_metal_server_network_interface_reserved_ips", "read", "set-reserved_ips").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsBareMetalServerNetworkInterfaces() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServerNetworkInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isBareMetalServerID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -218,6 +219,14 @@ func dataSourceIBMISBareMetalServerNetworkInterfacesRead(context context.Context
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_server_network_interfaces", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	options := &vpcv1.ListBareMetalServerNetworkInterfacesOptions{
 		BareMetalServerID: &bareMetalServerID,
 	}
@@ -420,6 +429,12 @@ func dataSourceIBMISBareMetalServerNetworkInterfacesRead(context context.Context
 	}
 	d.SetId(dataSourceIBMISBareMetalServerNetworkInterfacesID(d))
 
+	nicsInfo, err = filters.apply(context, meta, nicsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering network interfaces: %s", err), "(Data) ibm_is_bare_metal_server_network_interfaces", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isBareMetalServerNetworkInterfaces, nicsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting network_interfaces %s", err), "(Data) ibm_is_bare_metal_server_network_interfaces", "read", "network_interfaces-set").GetDiag()
 	}
@@ -25,6 +25,7 @@ func DataSourceIBMIsBareMetalServerProfiles() *schema.Resource {
 		ReadContext: dataSourceIBMIsBareMetalServerProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			isBareMetalServerProfiles: {
 				Type:        schema.TypeList,
@@ -418,6 +419,13 @@ func dataSourceIBMIsBareMetalServerProfilesRead(context context.Context, d *sche
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_server_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.BareMetalServerProfile{}
 	for {
@@ -625,6 +633,12 @@ func dataSourceIBMIsBareMetalServerProfilesRead(context context.Context, d *sche
 		profilesInfo = append(profilesInfo, l)
 	}
 	d.SetId(dataSourceIBMIsBMSProfilesID(d))
+	profilesInfo, err = filters.apply(context, meta, profilesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering profiles: %s", err), "(Data) ibm_is_bare_metal_server_profiles", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isBareMetalServerProfiles, profilesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting profiles %s", err), "(Data) ibm_is_bare_metal_server_profiles", "read", "profiles-set").GetDiag()
 	}
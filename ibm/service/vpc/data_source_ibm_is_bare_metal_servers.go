@@ -27,6 +27,7 @@ func DataSourceIBMIsBareMetalServers() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServersRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -952,6 +953,13 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_bare_metal_servers", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allrecs := []vpcv1.BareMetalServer{}
 
@@ -1380,6 +1388,12 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		}
 		serversInfo = append(serversInfo, l)
 	}
+	serversInfo, err = filters.apply(context, meta, serversInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering bare metal servers: %s", err), "(Data) ibm_is_bare_metal_servers", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISBareMetalServersID(d))
 	if err = d.Set(isBareMetalServers, serversInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting bare_metal_servers %s", err), "(Data) ibm_is_bare_metal_servers", "read", "bare_metal_servers-set").GetDiag()
@@ -22,6 +22,7 @@ func DataSourceIBMIsClusterNetworkInterfaces() *schema.Resource {
 		ReadContext: dataSourceIBMIsClusterNetworkInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"cluster_network_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -324,6 +325,13 @@ func dataSourceIBMIsClusterNetworkInterfacesRead(context context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_cluster_network_interfaces", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listClusterNetworkInterfacesOptions := &vpcv1.ListClusterNetworkInterfacesOptions{}
 
 	listClusterNetworkInterfacesOptions.SetClusterNetworkID(d.Get("cluster_network_id").(string))
@@ -360,6 +368,12 @@ func dataSourceIBMIsClusterNetworkInterfacesRead(context context.Context, d *sch
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering interfaces: %s", err), "(Data) ibm_is_cluster_network_interfaces", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("interfaces", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting interfaces %s", err), "(Data) ibm_is_cluster_network_interfaces", "read", "interfaces-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsClusterNetworkProfiles() *schema.Resource {
 		ReadContext: dataSourceIBMIsClusterNetworkProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"profiles": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -106,6 +107,13 @@ func dataSourceIBMIsClusterNetworkProfilesRead(context context.Context, d *schem
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_cluster_network_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listClusterNetworkProfilesOptions := &vpcv1.ListClusterNetworkProfilesOptions{}
 
 	var pager *vpcv1.ClusterNetworkProfilesPager
@@ -134,6 +142,12 @@ func dataSourceIBMIsClusterNetworkProfilesRead(context context.Context, d *schem
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering profiles: %s", err), "(Data) ibm_is_cluster_network_profiles", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("profiles", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting profiles %s", err), "(Data) ibm_is_cluster_network_profiles", "read", "profiles-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsClusterNetworkSubnetReservedIps() *schema.Resource {
 		ReadContext: dataSourceIBMIsClusterNetworkSubnetReservedIpsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"cluster_network_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -176,6 +177,13 @@ func dataSourceIBMIsClusterNetworkSubnetReservedIpsRead(context context.Context,
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_cluster_network_subnet_reserved_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listClusterNetworkSubnetReservedIpsOptions := &vpcv1.ListClusterNetworkSubnetReservedIpsOptions{}
 
 	listClusterNetworkSubnetReservedIpsOptions.SetClusterNetworkID(d.Get("cluster_network_id").(string))
@@ -213,6 +221,12 @@ func dataSourceIBMIsClusterNetworkSubnetReservedIpsRead(context context.Context,
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering reserved ips: %s", err), "(Data) ibm_is_cluster_network_subnet_reserved_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("reserved_ips", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reserved_ips %s", err), "(Data) ibm_is_cluster_network_subnet_reserved_ips", "read", "reserved_ips-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsClusterNetworkSubnets() *schema.Resource {
 		ReadContext: dataSourceIBMIsClusterNetworkSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"cluster_network_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -133,6 +134,13 @@ func dataSourceIBMIsClusterNetworkSubnetsRead(context context.Context, d *schema
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_cluster_network_subnets", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listClusterNetworkSubnetsOptions := &vpcv1.ListClusterNetworkSubnetsOptions{}
 
 	listClusterNetworkSubnetsOptions.SetClusterNetworkID(d.Get("cluster_network_id").(string))
@@ -169,6 +177,12 @@ func dataSourceIBMIsClusterNetworkSubnetsRead(context context.Context, d *schema
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering subnets: %s", err), "(Data) ibm_is_cluster_network_subnets", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("subnets", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting subnets %s", err), "(Data) ibm_is_cluster_network_subnets", "read", "subnets-set").GetDiag()
 	}
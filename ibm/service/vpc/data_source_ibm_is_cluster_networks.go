@@ -22,6 +22,7 @@ func DataSourceIBMIsClusterNetworks() *schema.Resource {
 		ReadContext: dataSourceIBMIsClusterNetworksRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -267,6 +268,13 @@ func dataSourceIBMIsClusterNetworksRead(context context.Context, d *schema.Resou
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_cluster_networks", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listClusterNetworksOptions := &vpcv1.ListClusterNetworksOptions{}
 
 	if _, ok := d.GetOk("resource_group_id"); ok {
@@ -314,6 +322,12 @@ func dataSourceIBMIsClusterNetworksRead(context context.Context, d *schema.Resou
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering cluster networks: %s", err), "(Data) ibm_is_cluster_networks", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("cluster_networks", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting cluster_networks %s", err), "(Data) ibm_is_cluster_networks", "read", "cluster_networks-set").GetDiag()
 	}
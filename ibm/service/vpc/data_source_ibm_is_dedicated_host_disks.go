@@ -22,6 +22,7 @@ func DataSourceIbmIsDedicatedHostDisks() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostDisksRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"dedicated_host": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -149,6 +150,13 @@ func dataSourceIbmIsDedicatedHostDisksRead(context context.Context, d *schema.Re
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_dedicated_host_disks", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listDedicatedHostDisksOptions := &vpcv1.ListDedicatedHostDisksOptions{}
 
 	listDedicatedHostDisksOptions.SetDedicatedHostID(d.Get("dedicated_host").(string))
@@ -163,7 +171,14 @@ func dataSourceIbmIsDedicatedHostDisksRead(context context.Context, d *schema.Re
 	d.SetId(dataSourceIbmIsDedicatedHostDisksID(d))
 
 	if dedicatedHostDiskCollection.Disks != nil {
-		err = d.Set("disks", dataSourceDedicatedHostDiskCollectionFlattenDisks(dedicatedHostDiskCollection.Disks))
+		disks := dataSourceDedicatedHostDiskCollectionFlattenDisks(dedicatedHostDiskCollection.Disks)
+		disks, err = filters.apply(context, meta, disks)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering disks: %s", err), "(Data) ibm_is_dedicated_host_disks", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("disks", disks)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("[ERROR] Error setting disks: %s", err.Error()), "ibm_is_dedicated_host_disks", "read", "set-disks").GetDiag()
 		}
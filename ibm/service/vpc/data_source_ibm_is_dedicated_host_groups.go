@@ -22,6 +22,7 @@ func DataSourceIbmIsDedicatedHostGroups() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -179,6 +180,13 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_dedicated_host_groups", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	listDedicatedHostGroupsOptions := &vpcv1.ListDedicatedHostGroupsOptions{}
 
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
@@ -188,6 +196,8 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 	if zoneintf, ok := d.GetOk("zone"); ok {
 		zoneName := zoneintf.(string)
 		listDedicatedHostGroupsOptions.ZoneName = &zoneName
+	} else if zoneName, ok := filters.pushdown(isFilterZone); ok {
+		listDedicatedHostGroupsOptions.ZoneName = &zoneName
 	}
 	if nameintf, ok := d.GetOk("name"); ok {
 		name := nameintf.(string)
@@ -216,7 +226,14 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 	if len(allrecs) > 0 {
 
 		d.SetId(dataSourceIbmIsDedicatedHostGroupsID(d))
-		err = d.Set("host_groups", dataSourceDedicatedHostGroupCollectionFlattenGroups(allrecs))
+		hostGroups := dataSourceDedicatedHostGroupCollectionFlattenGroups(allrecs)
+		hostGroups, err = filters.apply(context, meta, hostGroups)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering dedicated host groups: %s", err), "ibm_is_dedicated_host_groups", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("host_groups", hostGroups)
 		if err != nil {
 			err = fmt.Errorf("[ERROR] Error setting host_groups: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_dedicated_host_groups", "read", "set-host_groups").GetDiag()
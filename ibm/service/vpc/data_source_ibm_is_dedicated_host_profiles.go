@@ -22,6 +22,7 @@ func DataSourceIbmIsDedicatedHostProfiles() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"profiles": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -355,6 +356,13 @@ func dataSourceIbmIsDedicatedHostProfilesRead(context context.Context, d *schema
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_dedicated_host_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listDedicatedHostProfilesOptions := &vpcv1.ListDedicatedHostProfilesOptions{}
 
 	start := ""
@@ -380,7 +388,14 @@ func dataSourceIbmIsDedicatedHostProfilesRead(context context.Context, d *schema
 
 		d.SetId(dataSourceIbmIsDedicatedHostProfilesID(d))
 
-		err = d.Set("profiles", dataSourceDedicatedHostProfileCollectionFlattenProfiles(allrecs))
+		profiles := dataSourceDedicatedHostProfileCollectionFlattenProfiles(allrecs)
+		profiles, err = filters.apply(context, meta, profiles)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering profiles: %s", err), "(Data) ibm_is_dedicated_host_profiles", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("profiles", profiles)
 		if err != nil {
 			err = fmt.Errorf("[ERROR] Error setting profiles: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_dedicated_host_profiles", "read", "set-profiles").GetDiag()
@@ -22,6 +22,7 @@ func DataSourceIbmIsDedicatedHosts() *schema.Resource {
 		ReadContext: dataSourceIbmIsDedicatedHostsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"host_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -427,6 +428,13 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_dedicated_hosts", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listDedicatedHostsOptions := &vpcv1.ListDedicatedHostsOptions{}
 	if hostgroupintf, ok := d.GetOk("host_group"); ok {
 		hostgroupid := hostgroupintf.(string)
@@ -439,6 +447,8 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 	if zoneintf, ok := d.GetOk("zone"); ok {
 		zoneName := zoneintf.(string)
 		listDedicatedHostsOptions.ZoneName = &zoneName
+	} else if zoneName, ok := filters.pushdown(isFilterZone); ok {
+		listDedicatedHostsOptions.ZoneName = &zoneName
 	}
 	if nameintf, ok := d.GetOk("name"); ok {
 		name := nameintf.(string)
@@ -467,7 +477,14 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 
 		d.SetId(dataSourceIbmIsDedicatedHostsID(d))
 
-		err = d.Set("dedicated_hosts", dataSourceDedicatedHostCollectionFlattenDedicatedHosts(allrecs, meta))
+		dedicatedHosts := dataSourceDedicatedHostCollectionFlattenDedicatedHosts(allrecs, meta)
+		dedicatedHosts, err = filters.apply(context, meta, dedicatedHosts)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering dedicated hosts: %s", err), "ibm_is_dedicated_hosts", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("dedicated_hosts", dedicatedHosts)
 		if err != nil {
 			err = fmt.Errorf("[ERROR] Error setting dedicated_hosts %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_dedicated_hosts", "read", "set-dedicated_hosts").GetDiag()
@@ -36,6 +36,7 @@ func DataSourceIBMISEndpointGatewayTargets() *schema.Resource {
 		ReadContext: dataSourceIBMISEndpointGatewayTargetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isVPEResources: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -92,6 +93,14 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_endpoint_gateway_targets", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	region := bmxSess.Config.Region
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
@@ -197,6 +206,12 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 
 	}
 
+	resourceInfo, err = filters.apply(context, meta, resourceInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering resources: %s", err), "(Data) ibm_is_endpoint_gateway_targets", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isVPEResources, resourceInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting resources: %s", err), "(Data) ibm_is_endpoint_gateway_targets", "read", "set-resources").GetDiag()
 	}
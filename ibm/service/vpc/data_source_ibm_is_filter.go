@@ -0,0 +1,341 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isFilter       = "filter"
+	isFilterName   = "name"
+	isFilterValues = "values"
+
+	isFilterTag       = "tag"
+	isFilterAccessTag = "access_tag"
+	isFilterNameRegex = "name_regex"
+	isFilterStatus    = "status"
+	isFilterZone      = "zone"
+	isFilterCRNPrefix = "crn_prefix"
+
+	// isFilterSearchLimit is the size of the pages of Global Search results
+	// that tag filters are resolved with.
+	isFilterSearchLimit = 1000
+)
+
+// dataSourceIBMISFilterSchema returns the schema of the filter blocks shared
+// by the plural VPC data sources. An item is kept when it matches all the
+// filters, and it matches a filter when it matches any of its values.
+//
+// The plural data sources that do not have filter blocks are:
+//   - ibm_is_vpn_gateway_connection_local_cidrs and
+//     ibm_is_vpn_gateway_connection_peer_cidrs, whose items are CIDR strings
+//     rather than resources.
+//   - ibm_is_vpn_gateway_connection_tunnel_status, which samples the status
+//     of the tunnels of a single connection rather than listing resources.
+//   - ibm_is_vpc_import_blocks, which generates the configuration of all the
+//     resources of a VPC, and whose import blocks must not miss any of them.
+//   - ibm_is_security_group_rules, whose rules have none of the name, status,
+//     zone and CRN that the filters match on.
+func dataSourceIBMISFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Filters the collection to the items that match all the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				isFilterName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						isFilterTag, isFilterAccessTag, isFilterNameRegex, isFilterStatus, isFilterZone, isFilterCRNPrefix,
+					}, false),
+					Description: "The attribute to filter on: tag, access_tag, name_regex, status, zone or crn_prefix",
+				},
+				isFilterValues: {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: "The values to match, an item matches the filter when it matches any of them",
+				},
+			},
+		},
+	}
+}
+
+// isFilters are the filter blocks of a plural VPC data source.
+type isFilters struct {
+	tags       [][]string
+	accessTags [][]string
+	nameRegex  [][]*regexp.Regexp
+	status     [][]string
+	zone       [][]string
+	crnPrefix  [][]string
+}
+
+// expandIBMISFilters returns the filters configured in d. The name_regex
+// values are compiled so that invalid expressions are reported before any
+// API call.
+func expandIBMISFilters(d *schema.ResourceData) (*isFilters, error) {
+	filters := &isFilters{}
+	for _, filterIntf := range d.Get(isFilter).(*schema.Set).List() {
+		filter := filterIntf.(map[string]interface{})
+		values := flex.ExpandStringList(filter[isFilterValues].(*schema.Set).List())
+		switch filter[isFilterName].(string) {
+		case isFilterTag:
+			filters.tags = append(filters.tags, values)
+		case isFilterAccessTag:
+			filters.accessTags = append(filters.accessTags, values)
+		case isFilterNameRegex:
+			regexes := make([]*regexp.Regexp, 0, len(values))
+			for _, value := range values {
+				regex, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Invalid name_regex filter %q: %s", value, err)
+				}
+				regexes = append(regexes, regex)
+			}
+			filters.nameRegex = append(filters.nameRegex, regexes)
+		case isFilterStatus:
+			filters.status = append(filters.status, values)
+		case isFilterZone:
+			filters.zone = append(filters.zone, values)
+		case isFilterCRNPrefix:
+			filters.crnPrefix = append(filters.crnPrefix, values)
+		}
+	}
+	return filters, nil
+}
+
+// pushdown returns the value that the list API can filter on for a filter
+// that has a single value in a single block, such as the zone of the volumes.
+// The filter is still applied to the items that the API returns.
+func (f *isFilters) pushdown(name string) (string, bool) {
+	values, ok := f.pushdownValues(name)
+	if !ok || len(values) != 1 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// pushdownValues returns the values that the list API can filter on for a
+// filter that has a single block, for the APIs that accept any of several
+// values, such as the status of the images.
+func (f *isFilters) pushdownValues(name string) ([]string, bool) {
+	var values [][]string
+	switch name {
+	case isFilterStatus:
+		values = f.status
+	case isFilterZone:
+		values = f.zone
+	}
+	if len(values) != 1 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// pushdownTag returns the tag that the list API can filter on for a tag
+// filter that has a single value in a single block. The API matches the same
+// tags as Global Search, so the filter is left to it and is no longer
+// resolved by apply, which also spares the delay of the search index.
+func (f *isFilters) pushdownTag() (string, bool) {
+	if len(f.tags) != 1 || len(f.tags[0]) != 1 {
+		return "", false
+	}
+	tag := f.tags[0][0]
+	f.tags = nil
+	return tag, true
+}
+
+// apply returns the items that match the filters. The items are the
+// flattened elements of the list attribute of the data source, whose name,
+// status, zone and crn attributes are compared with the filters. Tag filters
+// are resolved to the CRNs of the tagged VPC resources with Global Search.
+func (f *isFilters) apply(context context.Context, meta interface{}, items []map[string]interface{}) ([]map[string]interface{}, error) {
+	var taggedCRNs []map[string]bool
+	for _, tags := range f.tags {
+		crns, err := searchIBMISTaggedCRNs(context, meta, "tags", tags)
+		if err != nil {
+			return nil, err
+		}
+		taggedCRNs = append(taggedCRNs, crns)
+	}
+	for _, tags := range f.accessTags {
+		crns, err := searchIBMISTaggedCRNs(context, meta, "access_tags", tags)
+		if err != nil {
+			return nil, err
+		}
+		taggedCRNs = append(taggedCRNs, crns)
+	}
+
+	filtered := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if f.match(item, taggedCRNs) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// applyList is apply for the data sources that flatten their items into a
+// []interface{}.
+func (f *isFilters) applyList(context context.Context, meta interface{}, list []interface{}) ([]interface{}, error) {
+	items := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		items = append(items, item.(map[string]interface{}))
+	}
+	items, err := f.apply(context, meta, items)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		filtered = append(filtered, item)
+	}
+	return filtered, nil
+}
+
+func (f *isFilters) match(item map[string]interface{}, taggedCRNs []map[string]bool) bool {
+	crn := isFilterString(item["crn"])
+	for _, crns := range taggedCRNs {
+		if !crns[crn] {
+			return false
+		}
+	}
+	for _, prefixes := range f.crnPrefix {
+		if !matchAny(prefixes, func(prefix string) bool { return strings.HasPrefix(crn, prefix) }) {
+			return false
+		}
+	}
+
+	name := isFilterString(item["name"])
+	for _, regexes := range f.nameRegex {
+		matched := false
+		for _, regex := range regexes {
+			if regex.MatchString(name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	status := isFilterItemStatus(item)
+	for _, statuses := range f.status {
+		if !matchAny(statuses, func(s string) bool { return s == status }) {
+			return false
+		}
+	}
+
+	zone := isFilterItemZone(item)
+	for _, zones := range f.zone {
+		if !matchAny(zones, func(z string) bool { return z == zone }) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(values []string, match func(string) bool) bool {
+	for _, value := range values {
+		if match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFilterItemStatus returns the status of an item, or its lifecycle state
+// for the resources that do not have a status.
+func isFilterItemStatus(item map[string]interface{}) string {
+	if status := isFilterString(item["status"]); status != "" {
+		return status
+	}
+	return isFilterString(item["lifecycle_state"])
+}
+
+// isFilterItemZone returns the name of the zone of an item, which is either
+// flattened as a string or as a list of one zone reference.
+func isFilterItemZone(item map[string]interface{}) string {
+	switch zone := item["zone"].(type) {
+	case []interface{}:
+		if len(zone) > 0 {
+			if ref, ok := zone[0].(map[string]interface{}); ok {
+				return isFilterString(ref["name"])
+			}
+		}
+	case []map[string]interface{}:
+		if len(zone) > 0 {
+			return isFilterString(zone[0]["name"])
+		}
+	default:
+		return isFilterString(zone)
+	}
+	return ""
+}
+
+// isFilterString returns the value of a flattened string attribute, which
+// some data sources set as a *string.
+func isFilterString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
+// searchIBMISTaggedCRNs returns the CRNs of the VPC resources of the account
+// that have any of the tags in the field of Global Search documents, which
+// is either tags or access_tags.
+func searchIBMISTaggedCRNs(context context.Context, meta interface{}, field string, tags []string) (map[string]bool, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	terms := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		terms = append(terms, fmt.Sprintf("%s:\"%s\"", field, strings.ReplaceAll(tag, "\"", "\\\"")))
+	}
+	options := &globalsearchv2.SearchOptions{}
+	options.SetQuery(fmt.Sprintf("family:is AND (%s)", strings.Join(terms, " OR ")))
+	options.SetFields([]string{"crn"})
+	options.SetLimit(isFilterSearchLimit)
+
+	crns := map[string]bool{}
+	for {
+		result, resp, err := gsClient.SearchWithContext(context, options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error searching the resources with the %s %s: %s\n%s", field, strings.Join(tags, ", "), err, resp)
+		}
+		for _, item := range result.Items {
+			if item.CRN != nil {
+				crns[*item.CRN] = true
+			}
+		}
+		if len(result.Items) < isFilterSearchLimit || result.SearchCursor == nil {
+			break
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+	return crns, nil
+}
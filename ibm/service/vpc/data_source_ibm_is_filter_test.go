@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testIBMISFilters(t *testing.T, filters ...map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	raw := make([]interface{}, 0, len(filters))
+	for _, filter := range filters {
+		raw = append(raw, filter)
+	}
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{isFilter: dataSourceIBMISFilterSchema()}, map[string]interface{}{isFilter: raw})
+}
+
+func testIBMISFilter(name string, values ...interface{}) map[string]interface{} {
+	return map[string]interface{}{isFilterName: name, isFilterValues: values}
+}
+
+func TestExpandIBMISFilters(t *testing.T) {
+	d := testIBMISFilters(t,
+		testIBMISFilter(isFilterTag, "env:prod"),
+		testIBMISFilter(isFilterAccessTag, "project:a"),
+		testIBMISFilter(isFilterNameRegex, "^web-"),
+		testIBMISFilter(isFilterStatus, "available", "pending"),
+		testIBMISFilter(isFilterZone, "us-south-1"),
+		testIBMISFilter(isFilterCRNPrefix, "crn:v1:bluemix:public:is:us-south"),
+	)
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(filters.tags, [][]string{{"env:prod"}}) || !reflect.DeepEqual(filters.accessTags, [][]string{{"project:a"}}) {
+		t.Errorf("unexpected tags %v and access tags %v", filters.tags, filters.accessTags)
+	}
+	if len(filters.nameRegex) != 1 || len(filters.nameRegex[0]) != 1 || filters.nameRegex[0][0].String() != "^web-" {
+		t.Errorf("unexpected name regexes %v", filters.nameRegex)
+	}
+	if len(filters.status) != 1 || len(filters.status[0]) != 2 {
+		t.Errorf("unexpected statuses %v", filters.status)
+	}
+	if !reflect.DeepEqual(filters.zone, [][]string{{"us-south-1"}}) || !reflect.DeepEqual(filters.crnPrefix, [][]string{{"crn:v1:bluemix:public:is:us-south"}}) {
+		t.Errorf("unexpected zones %v and CRN prefixes %v", filters.zone, filters.crnPrefix)
+	}
+}
+
+func TestExpandIBMISFiltersInvalidRegex(t *testing.T) {
+	if _, err := expandIBMISFilters(testIBMISFilters(t, testIBMISFilter(isFilterNameRegex, "web-("))); err == nil {
+		t.Error("expected an error for an invalid name_regex")
+	}
+}
+
+func TestIBMISFiltersPushdown(t *testing.T) {
+	filters, err := expandIBMISFilters(testIBMISFilters(t,
+		testIBMISFilter(isFilterZone, "us-south-1"),
+		testIBMISFilter(isFilterStatus, "available", "pending"),
+		testIBMISFilter(isFilterTag, "env:prod"),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if zone, ok := filters.pushdown(isFilterZone); !ok || zone != "us-south-1" {
+		t.Errorf("expected the zone to be pushed down, got %q", zone)
+	}
+	if _, ok := filters.pushdown(isFilterStatus); ok {
+		t.Error("expected a status filter with two values not to be pushed down as a single value")
+	}
+	if statuses, ok := filters.pushdownValues(isFilterStatus); !ok || len(statuses) != 2 {
+		t.Errorf("expected the statuses to be pushed down, got %v", statuses)
+	}
+	if _, ok := filters.pushdown(isFilterNameRegex); ok {
+		t.Error("expected name_regex not to be pushed down")
+	}
+
+	if tag, ok := filters.pushdownTag(); !ok || tag != "env:prod" {
+		t.Errorf("expected the tag to be pushed down, got %q", tag)
+	}
+	if len(filters.tags) != 0 {
+		t.Errorf("expected the pushed down tag to be left to the API, got %v", filters.tags)
+	}
+
+	filters, err = expandIBMISFilters(testIBMISFilters(t,
+		testIBMISFilter(isFilterZone, "us-south-1"),
+		testIBMISFilter(isFilterZone, "us-south-2"),
+		testIBMISFilter(isFilterTag, "env:prod", "env:dev"),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := filters.pushdown(isFilterZone); ok {
+		t.Error("expected two zone filters not to be pushed down")
+	}
+	if _, ok := filters.pushdownTag(); ok || len(filters.tags) != 1 {
+		t.Error("expected a tag filter with two values to be resolved with Global Search")
+	}
+}
+
+func TestIBMISFiltersMatch(t *testing.T) {
+	item := map[string]interface{}{
+		"name":   "web-1",
+		"status": "available",
+		"zone":   "us-south-1",
+		"crn":    "crn:v1:bluemix:public:is:us-south-1:a/account::instance:0717-1",
+	}
+	cases := []struct {
+		name       string
+		filters    []map[string]interface{}
+		taggedCRNs []map[string]bool
+		expected   bool
+	}{
+		{
+			name:     "no filter",
+			expected: true,
+		},
+		{
+			name:     "all filters match",
+			filters:  []map[string]interface{}{testIBMISFilter(isFilterNameRegex, "^web-"), testIBMISFilter(isFilterStatus, "available"), testIBMISFilter(isFilterZone, "us-south-1"), testIBMISFilter(isFilterCRNPrefix, "crn:v1:bluemix:public:is:us-south-1")},
+			expected: true,
+		},
+		{
+			name:     "any value of a filter",
+			filters:  []map[string]interface{}{testIBMISFilter(isFilterZone, "us-south-2", "us-south-1")},
+			expected: true,
+		},
+		{
+			name:     "one filter does not match",
+			filters:  []map[string]interface{}{testIBMISFilter(isFilterNameRegex, "^web-"), testIBMISFilter(isFilterStatus, "pending")},
+			expected: false,
+		},
+		{
+			name:     "two blocks of the same filter",
+			filters:  []map[string]interface{}{testIBMISFilter(isFilterZone, "us-south-1"), testIBMISFilter(isFilterZone, "us-south-2")},
+			expected: false,
+		},
+		{
+			name:     "crn prefix",
+			filters:  []map[string]interface{}{testIBMISFilter(isFilterCRNPrefix, "crn:v1:bluemix:public:is:eu-de")},
+			expected: false,
+		},
+		{
+			name:       "tagged",
+			taggedCRNs: []map[string]bool{{"crn:v1:bluemix:public:is:us-south-1:a/account::instance:0717-1": true}},
+			expected:   true,
+		},
+		{
+			name:       "not tagged",
+			taggedCRNs: []map[string]bool{{"crn:v1:bluemix:public:is:us-south-1:a/account::instance:0717-2": true}},
+			expected:   false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			filters, err := expandIBMISFilters(testIBMISFilters(t, c.filters...))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if matched := filters.match(item, c.taggedCRNs); matched != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, matched)
+			}
+		})
+	}
+}
+
+func TestIBMISFiltersMatchLifecycleState(t *testing.T) {
+	filters, err := expandIBMISFilters(testIBMISFilters(t, testIBMISFilter(isFilterStatus, "stable")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	name := "vpe-1"
+	if !filters.match(map[string]interface{}{"name": &name, "lifecycle_state": "stable"}, nil) {
+		t.Error("expected the status filter to match the lifecycle state of items without a status")
+	}
+}
+
+func TestIsFilterItemZone(t *testing.T) {
+	zone := "us-south-3"
+	cases := []struct {
+		name     string
+		zone     interface{}
+		expected string
+	}{
+		{"string", "us-south-1", "us-south-1"},
+		{"string pointer", &zone, "us-south-3"},
+		{"list of references", []interface{}{map[string]interface{}{"name": "us-south-2", "href": "https://us-south.iaas.cloud.ibm.com/v1/regions/us-south/zones/us-south-2"}}, "us-south-2"},
+		{"list of maps", []map[string]interface{}{{"name": "us-south-1"}}, "us-south-1"},
+		{"empty list", []interface{}{}, ""},
+		{"unset", nil, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := isFilterItemZone(map[string]interface{}{"zone": c.zone}); actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ func DataSourceIBMIsFloatingIps() *schema.Resource {
 		ReadContext: dataSourceIBMIsFloatingIpsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -225,6 +226,13 @@ func dataSourceIBMIsFloatingIpsRead(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_floating_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allFloatingIPs := []vpcv1.FloatingIP{}
 	floatingIPOptions := &vpcv1.ListFloatingIpsOptions{}
@@ -275,7 +283,14 @@ func dataSourceIBMIsFloatingIpsRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if matchFloatingIps != nil {
-		err = d.Set("floating_ips", dataSourceFloatingIPCollectionFlattenFloatingIps(matchFloatingIps, d, meta))
+		floatingIps := dataSourceFloatingIPCollectionFlattenFloatingIps(matchFloatingIps, d, meta)
+		floatingIps, err = filters.apply(ctx, meta, floatingIps)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering floating ips: %s", err), "(Data) ibm_is_floating_ips", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("floating_ips", floatingIps)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting floating_ips %s", err), "(Data) ibm_ibm_is_floating_ips", "read", "floating_ips-set").GetDiag()
 		}
@@ -25,6 +25,7 @@ func DataSourceIBMISFlowLogs() *schema.Resource {
 		ReadContext: dataSourceIBMISFlowLogsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -151,6 +152,13 @@ func dataSourceIBMISFlowLogsRead(context context.Context, d *schema.ResourceData
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_logs", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.FlowLogCollector{}
 	listOptions := &vpcv1.ListFlowLogCollectorsOptions{}
@@ -234,6 +242,12 @@ func dataSourceIBMISFlowLogsRead(context context.Context, d *schema.ResourceData
 		}
 		flowlogsInfo = append(flowlogsInfo, l)
 	}
+	flowlogsInfo, err = filters.apply(context, meta, flowlogsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering flow logs: %s", err), "(Data) ibm_is_flow_logs", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISFlowLogsID(d))
 	if err = d.Set(isFlowLogs, flowlogsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting flow_log_collectors %s", err), "(Data) ibm_ibm_is_flow_logs", "read", "flow_log_collectors-set").GetDiag()
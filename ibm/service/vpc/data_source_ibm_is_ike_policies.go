@@ -22,6 +22,7 @@ func DataSourceIBMIsIkePolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsIkePoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"ike_policies": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -165,6 +166,13 @@ func dataSourceIBMIsIkePoliciesRead(context context.Context, d *schema.ResourceD
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_ike_policies", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.IkePolicy{}
 	for {
@@ -187,7 +195,14 @@ func dataSourceIBMIsIkePoliciesRead(context context.Context, d *schema.ResourceD
 
 	d.SetId(dataSourceIBMIsIkePoliciesID(d))
 
-	err = d.Set("ike_policies", dataSourceIkePolicyCollectionFlattenIkePolicies(allrecs))
+	ikePolicies := dataSourceIkePolicyCollectionFlattenIkePolicies(allrecs)
+	ikePolicies, err = filters.apply(context, meta, ikePolicies)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering ike policies: %s", err), "(Data) ibm_is_ike_policies", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	err = d.Set("ike_policies", ikePolicies)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting ike_policies %s", err), "(Data) ibm_is_ike_policies", "read", "ike_policies-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsImageExports() *schema.Resource {
 		ReadContext: DataSourceIBMIsImageExportsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"image": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -160,6 +161,13 @@ func DataSourceIBMIsImageExportsRead(context context.Context, d *schema.Resource
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_image_export_jobs", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listImageExportJobsOptions := &vpcv1.ListImageExportJobsOptions{}
 
 	listImageExportJobsOptions.SetImageID(d.Get("image").(string))
@@ -183,6 +191,12 @@ func DataSourceIBMIsImageExportsRead(context context.Context, d *schema.Resource
 			exportJobs = append(exportJobs, modelMap)
 		}
 	}
+	exportJobs, err = filters.apply(context, meta, exportJobs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering export jobs: %s", err), "(Data) ibm_is_image_export_jobs", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("export_jobs", exportJobs); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting export_jobs: %s", err), "(Data) ibm_is_image_export_jobs", "read", "set-export_jobs").GetDiag()
 	}
@@ -27,6 +27,7 @@ func DataSourceIBMISImages() *schema.Resource {
 		ReadContext: dataSourceIBMISImagesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isImagesResourceGroupID: {
 				Type:        schema.TypeString,
 				Description: "The id of the resource group",
@@ -320,6 +321,13 @@ func imageList(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_images", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allrecs := []vpcv1.Image{}
 
@@ -357,6 +365,9 @@ func imageList(context context.Context, d *schema.ResourceData, meta interface{}
 	if visibility != "" {
 		listImagesOptions.SetVisibility(visibility)
 	}
+	if statuses, ok := filters.pushdownValues(isFilterStatus); ok && status == "" {
+		listImagesOptions.SetStatus(statuses)
+	}
 
 	if userDataFormat, ok := d.GetOk(isImageUserDataFormat); ok {
 		userDataFormats := userDataFormat.(*schema.Set)
@@ -462,6 +473,12 @@ func imageList(context context.Context, d *schema.ResourceData, meta interface{}
 		l[isImageAccessTags] = accesstags
 		imagesInfo = append(imagesInfo, l)
 	}
+	imagesInfo, err = filters.apply(context, meta, imagesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering images: %s", err), "(Data) ibm_is_images", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISImagesID(d))
 	if err = d.Set("images", imagesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting images %s", err), "(Data) ibm_is_images", "read", "images-set").GetDiag()
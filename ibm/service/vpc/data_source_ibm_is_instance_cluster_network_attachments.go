@@ -22,6 +22,7 @@ func DataSourceIBMIsInstanceClusterNetworkAttachments() *schema.Resource {
 		ReadContext: dataSourceIBMIsInstanceClusterNetworkAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"instance_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -259,6 +260,13 @@ func dataSourceIBMIsInstanceClusterNetworkAttachmentsRead(context context.Contex
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_cluster_network_attachments", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listInstanceClusterNetworkAttachmentsOptions := &vpcv1.ListInstanceClusterNetworkAttachmentsOptions{}
 
 	listInstanceClusterNetworkAttachmentsOptions.SetInstanceID(d.Get("instance_id").(string))
@@ -289,6 +297,12 @@ func dataSourceIBMIsInstanceClusterNetworkAttachmentsRead(context context.Contex
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering cluster network attachments: %s", err), "(Data) ibm_is_instance_cluster_network_attachments", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("cluster_network_attachments", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting cluster_network_attachments %s", err), "(Data) ibm_is_instance_cluster_network_attachments", "read", "cluster_network_attachments-set").GetDiag()
 	}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isInstanceConsoleLogInstance    = "instance"
+	isInstanceConsoleLogMarker      = "marker"
+	isInstanceConsoleLogTimeout     = "timeout"
+	isInstanceConsoleLogIdleTimeout = "idle_timeout"
+	isInstanceConsoleLogMaxLines    = "max_lines"
+	isInstanceConsoleLogLog         = "log"
+	isInstanceConsoleLogMarkerFound = "marker_found"
+	isInstanceConsoleLogStatus      = "status"
+)
+
+// consoleEscapeSequences matches the terminal control sequences that are
+// removed from the serial console output.
+var consoleEscapeSequences = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]|\x1b[()][A-Z0-9]|\r`)
+
+func DataSourceIBMISInstanceConsoleLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISInstanceConsoleLogRead,
+
+		Schema: map[string]*schema.Schema{
+			isInstanceConsoleLogInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the virtual server instance",
+			},
+			isInstanceConsoleLogMarker: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regular expression that stops the read of the serial console when a line of the output matches it",
+			},
+			isInstanceConsoleLogTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 3600),
+				Description:  "The maximum time in seconds to read the serial console for, or to wait for the marker",
+			},
+			isInstanceConsoleLogIdleTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 3600),
+				Description:  "The time in seconds after which the read stops when the serial console prints nothing",
+			},
+			isInstanceConsoleLogMaxLines: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      200,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of lines of the serial console output to keep, the last lines are kept",
+			},
+			isInstanceConsoleLogLog: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial console output that the instance printed while the data source was connected to it",
+			},
+			isInstanceConsoleLogMarkerFound: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether a line of the output matched the marker",
+			},
+			isInstanceConsoleLogStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the virtual server instance",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISInstanceConsoleLogRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_console_log", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	instanceID := d.Get(isInstanceConsoleLogInstance).(string)
+	instance, _, err := sess.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &instanceID})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetInstanceWithContext failed: %s", err.Error()), "(Data) ibm_is_instance_console_log", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// The serial console of an instance that is not running cannot be
+	// connected to, and it has no history to return.
+	output := instanceSerialConsoleOutput{}
+	if instance.Status != nil && *instance.Status == "running" {
+		var marker *regexp.Regexp
+		if v, ok := d.GetOk(isInstanceConsoleLogMarker); ok {
+			marker = regexp.MustCompile(v.(string))
+		}
+		timeout := time.Duration(d.Get(isInstanceConsoleLogTimeout).(int)) * time.Second
+		idleTimeout := time.Duration(d.Get(isInstanceConsoleLogIdleTimeout).(int)) * time.Second
+		output, err = readInstanceSerialConsole(context, sess, instanceID, marker, timeout, idleTimeout, d.Get(isInstanceConsoleLogMaxLines).(int))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("readInstanceSerialConsole failed: %s", err.Error()), "(Data) ibm_is_instance_console_log", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	d.SetId(instanceID)
+	if err = d.Set(isInstanceConsoleLogLog, output.log); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting log: %s", err), "(Data) ibm_is_instance_console_log", "read", "set-log").GetDiag()
+	}
+	if err = d.Set(isInstanceConsoleLogMarkerFound, output.markerFound); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting marker_found: %s", err), "(Data) ibm_is_instance_console_log", "read", "set-marker_found").GetDiag()
+	}
+	if err = d.Set(isInstanceConsoleLogStatus, instance.Status); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting status: %s", err), "(Data) ibm_is_instance_console_log", "read", "set-status").GetDiag()
+	}
+	return nil
+}
+
+// instanceSerialConsoleOutput is the output read from the serial console of
+// an instance.
+type instanceSerialConsoleOutput struct {
+	// log holds the last lines of the output, without the terminal control
+	// sequences.
+	log string
+	// markerFound indicates whether a line of the output matched the marker.
+	markerFound bool
+	// received indicates whether the console printed anything at all while
+	// it was connected.
+	received bool
+	// idle indicates whether the read stopped because the console printed
+	// nothing for the idle timeout.
+	idle bool
+}
+
+// readInstanceSerialConsole connects to the serial console of a running
+// instance and reads its output until a line matches marker, until the
+// console prints nothing for idleTimeout, or until the timeout expires. The
+// console keeps no history: it only streams the output printed while it is
+// connected, of which the last maxLines lines are returned.
+func readInstanceSerialConsole(ctx context.Context, sess *vpcv1.VpcV1, instanceID string, marker *regexp.Regexp, timeout, idleTimeout time.Duration, maxLines int) (instanceSerialConsoleOutput, error) {
+	output := instanceSerialConsoleOutput{}
+	tokenOptions := &vpcv1.CreateInstanceConsoleAccessTokenOptions{
+		InstanceID:  &instanceID,
+		ConsoleType: core.StringPtr("serial"),
+	}
+	token, response, err := sess.CreateInstanceConsoleAccessTokenWithContext(ctx, tokenOptions)
+	if err != nil {
+		return output, fmt.Errorf("[ERROR] Error creating console access token of instance %s: %s\n%s", instanceID, err, response)
+	}
+	consoleURL, err := url.Parse(*token.Href)
+	if err != nil {
+		return output, fmt.Errorf("[ERROR] Error parsing console URL of instance %s: %s", instanceID, err)
+	}
+	if query := consoleURL.Query(); query.Get("access_token") == "" {
+		query.Set("access_token", *token.AccessToken)
+		consoleURL.RawQuery = query.Encode()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, consoleURL.String(), nil)
+	if err != nil {
+		return output, fmt.Errorf("[ERROR] Error connecting to the serial console of instance %s: %s", instanceID, err)
+	}
+	defer conn.Close()
+	go func() {
+		// Unblocks the read below when the context is canceled
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	lines := []string{}
+	partial := ""
+	for !output.markerFound {
+		conn.SetReadDeadline(time.Now().Add(idleTimeout))
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			var netErr net.Error
+			if ctx.Err() == nil && errors.As(err, &netErr) && netErr.Timeout() {
+				output.idle = true
+				break
+			}
+			if ctx.Err() != nil || websocket.IsCloseError(err, websocket.CloseNormalClosure) || errors.Is(err, websocket.ErrCloseSent) {
+				break
+			}
+			return output, fmt.Errorf("[ERROR] Error reading the serial console of instance %s: %s", instanceID, err)
+		}
+		output.received = true
+		text := partial + consoleEscapeSequences.ReplaceAllString(string(data), "")
+		complete := strings.Split(text, "\n")
+		partial = complete[len(complete)-1]
+		for _, line := range complete[:len(complete)-1] {
+			lines = append(lines, line)
+			if marker != nil && marker.MatchString(line) {
+				output.markerFound = true
+			}
+		}
+		if len(lines) > maxLines {
+			lines = lines[len(lines)-maxLines:]
+		}
+	}
+	if partial != "" {
+		lines = append(lines, partial)
+		if marker != nil && !output.markerFound && marker.MatchString(partial) {
+			output.markerFound = true
+		}
+	}
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	output.log = strings.Join(lines, "\n")
+	return output, nil
+}
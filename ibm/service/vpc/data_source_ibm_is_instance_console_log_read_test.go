@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// testSerialConsoleClient returns a vpc client whose instance serial console
+// sends the given messages, and then stays connected without printing
+// anything more.
+func testSerialConsoleClient(t *testing.T, messages ...string) *vpcv1.VpcV1 {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/instances/instance-id/console_access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "token", "console_type": "serial", "created_at": "2024-01-01T00:00:00Z", "expires_at": "2024-01-01T00:01:00Z", "force": false, "href": "ws` + strings.TrimPrefix(server.URL, "http") + `/console"}`))
+	})
+	mux.HandleFunc("/console", func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("access_token"); token != "token" {
+			t.Errorf("expected the access token in the console URL, got %q", token)
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		defer conn.Close()
+		for _, message := range messages {
+			if err := conn.WriteMessage(websocket.BinaryMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+		// Returns once the client disconnects
+		conn.ReadMessage()
+	})
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return sess
+}
+
+func TestReadInstanceSerialConsole(t *testing.T) {
+	cases := []struct {
+		name     string
+		messages []string
+		marker   string
+		maxLines int
+		expected instanceSerialConsoleOutput
+	}{
+		{
+			name:     "marker",
+			messages: []string{"\x1b[0;32mStarting\x1b[0m cloud-init\r\n", "Cloud-init v. 24.1 fini", "shed at Mon\r\n", "login: "},
+			marker:   `Cloud-init v\. .* finished`,
+			maxLines: 10,
+			expected: instanceSerialConsoleOutput{log: "Starting cloud-init\nCloud-init v. 24.1 finished at Mon", markerFound: true, received: true},
+		},
+		{
+			name:     "marker in the last partial line",
+			messages: []string{"Ubuntu 24.04 tty1\r\n", "instance login: "},
+			marker:   `login:`,
+			maxLines: 10,
+			expected: instanceSerialConsoleOutput{log: "Ubuntu 24.04 tty1\ninstance login: ", markerFound: true, received: true, idle: true},
+		},
+		{
+			name:     "last lines",
+			messages: []string{"one\ntwo\nthree\n", "four\n"},
+			maxLines: 2,
+			expected: instanceSerialConsoleOutput{log: "three\nfour", received: true, idle: true},
+		},
+		{
+			name:     "silent console",
+			marker:   `login:`,
+			maxLines: 10,
+			expected: instanceSerialConsoleOutput{idle: true},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var marker *regexp.Regexp
+			if c.marker != "" {
+				marker = regexp.MustCompile(c.marker)
+			}
+			start := time.Now()
+			output, err := readInstanceSerialConsole(context.Background(), testSerialConsoleClient(t, c.messages...), "instance-id", marker, time.Minute, 200*time.Millisecond, c.maxLines)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != c.expected {
+				t.Errorf("expected %+v, got %+v", c.expected, output)
+			}
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Errorf("expected the read to stop when the console is idle, it took %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestReadInstanceSerialConsoleTimeout(t *testing.T) {
+	output, err := readInstanceSerialConsole(context.Background(), testSerialConsoleClient(t, "booting\n"), "instance-id", regexp.MustCompile(`login:`), 300*time.Millisecond, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := instanceSerialConsoleOutput{log: "booting", received: true}
+	if output != expected {
+		t.Errorf("expected %+v, got %+v", expected, output)
+	}
+}
+
+func TestInstanceCloudInitDiagnostics(t *testing.T) {
+	marker := regexp.MustCompile(`Cloud-init v\. .* finished`)
+	cases := []struct {
+		name     string
+		output   instanceSerialConsoleOutput
+		diags    int
+		severity diag.Severity
+		detail   string
+	}{
+		{
+			name:   "marker found",
+			output: instanceSerialConsoleOutput{log: "Cloud-init v. 24.1 finished", markerFound: true, received: true},
+		},
+		{
+			name:     "boot finished before the console connected",
+			output:   instanceSerialConsoleOutput{idle: true},
+			diags:    1,
+			severity: diag.Warning,
+		},
+		{
+			name:     "console went idle",
+			output:   instanceSerialConsoleOutput{log: "Running apt-get", received: true, idle: true},
+			diags:    1,
+			severity: diag.Error,
+			detail:   "Running apt-get",
+		},
+		{
+			name:     "timeout",
+			output:   instanceSerialConsoleOutput{log: "Running apt-get", received: true},
+			diags:    1,
+			severity: diag.Error,
+			detail:   "Running apt-get",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := instanceCloudInitDiagnostics("instance-id", marker, time.Minute, c.output)
+			if len(diags) != c.diags {
+				t.Fatalf("expected %d diagnostics, got %v", c.diags, diags)
+			}
+			if c.diags == 0 {
+				return
+			}
+			if diags[0].Severity != c.severity {
+				t.Errorf("expected severity %v, got %v", c.severity, diags[0].Severity)
+			}
+			if !strings.Contains(diags[0].Summary+diags[0].Detail, c.detail) {
+				t.Errorf("expected the diagnostic to contain %q, got %v", c.detail, diags[0])
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ func DataSourceIbmIsInstanceDisks() *schema.Resource {
 		ReadContext: dataSourceIbmIsInstanceDisksRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"instance": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -82,6 +83,14 @@ func dataSourceIbmIsInstanceDisksRead(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_disks", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listInstanceDisksOptions := &vpcv1.ListInstanceDisksOptions{}
 
 	listInstanceDisksOptions.SetInstanceID(d.Get("instance").(string))
@@ -96,7 +105,14 @@ func dataSourceIbmIsInstanceDisksRead(context context.Context, d *schema.Resourc
 	d.SetId(dataSourceIbmIsInstanceDisksID(d))
 
 	if instanceDiskCollection.Disks != nil {
-		err = d.Set(isInstanceDisks, dataSourceInstanceDiskCollectionFlattenDisks(instanceDiskCollection.Disks))
+		disks := dataSourceInstanceDiskCollectionFlattenDisks(instanceDiskCollection.Disks)
+		disks, err = filters.apply(context, meta, disks)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering disks: %s", err), "(Data) ibm_is_instance_disks", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set(isInstanceDisks, disks)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting disks: %s", err), "(Data) ibm_is_instance_disks", "read", "set-disks").GetDiag()
 		}
@@ -20,6 +20,7 @@ func DataSourceIBMISInstanceGroupManagerActions() *schema.Resource {
 		ReadContext: dataSourceIBMISInstanceGroupManagerActionsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			"instance_group": {
 				Type:        schema.TypeString,
@@ -159,6 +160,13 @@ func dataSourceIBMISInstanceGroupManagerActionsRead(context context.Context, d *
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_group_manager_actions", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
 	instanceGroupID := d.Get("instance_group").(string)
 
@@ -233,6 +241,12 @@ func dataSourceIBMISInstanceGroupManagerActionsRead(context context.Context, d *
 		}
 		actions = append(actions, action)
 	}
+	actions, err = filters.apply(context, meta, actions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering instance group manager actions: %s", err), "(Data) ibm_is_instance_group_manager_actions", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("instance_group_manager_actions", actions); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting actions %s", err), "(Data) ibm_is_instance_group_manager_actions", "read", "actions-set").GetDiag()
 	}
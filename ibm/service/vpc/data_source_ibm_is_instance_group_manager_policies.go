@@ -20,6 +20,7 @@ func DataSourceIBMISInstanceGroupManagerPolicies() *schema.Resource {
 		ReadContext: dataSourceIBMISInstanceGroupManagerPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			"instance_group": {
 				Type:        schema.TypeString,
@@ -89,6 +90,13 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(context context.Context, d
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_group_manager_policies", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
 	instanceGroupID := d.Get("instance_group").(string)
 
@@ -130,6 +138,12 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(context context.Context, d
 		}
 		policies = append(policies, policy)
 	}
+	policies, err = filters.apply(context, meta, policies)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering instance group manager policies: %s", err), "(Data) ibm_is_instance_group_manager_policies", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("instance_group_manager_policies", policies); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting instance_group_manager_policies %s", err), "(Data) ibm_is_instance_group_manager_policies", "read", "instance_group_manager_policies-set").GetDiag()
 	}
@@ -20,6 +20,7 @@ func DataSourceIBMISInstanceGroupManagers() *schema.Resource {
 		ReadContext: dataSourceIBMISInstanceGroupManagersRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			"instance_group": {
 				Type:        schema.TypeString,
@@ -124,6 +125,13 @@ func dataSourceIBMISInstanceGroupManagersRead(context context.Context, d *schema
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_group_managers", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	instanceGroupID := d.Get("instance_group").(string)
 
 	// Support for pagination
@@ -202,6 +210,12 @@ func dataSourceIBMISInstanceGroupManagersRead(context context.Context, d *schema
 		}
 
 	}
+	instanceGroupMnagers, err = filters.apply(context, meta, instanceGroupMnagers)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering instance group managers: %s", err), "(Data) ibm_is_instance_group_managers", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("instance_group_managers", instanceGroupMnagers); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting instance_group_managers %s", err), "(Data) ibm_is_instance_group_managers", "read", "instance_group_managers-set").GetDiag()
 	}
@@ -25,6 +25,7 @@ func DataSourceIBMISInstanceGroupMemberships() *schema.Resource {
 		ReadContext: dataSourceIBMISInstanceGroupMembershipsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isInstanceGroup: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -122,6 +123,14 @@ func dataSourceIBMISInstanceGroupMembershipsRead(context context.Context, d *sch
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_group_memberships", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	instanceGroupID := d.Get(isInstanceGroup).(string)
 	// Support for pagination
 	start := ""
@@ -187,6 +196,12 @@ func dataSourceIBMISInstanceGroupMembershipsRead(context context.Context, d *sch
 
 		memberships = append(memberships, membership)
 	}
+	memberships, err = filters.apply(context, meta, memberships)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering memberships: %s", err), "(Data) ibm_is_instance_group_memberships", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("memberships", memberships); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting memberships %s", err), "(Data) ibm_is_instance_group_memberships", "read", "memberships-set").GetDiag()
 	}
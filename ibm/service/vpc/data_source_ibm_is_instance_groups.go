@@ -22,6 +22,7 @@ func DataSourceIBMISInstanceGroups() *schema.Resource {
 		ReadContext: DataSourceIBMIsInstanceGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"instance_groups": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -333,6 +334,13 @@ func DataSourceIBMIsInstanceGroupsRead(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_groups", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.InstanceGroup{}
 	listInstanceGroupsOptions := &vpcv1.ListInstanceGroupsOptions{}
@@ -366,6 +374,12 @@ func DataSourceIBMIsInstanceGroupsRead(context context.Context, d *schema.Resour
 		}
 		instanceGroups = append(instanceGroups, instanceGroup)
 	}
+	instanceGroups, err = filters.apply(context, meta, instanceGroups)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering instance groups: %s", err), "(Data) ibm_is_instance_groups", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("instance_groups", instanceGroups); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting instance_groups %s", err), "(Data) ibm_is_instance_groups", "read", "instance_groups-set").GetDiag()
 	}
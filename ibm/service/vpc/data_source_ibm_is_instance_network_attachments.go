@@ -21,6 +21,7 @@ func DataSourceIBMIsInstanceNetworkAttachments() *schema.Resource {
 		ReadContext: dataSourceIBMIsInstanceNetworkAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"instance": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -217,6 +218,13 @@ func dataSourceIBMIsInstanceNetworkAttachmentsRead(context context.Context, d *s
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_network_attachments", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listInstanceNetworkAttachmentsOptions := &vpcv1.ListInstanceNetworkAttachmentsOptions{}
 
 	listInstanceNetworkAttachmentsOptions.SetInstanceID(d.Get("instance").(string))
@@ -240,6 +248,12 @@ func dataSourceIBMIsInstanceNetworkAttachmentsRead(context context.Context, d *s
 			networkAttachments = append(networkAttachments, modelMap)
 		}
 	}
+	networkAttachments, err = filters.apply(context, meta, networkAttachments)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering network attachments: %s", err), "(Data) ibm_is_instance_network_attachments", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("network_attachments", networkAttachments); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting network_attachments: %s", err), "(Data) ibm_is_instance_network_attachments", "read", "set-network_attachments").GetDiag()
 	}
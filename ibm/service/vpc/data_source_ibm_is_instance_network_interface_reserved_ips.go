@@ -29,6 +29,7 @@ func DataSourceIBMISInstanceNICReservedIPs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISInstanceNICReservedIPsRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			/*
 				Request Parameters
 				==================
@@ -122,6 +123,13 @@ func dataSourceIBMISInstanceNICReservedIPsRead(context context.Context, d *schem
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_network_interface_reserved_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	instanceID := d.Get(isInstanceID).(string)
 	nicID := d.Get(isInstanceNICID).(string)
 
@@ -169,6 +177,12 @@ func dataSourceIBMISInstanceNICReservedIPsRead(context context.Context, d *schem
 	}
 
 	d.SetId(time.Now().UTC().String()) // This is not any reserved ip or instance id but state id
+	reservedIPs, err = filters.apply(context, meta, reservedIPs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering reserved ips: %s", err), "(Data) ibm_is_instance_network_interface_reserved_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isInstanceNICReservedIPs, reservedIPs); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reserved_ips: %s", err), "(Data) ibm_is_instance_network_interface_reserved_ips", "read", "set-reserved_ips").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsInstanceNetworkInterfaces() *schema.Resource {
 		ReadContext: dataSourceIBMIsInstanceNetworkInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"instance_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -271,6 +272,13 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_network_interfaces", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	instance_name := d.Get("instance_name").(string)
 	listInstancesOptions := &vpcv1.ListInstancesOptions{}
 
@@ -313,7 +321,14 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 			d.SetId(ins_id)
 
 			if networkInterfaceCollection.NetworkInterfaces != nil {
-				err = d.Set("network_interfaces", dataSourceNetworkInterfaceCollectionFlattenNetworkInterfaces(networkInterfaceCollection.NetworkInterfaces))
+				networkInterfaces := dataSourceNetworkInterfaceCollectionFlattenNetworkInterfaces(networkInterfaceCollection.NetworkInterfaces)
+				networkInterfaces, err = filters.apply(context, meta, networkInterfaces)
+				if err != nil {
+					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering network interfaces: %s", err), "(Data) ibm_is_instance_network_interfaces", "read")
+					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+					return tfErr.GetDiag()
+				}
+				err = d.Set("network_interfaces", networkInterfaces)
 				if err != nil {
 					return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting network_interfaces: %s", err), "(Data) ibm_is_instance_network_interfaces", "read", "set-network_interfaces").GetDiag()
 				}
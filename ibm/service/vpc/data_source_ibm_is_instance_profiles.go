@@ -24,6 +24,7 @@ func DataSourceIBMISInstanceProfiles() *schema.Resource {
 		ReadContext: dataSourceIBMISInstanceProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			isInstanceProfiles: {
 				Type:        schema.TypeList,
@@ -799,6 +800,14 @@ func instanceProfilesList(context context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listInstanceProfilesOptions := &vpcv1.ListInstanceProfilesOptions{}
 	availableProfiles, _, err := sess.ListInstanceProfilesWithContext(context, listInstanceProfilesOptions)
 	if err != nil {
@@ -963,6 +972,12 @@ func instanceProfilesList(context context.Context, d *schema.ResourceData, meta
 		profilesInfo = append(profilesInfo, l)
 	}
 	d.SetId(dataSourceIBMISInstanceProfilesID(d))
+	profilesInfo, err = filters.apply(context, meta, profilesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering profiles: %s", err), "(Data) ibm_is_instance_profiles", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("profiles", profilesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting profiles: %s", err), "(Data) ibm_is_instance_profiles", "read", "set-profiles").GetDiag()
 	}
@@ -91,6 +91,7 @@ func DataSourceIBMISInstanceTemplates() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISInstanceTemplatesRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isInstanceTemplates: {
 				Type:        schema.TypeList,
 				Description: "Collection of instance templates",
@@ -971,6 +972,13 @@ func dataSourceIBMISInstanceTemplatesRead(context context.Context, d *schema.Res
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_templates", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	listInstanceTemplatesOptions := &vpcv1.ListInstanceTemplatesOptions{}
 	availableTemplates, _, err := instanceC.ListInstanceTemplatesWithContext(context, listInstanceTemplatesOptions)
 	if err != nil {
@@ -1337,6 +1345,12 @@ func dataSourceIBMISInstanceTemplatesRead(context context.Context, d *schema.Res
 
 		templates = append(templates, template)
 	}
+	templates, err = filters.apply(context, meta, templates)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering instance templates: %s", err), "(Data) ibm_is_instance_templates", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISInstanceTemplatesID(d))
 	if err = d.Set("templates", templates); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting templates: %s", err), "(Data) ibm_is_instance_templates", "read", "set-templates").GetDiag()
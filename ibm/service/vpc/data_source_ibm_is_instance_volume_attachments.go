@@ -20,6 +20,7 @@ func DataSourceIBMISInstanceVolumeAttachments() *schema.Resource {
 		ReadContext: dataSourceIBMISInstanceVolumeAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isInstanceId: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -135,6 +136,14 @@ func instanceGetVolumeAttachments(context context.Context, d *schema.ResourceDat
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instance_volume_attachments", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	allrecs := []vpcv1.VolumeAttachment{}
 	listInstanceVolumeAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 		InstanceID: &instanceId,
@@ -172,6 +181,12 @@ func instanceGetVolumeAttachments(context context.Context, d *schema.ResourceDat
 		volAttList = append(volAttList, currentVolAtt)
 	}
 	d.SetId(dataSourceIBMISInstanceVolumeAttachmentsID(d))
+	volAttList, err = filters.apply(context, meta, volAttList)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering volume attachments: %s", err), "(Data) ibm_is_instance_volume_attachments", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("volume_attachments", volAttList); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting volume_attachments: %s", err), "(Data) ibm_is_instance_volume_attachments", "read", "set-volume_attachments").GetDiag()
 	}
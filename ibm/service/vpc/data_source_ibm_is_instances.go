@@ -25,6 +25,7 @@ func DataSourceIBMISInstances() *schema.Resource {
 		ReadContext: dataSourceIBMISInstancesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isInstanceGroup: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -1292,6 +1293,13 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_instances", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	var vpcName, vpcID, vpcCrn, resourceGroup, insGrp, dHostNameStr, dHostIdStr, placementGrpNameStr, placementGrpIdStr string
 
 	if vpc, ok := d.GetOk("vpc_name"); ok {
@@ -1839,6 +1847,12 @@ func instancesList(context context.Context, d *schema.ResourceData, meta interfa
 
 		instancesInfo = append(instancesInfo, l)
 	}
+	instancesInfo, err = filters.apply(context, meta, instancesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering instances: %s", err), "(Data) ibm_is_instances", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISInstancesID(d))
 	if err = d.Set("instances", instancesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting instances %s", err), "(Data) ibm_is_instances", "read", "instances-set").GetDiag()
@@ -22,6 +22,7 @@ func DataSourceIBMIsIpsecPolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsIpsecPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"ipsec_policies": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -165,6 +166,13 @@ func dataSourceIBMIsIpsecPoliciesRead(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_ipsec_policies", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.IPsecPolicy{}
 	for {
@@ -187,7 +195,14 @@ func dataSourceIBMIsIpsecPoliciesRead(context context.Context, d *schema.Resourc
 
 	d.SetId(dataSourceIBMIsIpsecPoliciesID(d))
 
-	err = d.Set("ipsec_policies", dataSourceIPsecPolicyCollectionFlattenIpsecPolicies(allrecs))
+	ipsecPolicies := dataSourceIPsecPolicyCollectionFlattenIpsecPolicies(allrecs)
+	ipsecPolicies, err = filters.apply(context, meta, ipsecPolicies)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering ipsec policies: %s", err), "(Data) ibm_is_ipsec_policies", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	err = d.Set("ipsec_policies", ipsecPolicies)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting ipsec_policies %s", err), "(Data) ibm_is_ipsec_policies", "read", "ipsec_policies-set").GetDiag()
 	}
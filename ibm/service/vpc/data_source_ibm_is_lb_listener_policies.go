@@ -21,6 +21,7 @@ func DataSourceIBMISLBListenerPolicies() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbListenerPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isLBListenerPolicyLBID: &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -205,6 +206,13 @@ func dataSourceIBMIsLbListenerPoliciesRead(context context.Context, d *schema.Re
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lb_listener_policies", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listLoadBalancerListenerPoliciesOptions := &vpcv1.ListLoadBalancerListenerPoliciesOptions{}
 
 	listLoadBalancerListenerPoliciesOptions.SetLoadBalancerID(d.Get(isLBListenerPolicyLBID).(string))
@@ -220,7 +228,14 @@ func dataSourceIBMIsLbListenerPoliciesRead(context context.Context, d *schema.Re
 	d.SetId(dataSourceIBMIsLbListenerPoliciesID(d))
 
 	if loadBalancerListenerPolicyCollection.Policies != nil {
-		err = d.Set("policies", dataSourceLoadBalancerListenerPolicyCollectionFlattenPolicies(loadBalancerListenerPolicyCollection.Policies))
+		policies := dataSourceLoadBalancerListenerPolicyCollectionFlattenPolicies(loadBalancerListenerPolicyCollection.Policies)
+		policies, err = filters.apply(context, meta, policies)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering policies: %s", err), "(Data) ibm_is_lb_listener_policies", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("policies", policies)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting policies: %s", err), "(Data) ibm_is_lb_listener_policies", "read", "set-policies").GetDiag()
 		}
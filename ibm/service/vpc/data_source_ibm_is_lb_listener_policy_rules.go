@@ -21,6 +21,7 @@ func DataSourceIBMISLBListenerPolicyRules() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbListenerPolicyRulesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isLBListenerPolicyRuleLBID: &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -97,6 +98,13 @@ func dataSourceIBMIsLbListenerPolicyRulesRead(context context.Context, d *schema
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lb_listener_policy_rules", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listLoadBalancerListenerPolicyRulesOptions := &vpcv1.ListLoadBalancerListenerPolicyRulesOptions{}
 	listLoadBalancerListenerPolicyRulesOptions.SetLoadBalancerID(d.Get(isLBListenerPolicyRuleLBID).(string))
 	listLoadBalancerListenerPolicyRulesOptions.SetListenerID(d.Get(isLBListenerPolicyRuleListenerID).(string))
@@ -112,7 +120,14 @@ func dataSourceIBMIsLbListenerPolicyRulesRead(context context.Context, d *schema
 	d.SetId(dataSourceIBMIsLbListenerPolicyRulesID(d))
 
 	if loadBalancerListenerPolicyRuleCollection.Rules != nil {
-		err = d.Set("rules", dataSourceLoadBalancerListenerPolicyRuleCollectionFlattenRules(loadBalancerListenerPolicyRuleCollection.Rules))
+		rules := dataSourceLoadBalancerListenerPolicyRuleCollectionFlattenRules(loadBalancerListenerPolicyRuleCollection.Rules)
+		rules, err = filters.apply(context, meta, rules)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering rules: %s", err), "(Data) ibm_is_lb_listener_policy_rules", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("rules", rules)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting rules: %s", err), "(Data) ibm_is_lb_listener_policy_rules", "read", "set-rules").GetDiag()
 		}
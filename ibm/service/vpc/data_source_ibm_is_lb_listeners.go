@@ -21,6 +21,7 @@ func DataSourceIBMISLBListeners() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbListenersRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isLBListenerLBID: &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -239,6 +240,13 @@ func dataSourceIBMIsLbListenersRead(context context.Context, d *schema.ResourceD
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lb_listeners", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listLoadBalancerListenersOptions := &vpcv1.ListLoadBalancerListenersOptions{}
 
 	listLoadBalancerListenersOptions.SetLoadBalancerID(d.Get(isLBListenerLBID).(string))
@@ -253,7 +261,14 @@ func dataSourceIBMIsLbListenersRead(context context.Context, d *schema.ResourceD
 	d.SetId(dataSourceIBMIsLbListenersID(d))
 
 	if loadBalancerListenerCollection.Listeners != nil {
-		err = d.Set("listeners", dataSourceLoadBalancerListenerCollectionFlattenListeners(loadBalancerListenerCollection.Listeners))
+		listeners := dataSourceLoadBalancerListenerCollectionFlattenListeners(loadBalancerListenerCollection.Listeners)
+		listeners, err = filters.apply(context, meta, listeners)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering listeners: %s", err), "(Data) ibm_is_lb_listeners", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("listeners", listeners)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting listeners: %s", err), "(Data) ibm_is_lb_listeners", "read", "set-listeners").GetDiag()
 		}
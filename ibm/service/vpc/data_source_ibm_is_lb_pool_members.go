@@ -21,6 +21,7 @@ func DataSourceIBMISLBPoolMembers() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbPoolMembersRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"lb": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -135,6 +136,13 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lb_pool_members", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
 
 	listLoadBalancerPoolMembersOptions.SetLoadBalancerID(d.Get("lb").(string))
@@ -150,7 +158,14 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 	d.SetId(dataSourceIBMIsLbPoolMembersID(d))
 
 	if loadBalancerPoolMemberCollection.Members != nil {
-		err = d.Set("members", dataSourceLoadBalancerPoolMemberCollectionFlattenMembers(loadBalancerPoolMemberCollection.Members))
+		members := dataSourceLoadBalancerPoolMemberCollectionFlattenMembers(loadBalancerPoolMemberCollection.Members)
+		members, err = filters.apply(context, meta, members)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering members: %s", err), "(Data) ibm_is_lb_pool_members", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("members", members)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting members: %s", err), "(Data) ibm_is_lb_pool_members", "read", "set-members").GetDiag()
 		}
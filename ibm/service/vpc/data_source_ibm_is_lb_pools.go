@@ -21,6 +21,7 @@ func DataSourceIBMISLBPools() *schema.Resource {
 		ReadContext: dataSourceIBMIsLbPoolsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"lb": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -277,6 +278,13 @@ func dataSourceIBMIsLbPoolsRead(context context.Context, d *schema.ResourceData,
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lb_pools", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listLoadBalancerPoolsOptions := &vpcv1.ListLoadBalancerPoolsOptions{}
 
 	listLoadBalancerPoolsOptions.SetLoadBalancerID(d.Get("lb").(string))
@@ -294,7 +302,14 @@ func dataSourceIBMIsLbPoolsRead(context context.Context, d *schema.ResourceData,
 	d.SetId(dataSourceIBMIsLbPoolsID(d))
 
 	if loadBalancerPoolCollection.Pools != nil {
-		err = d.Set("pools", dataSourceLoadBalancerPoolCollectionFlattenPools(loadBalancerPoolCollection.Pools))
+		pools := dataSourceLoadBalancerPoolCollectionFlattenPools(loadBalancerPoolCollection.Pools)
+		pools, err = filters.apply(context, meta, pools)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering pools: %s", err), "(Data) ibm_is_lb_pools", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("pools", pools)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting pools: %s", err), "(Data) ibm_is_lb_pools", "read", "set-pools").GetDiag()
 		}
@@ -26,6 +26,7 @@ func DataSourceIBMISLbProfiles() *schema.Resource {
 		ReadContext: dataSourceIBMISLbProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isLbsProfileName: &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -221,6 +222,13 @@ func dataSourceIBMISLbProfilesRead(context context.Context, d *schema.ResourceDa
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lb_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.LoadBalancerProfile{}
 	if lbprofilenameok, ok := d.GetOk(isLbsProfileName); ok {
@@ -384,6 +392,12 @@ func dataSourceIBMISLbProfilesRead(context context.Context, d *schema.ResourceDa
 		lbprofilesInfo = append(lbprofilesInfo, l)
 	}
 	d.SetId(dataSourceIBMISLbProfilesID(d))
+	lbprofilesInfo, err = filters.apply(context, meta, lbprofilesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering lb profiles: %s", err), "(Data) ibm_is_lb_profiles", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("lb_profiles", lbprofilesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting lb_profiles %s", err), "(Data) ibm_is_lb_profiles", "read", "lb_profiles-set").GetDiag()
 	}
@@ -28,6 +28,7 @@ func DataSourceIBMISLBS() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISLBSRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			loadBalancers: {
 				Type:        schema.TypeList,
 				Description: "Collection of load balancers",
@@ -360,6 +361,13 @@ func getLbs(context context.Context, d *schema.ResourceData, meta interface{}) d
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_lbs", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allrecs := []vpcv1.LoadBalancer{}
 	for {
@@ -557,6 +565,12 @@ func getLbs(context context.Context, d *schema.ResourceData, meta interface{}) d
 
 	}
 	//log.Printf("*******lbList %+v", lbList)
+	lbList, err = filters.apply(context, meta, lbList)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering lbs: %s", err), "(Data) ibm_is_lbs", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISLBsID(d))
 	if err = d.Set("load_balancers", lbList); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting load_balancers %s", err), "(Data) ibm_is_lbs", "read", "load_balancers-set").GetDiag()
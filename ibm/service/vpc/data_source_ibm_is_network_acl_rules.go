@@ -25,6 +25,7 @@ func DataSourceIBMISNetworkACLRules() *schema.Resource {
 		ReadContext: dataSourceIBMISNetworkACLRulesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"direction": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -193,6 +194,14 @@ func networkACLRulesList(context context.Context, d *schema.ResourceData, meta i
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_acl_rules", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
 	listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
@@ -313,6 +322,12 @@ func networkACLRulesList(context context.Context, d *schema.ResourceData, meta i
 		rulesInfo = append(rulesInfo, l)
 	}
 	d.SetId(dataSourceIBMISNetworkACLRulesId(d))
+	rulesInfo, err = filters.apply(context, meta, rulesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering rules: %s", err), "(Data) ibm_is_network_acl_rules", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("rules", rulesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting rules %s", err), "(Data) ibm_is_network_acl_rules", "read", "rules-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsNetworkAcls() *schema.Resource {
 		ReadContext: dataSourceIBMIsNetworkAclsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -365,6 +366,13 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_acls", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	resource_group_id := d.Get("resource_group").(string)
 	start := ""
 	allrecs := []vpcv1.NetworkACL{}
@@ -389,9 +397,16 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		}
 	}
 
+	networkAcls := dataSourceNetworkACLCollectionFlattenNetworkAcls(allrecs, d, meta)
+	networkAcls, err = filters.apply(context, meta, networkAcls)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering network acls: %s", err), "(Data) ibm_is_network_acls", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMIsNetworkAclsID(d))
 
-	err = d.Set("network_acls", dataSourceNetworkACLCollectionFlattenNetworkAcls(allrecs, d, meta))
+	err = d.Set("network_acls", networkAcls)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting network_acls %s", err), "(Data) ibm_is_network_acls", "read", "network_acls-set").GetDiag()
 	}
@@ -24,6 +24,7 @@ func DataSourceIBMISOperatingSystems() *schema.Resource {
 		ReadContext: dataSourceIBMISOperatingSystemsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isOperatingSystems: {
 				Type:        schema.TypeList,
 				Description: "List of operating systems",
@@ -105,6 +106,14 @@ func osList(context context.Context, d *schema.ResourceData, meta interface{}) d
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_operating_systems", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.OperatingSystem{}
 	for {
@@ -146,6 +155,12 @@ func osList(context context.Context, d *schema.ResourceData, meta interface{}) d
 		osInfo = append(osInfo, l)
 	}
 	d.SetId(dataSourceIBMISOperatingSystemsId(d))
+	osInfo, err = filters.apply(context, meta, osInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering operating systems: %s", err), "(Data) ibm_is_operating_systems", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("operating_systems", osInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting operating_systems %s", err), "(Data) ibm_is_operating_systems", "read", "operating_systems-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIbmIsPlacementGroups() *schema.Resource {
 		ReadContext: dataSourceIbmIsPlacementGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"placement_groups": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -126,6 +127,13 @@ func dataSourceIbmIsPlacementGroupsRead(context context.Context, d *schema.Resou
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_placement_groups", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listPlacementGroupsOptions := &vpcv1.ListPlacementGroupsOptions{}
 	start := ""
 	allrecs := []vpcv1.PlacementGroup{}
@@ -146,8 +154,15 @@ func dataSourceIbmIsPlacementGroupsRead(context context.Context, d *schema.Resou
 		}
 	}
 
+	placementGroups := dataSourcePlacementGroupCollectionFlattenPlacementGroups(meta, allrecs)
+	placementGroups, err = filters.apply(context, meta, placementGroups)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering placement groups: %s", err), "(Data) ibm_is_placement_groups", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIbmIsPlacementGroupsID(d))
-	err = d.Set("placement_groups", dataSourcePlacementGroupCollectionFlattenPlacementGroups(meta, allrecs))
+	err = d.Set("placement_groups", placementGroups)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting placement_groups %s", err), "(Data) ibm_is_placement_groups", "read", "placement_groups-set").GetDiag()
 	}
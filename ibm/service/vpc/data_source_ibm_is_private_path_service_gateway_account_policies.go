@@ -21,6 +21,7 @@ func DataSourceIBMIsPrivatePathServiceGatewayAccountPolicies() *schema.Resource
 		ReadContext: dataSourceIBMIsPrivatePathServiceGatewayAccountPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"private_path_service_gateway": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -100,6 +101,13 @@ func dataSourceIBMIsPrivatePathServiceGatewayAccountPoliciesRead(context context
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_private_path_service_gateway_account_policies", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	ppsgId := d.Get("private_path_service_gateway").(string)
 	listPrivatePathServiceGatewayAccountPoliciesOptions := &vpcv1.ListPrivatePathServiceGatewayAccountPoliciesOptions{}
 
@@ -134,6 +142,12 @@ func dataSourceIBMIsPrivatePathServiceGatewayAccountPoliciesRead(context context
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering account policies: %s", err), "(Data) ibm_is_private_path_service_gateway_account_policies", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("account_policies", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting account_policies: %s", err), "(Data) ibm_is_private_path_service_gateway_account_policies", "read", "set-account_policies").GetDiag()
 	}
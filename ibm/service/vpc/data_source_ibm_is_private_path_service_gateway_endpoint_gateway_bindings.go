@@ -21,6 +21,7 @@ func DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindings() *schema.R
 		ReadContext: dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"private_path_service_gateway": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -115,6 +116,13 @@ func dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead(context
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_private_path_service_gateway_endpoint_gateway_bindings", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listPrivatePathServiceGatewayEndpointGatewayBindingsOptions := &vpcv1.ListPrivatePathServiceGatewayEndpointGatewayBindingsOptions{}
 
 	listPrivatePathServiceGatewayEndpointGatewayBindingsOptions.SetPrivatePathServiceGatewayID(d.Get("private_path_service_gateway").(string))
@@ -155,6 +163,12 @@ func dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead(context
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering endpoint gateway bindings: %s", err), "(Data) ibm_is_private_path_service_gateway_endpoint_gateway_bindings", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("endpoint_gateway_bindings", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting resource_type: %s", err), "(Data) ibm_is_private_path_service_gateway_endpoint_gateway_bindings", "read", "set-endpoint_gateway_bindings").GetDiag()
 	}
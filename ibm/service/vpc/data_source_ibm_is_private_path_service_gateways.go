@@ -22,6 +22,7 @@ func DataSourceIBMIsPrivatePathServiceGateways() *schema.Resource {
 		ReadContext: dataSourceIBMIsPrivatePathServiceGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"private_path_service_gateways": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -255,6 +256,13 @@ func dataSourceIBMIsPrivatePathServiceGatewaysRead(context context.Context, d *s
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_private_path_service_gateways", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listPrivatePathServiceGatewaysOptions := &vpcv1.ListPrivatePathServiceGatewaysOptions{}
 
 	privatePathServiceGatewayCollection, response, err := vpcClient.ListPrivatePathServiceGatewaysWithContext(context, listPrivatePathServiceGatewaysOptions)
@@ -276,6 +284,12 @@ func dataSourceIBMIsPrivatePathServiceGatewaysRead(context context.Context, d *s
 			privatePathServiceGateways = append(privatePathServiceGateways, modelMap)
 		}
 	}
+	privatePathServiceGateways, err = filters.apply(context, meta, privatePathServiceGateways)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering private path service gateways: %s", err), "(Data) ibm_is_private_path_service_gateways", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("private_path_service_gateways", privatePathServiceGateways); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting default_access_policy: %s", err), "(Data) ibm_is_private_path_service_gateways", "read", "set-private_path_service_gateways").GetDiag()
 	}
@@ -24,6 +24,7 @@ func DataSourceIBMISPublicGateways() *schema.Resource {
 		ReadContext: dataSourceIBMISPublicGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isPublicGatewayResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -149,6 +150,13 @@ func publicGatewaysGet(context context.Context, d *schema.ResourceData, meta int
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_public_gateways", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	rgroup := ""
 	if rg, ok := d.GetOk(isPublicGatewayResourceGroup); ok {
 		rgroup = rg.(string)
@@ -225,6 +233,12 @@ func publicGatewaysGet(context context.Context, d *schema.ResourceData, meta int
 		}
 		publicgwInfo = append(publicgwInfo, l)
 	}
+	publicgwInfo, err = filters.apply(context, meta, publicgwInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering public gateways: %s", err), "(Data) ibm_is_public_gateways", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISPublicGatewaysID(d))
 	if err = d.Set("public_gateways", publicgwInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting public_gateways %s", err), "(Data) ibm_is_public_gateways", "read", "public_gateways-set").GetDiag()
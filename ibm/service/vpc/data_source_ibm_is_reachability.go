@@ -0,0 +1,1055 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isReachabilitySource                  = "source"
+	isReachabilityDestination             = "destination"
+	isReachabilityInstance                = "instance"
+	isReachabilityVirtualNetworkInterface = "virtual_network_interface"
+	isReachabilitySubnet                  = "subnet"
+	isReachabilityCIDR                    = "cidr"
+	isReachabilityProtocol                = "protocol"
+	isReachabilityPort                    = "port"
+	isReachabilityAllowed                 = "allowed"
+	isReachabilityComplete                = "complete"
+	isReachabilityReason                  = "reason"
+	isReachabilityDecidedBy               = "decided_by"
+	isReachabilityChecks                  = "checks"
+
+	isReachabilityCheckName         = "name"
+	isReachabilityCheckResult       = "result"
+	isReachabilityCheckResourceType = "resource_type"
+	isReachabilityCheckResourceID   = "resource_id"
+	isReachabilityCheckResourceName = "resource_name"
+	isReachabilityCheckRuleID       = "rule_id"
+	isReachabilityCheckRuleName     = "rule_name"
+	isReachabilityCheckMessage      = "message"
+
+	isReachabilityResultAllowed      = "allowed"
+	isReachabilityResultDenied       = "denied"
+	isReachabilityResultNotEvaluated = "not_evaluated"
+
+	// The client side of the traffic is assumed to use an ephemeral port, so
+	// that stateless network ACL rules must allow this whole range.
+	isReachabilityEphemeralPortMin = 1024
+	isReachabilityEphemeralPortMax = 65535
+
+	// ICMP traffic is evaluated as an echo request and its reply.
+	isReachabilityICMPEchoRequest = 8
+	isReachabilityICMPEchoReply   = 0
+)
+
+func DataSourceIBMISReachability() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISReachabilityRead,
+
+		Schema: map[string]*schema.Schema{
+			isReachabilitySource:      dataSourceIBMISReachabilityEndpointSchema(isReachabilitySource),
+			isReachabilityDestination: dataSourceIBMISReachabilityEndpointSchema(isReachabilityDestination),
+			isReachabilityProtocol: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp"}, false),
+				Description:  "The protocol of the traffic: tcp, udp or icmp",
+			},
+			isReachabilityPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "The destination port of the traffic, required for the tcp and udp protocols",
+			},
+			isReachabilityAllowed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether no security group rule, network ACL rule or route denies the traffic",
+			},
+			isReachabilityComplete: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the whole path of the traffic was evaluated. It is false when a part of the path, such as a next hop or a transit gateway, is outside of what the data source evaluates",
+			},
+			isReachabilityReason: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The explanation of the result",
+			},
+			isReachabilityDecidedBy: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The check that decided the result, which is the first check that denied the traffic, or the last check that allowed it",
+				Elem:        dataSourceIBMISReachabilityCheckSchema(),
+			},
+			isReachabilityChecks: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The checks evaluated along the path of the traffic and of its replies, in order",
+				Elem:        dataSourceIBMISReachabilityCheckSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceIBMISReachabilityEndpointSchema(name string) *schema.Schema {
+	kinds := []string{}
+	for _, kind := range []string{isReachabilityInstance, isReachabilityVirtualNetworkInterface, isReachabilitySubnet, isReachabilityCIDR} {
+		kinds = append(kinds, fmt.Sprintf("%s.0.%s", name, kind))
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: fmt.Sprintf("The %s of the traffic, which is either an instance, a virtual network interface, a subnet or a CIDR", name),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				isReachabilityInstance: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: kinds,
+					Description:  "The ID of a virtual server instance, whose primary network interface or attachment is used",
+				},
+				isReachabilityVirtualNetworkInterface: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: kinds,
+					Description:  "The ID of a virtual network interface",
+				},
+				isReachabilitySubnet: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: kinds,
+					Description:  "The ID of a subnet, all of whose addresses are evaluated",
+				},
+				isReachabilityCIDR: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: kinds,
+					ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address),
+					Description:  "An IPv4 address or CIDR outside of the subnets of the VPC, all of whose addresses are evaluated",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISReachabilityCheckSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isReachabilityCheckName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the check",
+			},
+			isReachabilityCheckResult: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The result of the check: allowed, denied or not_evaluated",
+			},
+			isReachabilityCheckResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the resource that was evaluated: security_group, network_acl, routing_table or public_gateway",
+			},
+			isReachabilityCheckResourceID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the resource that was evaluated",
+			},
+			isReachabilityCheckResourceName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource that was evaluated",
+			},
+			isReachabilityCheckRuleID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the rule or route that decided the check",
+			},
+			isReachabilityCheckRuleName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the rule or route that decided the check",
+			},
+			isReachabilityCheckMessage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The explanation of the result of the check",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISReachabilityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_reachability", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	traffic := reachabilityTraffic{protocol: d.Get(isReachabilityProtocol).(string), port: int64(d.Get(isReachabilityPort).(int))}
+	if traffic.protocol != "icmp" && traffic.port == 0 {
+		err = fmt.Errorf("port is required for the %s protocol", traffic.protocol)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_reachability", "read", "validate-port").GetDiag()
+	}
+
+	resolver := &reachabilityResolver{sess: sess, context: context}
+	source, err := resolver.endpoint(d.Get(isReachabilitySource).([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error resolving the source: %s", err.Error()), "(Data) ibm_is_reachability", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	destination, err := resolver.endpoint(d.Get(isReachabilityDestination).([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error resolving the destination: %s", err.Error()), "(Data) ibm_is_reachability", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if source.subnet == nil && destination.subnet == nil {
+		err = fmt.Errorf("the source or the destination must be an instance, a virtual network interface or a subnet")
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_reachability", "read", "validate-endpoints").GetDiag()
+	}
+
+	checks, err := resolver.evaluate(source, destination, traffic)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error evaluating the reachability: %s", err.Error()), "(Data) ibm_is_reachability", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	allowed, complete, decidedBy := reachabilityDecision(checks)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%d", source.name(), destination.name(), traffic.protocol, traffic.port))
+	if err = d.Set(isReachabilityAllowed, allowed); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting allowed: %s", err), "(Data) ibm_is_reachability", "read", "set-allowed").GetDiag()
+	}
+	if err = d.Set(isReachabilityComplete, complete); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting complete: %s", err), "(Data) ibm_is_reachability", "read", "set-complete").GetDiag()
+	}
+	reason := ""
+	decidedByList := []map[string]interface{}{}
+	if decidedBy != nil {
+		reason = decidedBy.message
+		decidedByList = append(decidedByList, decidedBy.toMap())
+	}
+	if err = d.Set(isReachabilityReason, reason); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reason: %s", err), "(Data) ibm_is_reachability", "read", "set-reason").GetDiag()
+	}
+	if err = d.Set(isReachabilityDecidedBy, decidedByList); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting decided_by: %s", err), "(Data) ibm_is_reachability", "read", "set-decided_by").GetDiag()
+	}
+	checkList := make([]map[string]interface{}, 0, len(checks))
+	for _, check := range checks {
+		checkList = append(checkList, check.toMap())
+	}
+	if err = d.Set(isReachabilityChecks, checkList); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting checks: %s", err), "(Data) ibm_is_reachability", "read", "set-checks").GetDiag()
+	}
+	return nil
+}
+
+// reachabilityTraffic is the traffic that is evaluated. A request is sent
+// from an ephemeral port of the source to the port of the destination, and
+// its replies are sent back.
+type reachabilityTraffic struct {
+	protocol string
+	port     int64
+}
+
+// reachabilityPacket is one direction of the traffic, between the addresses
+// of its endpoints.
+type reachabilityPacket struct {
+	protocol        string
+	source          *net.IPNet
+	destination     *net.IPNet
+	sourcePorts     [2]int64
+	destinationPort [2]int64
+	icmpType        int64
+}
+
+func (t reachabilityTraffic) request(source, destination *net.IPNet) reachabilityPacket {
+	return reachabilityPacket{
+		protocol:        t.protocol,
+		source:          source,
+		destination:     destination,
+		sourcePorts:     [2]int64{isReachabilityEphemeralPortMin, isReachabilityEphemeralPortMax},
+		destinationPort: [2]int64{t.port, t.port},
+		icmpType:        isReachabilityICMPEchoRequest,
+	}
+}
+
+func (t reachabilityTraffic) reply(source, destination *net.IPNet) reachabilityPacket {
+	return reachabilityPacket{
+		protocol:        t.protocol,
+		source:          destination,
+		destination:     source,
+		sourcePorts:     [2]int64{t.port, t.port},
+		destinationPort: [2]int64{isReachabilityEphemeralPortMin, isReachabilityEphemeralPortMax},
+		icmpType:        isReachabilityICMPEchoReply,
+	}
+}
+
+// reachabilityMatch is how a rule matches a packet. A rule matches a packet
+// partially when it only applies to some of its addresses or ports.
+type reachabilityMatch int
+
+const (
+	reachabilityNoMatch reachabilityMatch = iota
+	reachabilityPartialMatch
+	reachabilityFullMatch
+)
+
+func (m reachabilityMatch) and(other reachabilityMatch) reachabilityMatch {
+	if other < m {
+		return other
+	}
+	return m
+}
+
+// matchCIDR returns how a rule CIDR matches the addresses of a packet. A nil
+// rule CIDR matches any address.
+func matchCIDR(rule, addresses *net.IPNet) reachabilityMatch {
+	if rule == nil {
+		return reachabilityFullMatch
+	}
+	ruleOnes, _ := rule.Mask.Size()
+	addressesOnes, _ := addresses.Mask.Size()
+	if rule.Contains(addresses.IP) && ruleOnes <= addressesOnes {
+		return reachabilityFullMatch
+	}
+	if rule.Contains(addresses.IP) || addresses.Contains(rule.IP) {
+		return reachabilityPartialMatch
+	}
+	return reachabilityNoMatch
+}
+
+// matchPorts returns how a rule port range matches the ports of a packet. A
+// zero rule range matches any port.
+func matchPorts(ruleMin, ruleMax int64, ports [2]int64) reachabilityMatch {
+	if ruleMin == 0 && ruleMax == 0 {
+		return reachabilityFullMatch
+	}
+	if ruleMin <= ports[0] && ports[1] <= ruleMax {
+		return reachabilityFullMatch
+	}
+	if ruleMin <= ports[1] && ports[0] <= ruleMax {
+		return reachabilityPartialMatch
+	}
+	return reachabilityNoMatch
+}
+
+// reachabilityRule is a security group rule or a network ACL rule. The
+// remote of a security group rule is either remoteCIDR or remoteSecurityGroup,
+// and its local is localCIDR. The source and destination of a network ACL rule
+// are remoteCIDR and localCIDR, swapped by direction.
+type reachabilityRule struct {
+	id                  string
+	name                string
+	direction           string
+	action              string
+	protocol            string
+	remoteCIDR          *net.IPNet
+	remoteSecurityGroup string
+	localCIDR           *net.IPNet
+	sourcePortMin       int64
+	sourcePortMax       int64
+	destinationPortMin  int64
+	destinationPortMax  int64
+	icmpType            *int64
+}
+
+func (r reachabilityRule) matchProtocol(packet reachabilityPacket) reachabilityMatch {
+	switch {
+	case r.protocol == "all":
+		return reachabilityFullMatch
+	case r.protocol != packet.protocol:
+		return reachabilityNoMatch
+	case packet.protocol == "icmp":
+		if r.icmpType == nil || *r.icmpType == packet.icmpType {
+			return reachabilityFullMatch
+		}
+		return reachabilityNoMatch
+	}
+	return matchPorts(r.sourcePortMin, r.sourcePortMax, packet.sourcePorts).and(matchPorts(r.destinationPortMin, r.destinationPortMax, packet.destinationPort))
+}
+
+// reachabilityCheck is the result of the evaluation of a security group, a
+// network ACL or a routing table for a packet.
+type reachabilityCheck struct {
+	name         string
+	result       string
+	resourceType string
+	resourceID   string
+	resourceName string
+	ruleID       string
+	ruleName     string
+	message      string
+}
+
+func (c *reachabilityCheck) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		isReachabilityCheckName:         c.name,
+		isReachabilityCheckResult:       c.result,
+		isReachabilityCheckResourceType: c.resourceType,
+		isReachabilityCheckResourceID:   c.resourceID,
+		isReachabilityCheckResourceName: c.resourceName,
+		isReachabilityCheckRuleID:       c.ruleID,
+		isReachabilityCheckRuleName:     c.ruleName,
+		isReachabilityCheckMessage:      c.message,
+	}
+}
+
+// reachabilityDecision returns whether the checks allow the traffic, whether
+// they were all evaluated, and the check that decided it.
+func reachabilityDecision(checks []*reachabilityCheck) (bool, bool, *reachabilityCheck) {
+	complete := true
+	var decidedBy *reachabilityCheck
+	for _, check := range checks {
+		switch check.result {
+		case isReachabilityResultDenied:
+			return false, complete, check
+		case isReachabilityResultNotEvaluated:
+			complete = false
+		case isReachabilityResultAllowed:
+			decidedBy = check
+		}
+	}
+	return true, complete, decidedBy
+}
+
+// reachabilitySecurityGroup is a security group of an endpoint.
+type reachabilitySecurityGroup struct {
+	id    string
+	name  string
+	rules []reachabilityRule
+}
+
+// evaluateSecurityGroups returns the check of the security groups of an
+// endpoint for a packet in a direction. Security groups are stateful and only
+// allow traffic, so that a packet is allowed when a rule of any of the groups
+// matches all of it. The peer is the other endpoint of the packet.
+func evaluateSecurityGroups(name, direction string, groups []reachabilitySecurityGroup, local *net.IPNet, peer *reachabilityEndpoint, packet reachabilityPacket) *reachabilityCheck {
+	check := &reachabilityCheck{name: name, resourceType: "security_group"}
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.id)
+		for _, rule := range group.rules {
+			if rule.direction != direction {
+				continue
+			}
+			match := rule.matchProtocol(packet).and(matchCIDR(rule.localCIDR, local))
+			if rule.remoteSecurityGroup != "" {
+				if !peer.hasSecurityGroup(rule.remoteSecurityGroup) {
+					continue
+				}
+			} else {
+				match = match.and(matchCIDR(rule.remoteCIDR, peer.addresses))
+			}
+			if match == reachabilityFullMatch {
+				check.result = isReachabilityResultAllowed
+				check.resourceID, check.resourceName = group.id, group.name
+				check.ruleID = rule.id
+				check.message = fmt.Sprintf("The %s rule %s of the security group %s allows the traffic", direction, rule.id, group.name)
+				return check
+			}
+		}
+	}
+	check.result = isReachabilityResultDenied
+	check.resourceID = strings.Join(ids, ",")
+	check.message = fmt.Sprintf("No %s rule of the security groups %s allows the traffic", direction, strings.Join(ids, ", "))
+	return check
+}
+
+// reachabilityNetworkACL is the network ACL of a subnet, whose rules are in
+// the order that they are evaluated.
+type reachabilityNetworkACL struct {
+	id    string
+	name  string
+	rules []reachabilityRule
+}
+
+// evaluateNetworkACL returns the check of a network ACL for a packet in a
+// direction. The first rule that matches all of the packet decides. A deny
+// rule that matches part of it denies it, since the traffic is then denied
+// for some of the addresses or ports.
+func evaluateNetworkACL(name, direction string, acl reachabilityNetworkACL, packet reachabilityPacket) *reachabilityCheck {
+	check := &reachabilityCheck{name: name, resourceType: "network_acl", resourceID: acl.id, resourceName: acl.name}
+	for _, rule := range acl.rules {
+		if rule.direction != direction {
+			continue
+		}
+		match := rule.matchProtocol(packet)
+		if direction == "inbound" {
+			match = match.and(matchCIDR(rule.remoteCIDR, packet.source)).and(matchCIDR(rule.localCIDR, packet.destination))
+		} else {
+			match = match.and(matchCIDR(rule.localCIDR, packet.source)).and(matchCIDR(rule.remoteCIDR, packet.destination))
+		}
+		if match == reachabilityNoMatch || (match == reachabilityPartialMatch && rule.action == "allow") {
+			continue
+		}
+		check.ruleID, check.ruleName = rule.id, rule.name
+		if rule.action == "allow" {
+			check.result = isReachabilityResultAllowed
+			check.message = fmt.Sprintf("The %s rule %s of the network ACL %s allows the traffic", direction, rule.name, acl.name)
+		} else if match == reachabilityFullMatch {
+			check.result = isReachabilityResultDenied
+			check.message = fmt.Sprintf("The %s rule %s of the network ACL %s denies the traffic", direction, rule.name, acl.name)
+		} else {
+			check.result = isReachabilityResultDenied
+			check.message = fmt.Sprintf("The %s rule %s of the network ACL %s denies part of the traffic", direction, rule.name, acl.name)
+		}
+		return check
+	}
+	check.result = isReachabilityResultDenied
+	check.message = fmt.Sprintf("No %s rule of the network ACL %s allows the traffic", direction, acl.name)
+	return check
+}
+
+// reachabilityRoute is a route of the routing table of a subnet, in the zone
+// of the subnet.
+type reachabilityRoute struct {
+	id          string
+	name        string
+	action      string
+	destination *net.IPNet
+	nextHop     string
+	priority    int64
+}
+
+// selectRoute returns the route that the destination addresses are routed
+// with, which is the route with the longest destination prefix that contains
+// them, and then the highest priority. A drop route that is more specific
+// than the destination addresses is returned instead, since part of the
+// traffic is then dropped.
+func selectRoute(routes []reachabilityRoute, destination *net.IPNet) *reachabilityRoute {
+	var selected *reachabilityRoute
+	selectedOnes := -1
+	for i, route := range routes {
+		switch matchCIDR(route.destination, destination) {
+		case reachabilityPartialMatch:
+			if route.action == "drop" {
+				return &routes[i]
+			}
+		case reachabilityFullMatch:
+			ones, _ := route.destination.Mask.Size()
+			if ones > selectedOnes || (ones == selectedOnes && route.priority < selected.priority) {
+				selected, selectedOnes = &routes[i], ones
+			}
+		}
+	}
+	return selected
+}
+
+// reachabilityEndpoint is the source or the destination of the traffic.
+// Endpoints outside of the VPC have no subnet.
+type reachabilityEndpoint struct {
+	kind           string
+	id             string
+	addresses      *net.IPNet
+	subnet         *vpcv1.Subnet
+	securityGroups []reachabilitySecurityGroup
+	floatingIP     bool
+}
+
+func (e *reachabilityEndpoint) name() string {
+	if e.kind == isReachabilityCIDR {
+		return e.addresses.String()
+	}
+	return e.id
+}
+
+func (e *reachabilityEndpoint) hasSecurityGroup(id string) bool {
+	for _, group := range e.securityGroups {
+		if group.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *reachabilityEndpoint) subnetID() string {
+	if e.subnet == nil {
+		return ""
+	}
+	return *e.subnet.ID
+}
+
+// reachabilityResolver reads the endpoints and the rules and routes along the
+// path of the traffic. The security groups, network ACLs and routing tables
+// are read once.
+type reachabilityResolver struct {
+	sess           *vpcv1.VpcV1
+	context        context.Context
+	securityGroups map[string]reachabilitySecurityGroup
+	networkACLs    map[string]reachabilityNetworkACL
+}
+
+func (r *reachabilityResolver) endpoint(endpointMap map[string]interface{}) (*reachabilityEndpoint, error) {
+	endpoint := &reachabilityEndpoint{}
+	var address, subnetID string
+	var securityGroups []vpcv1.SecurityGroupReference
+	switch {
+	case endpointMap[isReachabilityInstance].(string) != "":
+		endpoint.kind, endpoint.id = isReachabilityInstance, endpointMap[isReachabilityInstance].(string)
+		instance, response, err := r.sess.GetInstanceWithContext(r.context, &vpcv1.GetInstanceOptions{ID: &endpoint.id})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting instance %s: %s\n%s", endpoint.id, err, response)
+		}
+		if instance.PrimaryNetworkAttachment != nil {
+			vniID := *instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID
+			if address, subnetID, securityGroups, err = r.virtualNetworkInterface(endpoint, vniID); err != nil {
+				return nil, err
+			}
+		} else {
+			nic, response, err := r.sess.GetInstanceNetworkInterfaceWithContext(r.context, &vpcv1.GetInstanceNetworkInterfaceOptions{
+				InstanceID: &endpoint.id,
+				ID:         instance.PrimaryNetworkInterface.ID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error getting the primary network interface of instance %s: %s\n%s", endpoint.id, err, response)
+			}
+			address, subnetID, securityGroups = *nic.PrimaryIP.Address, *nic.Subnet.ID, nic.SecurityGroups
+			endpoint.floatingIP = len(nic.FloatingIps) > 0
+		}
+	case endpointMap[isReachabilityVirtualNetworkInterface].(string) != "":
+		endpoint.kind, endpoint.id = isReachabilityVirtualNetworkInterface, endpointMap[isReachabilityVirtualNetworkInterface].(string)
+		var err error
+		if address, subnetID, securityGroups, err = r.virtualNetworkInterface(endpoint, endpoint.id); err != nil {
+			return nil, err
+		}
+	case endpointMap[isReachabilitySubnet].(string) != "":
+		endpoint.kind, endpoint.id = isReachabilitySubnet, endpointMap[isReachabilitySubnet].(string)
+		subnetID = endpoint.id
+	default:
+		endpoint.kind = isReachabilityCIDR
+		address = endpointMap[isReachabilityCIDR].(string)
+	}
+
+	if subnetID != "" {
+		subnet, response, err := r.sess.GetSubnetWithContext(r.context, &vpcv1.GetSubnetOptions{ID: &subnetID})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting subnet %s: %s\n%s", subnetID, err, response)
+		}
+		endpoint.subnet = subnet
+		if address == "" {
+			address = *subnet.Ipv4CIDRBlock
+		}
+	}
+	addresses, err := reachabilityCIDR(address)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the address %s: %s", address, err)
+	}
+	endpoint.addresses = addresses
+
+	for _, reference := range securityGroups {
+		group, err := r.securityGroup(*reference.ID)
+		if err != nil {
+			return nil, err
+		}
+		endpoint.securityGroups = append(endpoint.securityGroups, group)
+	}
+	return endpoint, nil
+}
+
+func (r *reachabilityResolver) virtualNetworkInterface(endpoint *reachabilityEndpoint, id string) (string, string, []vpcv1.SecurityGroupReference, error) {
+	vni, response, err := r.sess.GetVirtualNetworkInterfaceWithContext(r.context, &vpcv1.GetVirtualNetworkInterfaceOptions{ID: &id})
+	if err != nil {
+		return "", "", nil, fmt.Errorf("[ERROR] Error getting virtual network interface %s: %s\n%s", id, err, response)
+	}
+	floatingIPOptions := &vpcv1.ListNetworkInterfaceFloatingIpsOptions{}
+	floatingIPOptions.SetVirtualNetworkInterfaceID(id)
+	floatingIPs, response, err := r.sess.ListNetworkInterfaceFloatingIpsWithContext(r.context, floatingIPOptions)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("[ERROR] Error listing the floating IPs of virtual network interface %s: %s\n%s", id, err, response)
+	}
+	endpoint.floatingIP = len(floatingIPs.FloatingIps) > 0
+	return *vni.PrimaryIP.Address, *vni.Subnet.ID, vni.SecurityGroups, nil
+}
+
+func (r *reachabilityResolver) securityGroup(id string) (reachabilitySecurityGroup, error) {
+	if group, ok := r.securityGroups[id]; ok {
+		return group, nil
+	}
+	securityGroup, response, err := r.sess.GetSecurityGroupWithContext(r.context, &vpcv1.GetSecurityGroupOptions{ID: &id})
+	if err != nil {
+		return reachabilitySecurityGroup{}, fmt.Errorf("[ERROR] Error getting security group %s: %s\n%s", id, err, response)
+	}
+	group := reachabilitySecurityGroup{id: id, name: *securityGroup.Name}
+	for _, ruleIntf := range securityGroup.Rules {
+		rule := reachabilityRule{action: "allow"}
+		var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+		var localIntf vpcv1.SecurityGroupRuleLocalIntf
+		var ipVersion string
+		switch ruleIntf := ruleIntf.(type) {
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+			rule.id, rule.direction, rule.protocol, ipVersion = *ruleIntf.ID, *ruleIntf.Direction, *ruleIntf.Protocol, *ruleIntf.IPVersion
+			remoteIntf, localIntf = ruleIntf.Remote, ruleIntf.Local
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+			rule.id, rule.direction, rule.protocol, ipVersion = *ruleIntf.ID, *ruleIntf.Direction, *ruleIntf.Protocol, *ruleIntf.IPVersion
+			rule.icmpType = ruleIntf.Type
+			remoteIntf, localIntf = ruleIntf.Remote, ruleIntf.Local
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+			rule.id, rule.direction, rule.protocol, ipVersion = *ruleIntf.ID, *ruleIntf.Direction, *ruleIntf.Protocol, *ruleIntf.IPVersion
+			// The ports of a security group rule are the destination ports of
+			// the traffic, in both directions.
+			rule.destinationPortMin, rule.destinationPortMax = reachabilityInt(ruleIntf.PortMin), reachabilityInt(ruleIntf.PortMax)
+			remoteIntf, localIntf = ruleIntf.Remote, ruleIntf.Local
+		default:
+			continue
+		}
+		if ipVersion != "ipv4" {
+			continue
+		}
+		var err error
+		if remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+			if remote.ID != nil {
+				rule.remoteSecurityGroup = *remote.ID
+			} else if remote.Address != nil {
+				rule.remoteCIDR, err = reachabilityCIDR(*remote.Address)
+			} else if remote.CIDRBlock != nil {
+				rule.remoteCIDR, err = reachabilityCIDR(*remote.CIDRBlock)
+			}
+			if err != nil {
+				return reachabilitySecurityGroup{}, fmt.Errorf("[ERROR] Error parsing the remote of rule %s of security group %s: %s", rule.id, id, err)
+			}
+		}
+		if local, ok := localIntf.(*vpcv1.SecurityGroupRuleLocal); ok && local != nil {
+			if local.Address != nil {
+				rule.localCIDR, err = reachabilityCIDR(*local.Address)
+			} else if local.CIDRBlock != nil {
+				rule.localCIDR, err = reachabilityCIDR(*local.CIDRBlock)
+			}
+			if err != nil {
+				return reachabilitySecurityGroup{}, fmt.Errorf("[ERROR] Error parsing the local of rule %s of security group %s: %s", rule.id, id, err)
+			}
+		}
+		group.rules = append(group.rules, rule)
+	}
+	if r.securityGroups == nil {
+		r.securityGroups = map[string]reachabilitySecurityGroup{}
+	}
+	r.securityGroups[id] = group
+	return group, nil
+}
+
+func (r *reachabilityResolver) networkACL(id string) (reachabilityNetworkACL, error) {
+	if acl, ok := r.networkACLs[id]; ok {
+		return acl, nil
+	}
+	networkACL, response, err := r.sess.GetNetworkACLWithContext(r.context, &vpcv1.GetNetworkACLOptions{ID: &id})
+	if err != nil {
+		return reachabilityNetworkACL{}, fmt.Errorf("[ERROR] Error getting network ACL %s: %s\n%s", id, err, response)
+	}
+	acl := reachabilityNetworkACL{id: id, name: *networkACL.Name}
+	for _, ruleIntf := range networkACL.Rules {
+		rule := reachabilityRule{}
+		var source, destination, ipVersion string
+		switch ruleIntf := ruleIntf.(type) {
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+			rule.id, rule.name, rule.direction, rule.action, rule.protocol = *ruleIntf.ID, *ruleIntf.Name, *ruleIntf.Direction, *ruleIntf.Action, *ruleIntf.Protocol
+			source, destination, ipVersion = *ruleIntf.Source, *ruleIntf.Destination, *ruleIntf.IPVersion
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+			rule.id, rule.name, rule.direction, rule.action, rule.protocol = *ruleIntf.ID, *ruleIntf.Name, *ruleIntf.Direction, *ruleIntf.Action, *ruleIntf.Protocol
+			source, destination, ipVersion = *ruleIntf.Source, *ruleIntf.Destination, *ruleIntf.IPVersion
+			rule.icmpType = ruleIntf.Type
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+			rule.id, rule.name, rule.direction, rule.action, rule.protocol = *ruleIntf.ID, *ruleIntf.Name, *ruleIntf.Direction, *ruleIntf.Action, *ruleIntf.Protocol
+			source, destination, ipVersion = *ruleIntf.Source, *ruleIntf.Destination, *ruleIntf.IPVersion
+			rule.sourcePortMin, rule.sourcePortMax = reachabilityInt(ruleIntf.SourcePortMin), reachabilityInt(ruleIntf.SourcePortMax)
+			rule.destinationPortMin, rule.destinationPortMax = reachabilityInt(ruleIntf.DestinationPortMin), reachabilityInt(ruleIntf.DestinationPortMax)
+		default:
+			continue
+		}
+		if ipVersion != "ipv4" {
+			continue
+		}
+		sourceCIDR, err := reachabilityCIDR(source)
+		if err != nil {
+			return reachabilityNetworkACL{}, fmt.Errorf("[ERROR] Error parsing the source of rule %s of network ACL %s: %s", rule.name, id, err)
+		}
+		destinationCIDR, err := reachabilityCIDR(destination)
+		if err != nil {
+			return reachabilityNetworkACL{}, fmt.Errorf("[ERROR] Error parsing the destination of rule %s of network ACL %s: %s", rule.name, id, err)
+		}
+		if rule.direction == "inbound" {
+			rule.remoteCIDR, rule.localCIDR = sourceCIDR, destinationCIDR
+		} else {
+			rule.localCIDR, rule.remoteCIDR = sourceCIDR, destinationCIDR
+		}
+		acl.rules = append(acl.rules, rule)
+	}
+	if r.networkACLs == nil {
+		r.networkACLs = map[string]reachabilityNetworkACL{}
+	}
+	r.networkACLs[id] = acl
+	return acl, nil
+}
+
+func (r *reachabilityResolver) routes(subnet *vpcv1.Subnet) ([]reachabilityRoute, error) {
+	if subnet.RoutingTable == nil {
+		return nil, nil
+	}
+	routes := []reachabilityRoute{}
+	start := ""
+	for {
+		listOptions := r.sess.NewListVPCRoutingTableRoutesOptions(*subnet.VPC.ID, *subnet.RoutingTable.ID)
+		if start != "" {
+			listOptions.Start = &start
+		}
+		result, response, err := r.sess.ListVPCRoutingTableRoutesWithContext(r.context, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the routes of routing table %s: %s\n%s", *subnet.RoutingTable.ID, err, response)
+		}
+		for _, route := range result.Routes {
+			if route.Zone == nil || *route.Zone.Name != *subnet.Zone.Name || route.Destination == nil {
+				continue
+			}
+			destination, err := reachabilityCIDR(*route.Destination)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error parsing the destination of route %s: %s", *route.ID, err)
+			}
+			reachabilityRoute := reachabilityRoute{
+				id:          *route.ID,
+				name:        *route.Name,
+				action:      *route.Action,
+				destination: destination,
+				priority:    reachabilityInt(route.Priority),
+			}
+			if nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && nextHop != nil {
+				if nextHop.Address != nil {
+					reachabilityRoute.nextHop = *nextHop.Address
+				} else if nextHop.ID != nil {
+					reachabilityRoute.nextHop = *nextHop.ID
+				}
+			}
+			routes = append(routes, reachabilityRoute)
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return routes, nil
+}
+
+// addressPrefixes returns the address prefixes of a VPC.
+func (r *reachabilityResolver) addressPrefixes(vpcID string) ([]*net.IPNet, error) {
+	prefixes := []*net.IPNet{}
+	start := ""
+	for {
+		listOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+		listOptions.SetVPCID(vpcID)
+		if start != "" {
+			listOptions.Start = &start
+		}
+		result, response, err := r.sess.ListVPCAddressPrefixesWithContext(r.context, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the address prefixes of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, prefix := range result.AddressPrefixes {
+			cidr, err := reachabilityCIDR(*prefix.CIDR)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error parsing the address prefix %s of VPC %s: %s", *prefix.ID, vpcID, err)
+			}
+			prefixes = append(prefixes, cidr)
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return prefixes, nil
+}
+
+// evaluate returns the checks along the path of the traffic from the source
+// to the destination, followed by the checks of the stateless network ACLs
+// along the path of its replies. Network ACLs are not evaluated for traffic
+// within a subnet.
+func (r *reachabilityResolver) evaluate(source, destination *reachabilityEndpoint, traffic reachabilityTraffic) ([]*reachabilityCheck, error) {
+	request := traffic.request(source.addresses, destination.addresses)
+	reply := traffic.reply(source.addresses, destination.addresses)
+	sameSubnet := source.subnetID() != "" && source.subnetID() == destination.subnetID()
+	checks := []*reachabilityCheck{}
+
+	if len(source.securityGroups) > 0 {
+		checks = append(checks, evaluateSecurityGroups("source_security_groups", "outbound", source.securityGroups, source.addresses, destination, request))
+	} else if source.kind == isReachabilitySubnet {
+		checks = append(checks, subnetSecurityGroupsCheck("source_security_groups", source))
+	}
+	if source.subnet != nil && !sameSubnet {
+		acl, err := r.networkACL(*source.subnet.NetworkACL.ID)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, evaluateNetworkACL("source_network_acl", "outbound", acl, request))
+	}
+	if !sameSubnet {
+		check, err := r.evaluateRouting(source, destination)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+	var destinationACL reachabilityNetworkACL
+	if destination.subnet != nil && !sameSubnet {
+		var err error
+		if destinationACL, err = r.networkACL(*destination.subnet.NetworkACL.ID); err != nil {
+			return nil, err
+		}
+		checks = append(checks, evaluateNetworkACL("destination_network_acl", "inbound", destinationACL, request))
+	}
+	if len(destination.securityGroups) > 0 {
+		checks = append(checks, evaluateSecurityGroups("destination_security_groups", "inbound", destination.securityGroups, destination.addresses, source, request))
+	} else if destination.kind == isReachabilitySubnet {
+		checks = append(checks, subnetSecurityGroupsCheck("destination_security_groups", destination))
+	}
+
+	if destination.subnet != nil && !sameSubnet {
+		checks = append(checks, evaluateNetworkACL("destination_network_acl_reply", "outbound", destinationACL, reply))
+	}
+	if source.subnet != nil && !sameSubnet {
+		acl, err := r.networkACL(*source.subnet.NetworkACL.ID)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, evaluateNetworkACL("source_network_acl_reply", "inbound", acl, reply))
+	}
+	return checks, nil
+}
+
+// subnetSecurityGroupsCheck returns the check of the security groups of a
+// subnet endpoint, which are those of the network interfaces in the subnet
+// and are not evaluated.
+func subnetSecurityGroupsCheck(name string, endpoint *reachabilityEndpoint) *reachabilityCheck {
+	return &reachabilityCheck{
+		name:         name,
+		result:       isReachabilityResultNotEvaluated,
+		resourceType: "security_group",
+		message:      fmt.Sprintf("The security groups of the network interfaces in the subnet %s are not evaluated", endpoint.id),
+	}
+}
+
+// evaluateRouting returns the check of the routing of the traffic between
+// subnets. The routes of the routing table of the source subnet are evaluated
+// first, and traffic that they do not drop or deliver elsewhere is routed
+// within the VPC, or through a public gateway or a floating IP to and from
+// public addresses. Traffic to and from private addresses outside of the VPC
+// relies on connectivity, such as transit gateways, that is not evaluated.
+func (r *reachabilityResolver) evaluateRouting(source, destination *reachabilityEndpoint) (*reachabilityCheck, error) {
+	check := &reachabilityCheck{name: "routing", resourceType: "routing_table"}
+	vpcSubnet := source.subnet
+	if vpcSubnet == nil {
+		vpcSubnet = destination.subnet
+	}
+
+	if source.subnet != nil && source.subnet.RoutingTable != nil {
+		check.resourceID, check.resourceName = *source.subnet.RoutingTable.ID, *source.subnet.RoutingTable.Name
+		routes, err := r.routes(source.subnet)
+		if err != nil {
+			return nil, err
+		}
+		if route := selectRoute(routes, destination.addresses); route != nil {
+			switch route.action {
+			case "drop":
+				check.result = isReachabilityResultDenied
+				check.ruleID, check.ruleName = route.id, route.name
+				check.message = fmt.Sprintf("The route %s of the routing table %s drops the traffic to %s", route.name, check.resourceName, route.destination)
+				return check, nil
+			case "deliver":
+				check.result = isReachabilityResultNotEvaluated
+				check.ruleID, check.ruleName = route.id, route.name
+				check.message = fmt.Sprintf("The route %s of the routing table %s delivers the traffic to the next hop %s, which is not evaluated", route.name, check.resourceName, route.nextHop)
+				return check, nil
+			}
+		}
+	}
+
+	if source.subnet != nil && destination.subnet != nil {
+		if *source.subnet.VPC.ID == *destination.subnet.VPC.ID {
+			check.result = isReachabilityResultAllowed
+			check.message = fmt.Sprintf("The traffic is routed within the VPC %s", *source.subnet.VPC.Name)
+		} else {
+			check.result = isReachabilityResultNotEvaluated
+			check.message = "The source and the destination are in different VPCs, the connectivity between them is not evaluated"
+		}
+		return check, nil
+	}
+
+	external, internal := destination, source
+	if source.subnet == nil {
+		external, internal = source, destination
+	}
+	prefixes, err := r.addressPrefixes(*vpcSubnet.VPC.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, prefix := range prefixes {
+		if matchCIDR(prefix, external.addresses) == reachabilityFullMatch {
+			check.result = isReachabilityResultAllowed
+			check.message = fmt.Sprintf("The traffic is routed within the VPC %s", *vpcSubnet.VPC.Name)
+			return check, nil
+		}
+	}
+	if external.addresses.IP.IsPrivate() {
+		check.result = isReachabilityResultNotEvaluated
+		check.message = fmt.Sprintf("%s is a private address outside of the VPC, the connectivity to it is not evaluated", external.addresses)
+		return check, nil
+	}
+	switch {
+	case internal.floatingIP:
+		check.result = isReachabilityResultAllowed
+		check.message = fmt.Sprintf("The traffic with the public address %s goes through the floating IP of %s", external.addresses, internal.id)
+	case source == internal && internal.subnet.PublicGateway != nil:
+		check.result = isReachabilityResultAllowed
+		check.resourceType, check.resourceID, check.resourceName = "public_gateway", *internal.subnet.PublicGateway.ID, *internal.subnet.PublicGateway.Name
+		check.message = fmt.Sprintf("The traffic to the public address %s goes through the public gateway %s", external.addresses, *internal.subnet.PublicGateway.Name)
+	case source == internal:
+		check.result = isReachabilityResultDenied
+		check.message = fmt.Sprintf("The public address %s is not reachable without a floating IP or a public gateway of the subnet %s", external.addresses, *internal.subnet.Name)
+	case internal.kind == isReachabilitySubnet:
+		check.result = isReachabilityResultNotEvaluated
+		check.message = fmt.Sprintf("The traffic from the public address %s needs floating IPs on the subnet %s, which are not evaluated", external.addresses, *internal.subnet.Name)
+	default:
+		check.result = isReachabilityResultDenied
+		check.message = fmt.Sprintf("The traffic from the public address %s needs a floating IP on %s", external.addresses, internal.id)
+	}
+	return check, nil
+}
+
+// reachabilityCIDR parses an IPv4 address or CIDR. An address that cannot be
+// parsed is an error rather than a nil CIDR, which would match any address.
+func reachabilityCIDR(address string) (*net.IPNet, error) {
+	if !strings.Contains(address, "/") {
+		address += "/32"
+	}
+	_, cidr, err := net.ParseCIDR(address)
+	if err != nil {
+		return nil, err
+	}
+	if cidr.IP.To4() == nil {
+		return nil, fmt.Errorf("%s is not an IPv4 address or CIDR", address)
+	}
+	return cidr, nil
+}
+
+func reachabilityInt(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,393 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"net"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func testReachabilityCIDR(t *testing.T, address string) *net.IPNet {
+	t.Helper()
+	cidr, err := reachabilityCIDR(address)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return cidr
+}
+
+func TestReachabilityCIDR(t *testing.T) {
+	cases := []struct {
+		address  string
+		expected string
+	}{
+		{"10.240.0.4", "10.240.0.4/32"},
+		{"10.240.0.0/24", "10.240.0.0/24"},
+		{"10.240.0.7/24", "10.240.0.0/24"},
+		{"0.0.0.0/0", "0.0.0.0/0"},
+	}
+	for _, c := range cases {
+		t.Run(c.address, func(t *testing.T) {
+			if cidr := testReachabilityCIDR(t, c.address); cidr.String() != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, cidr)
+			}
+		})
+	}
+
+	for _, address := range []string{"", "10.240.0", "10.240.0.0/33", "any", "2001:db8::/32"} {
+		t.Run(address, func(t *testing.T) {
+			if cidr, err := reachabilityCIDR(address); err == nil {
+				t.Errorf("expected an error, got %s", cidr)
+			}
+		})
+	}
+}
+
+func TestMatchCIDR(t *testing.T) {
+	cases := []struct {
+		name      string
+		rule      string
+		addresses string
+		expected  reachabilityMatch
+	}{
+		{"any address", "", "10.240.0.0/24", reachabilityFullMatch},
+		{"same cidr", "10.240.0.0/24", "10.240.0.0/24", reachabilityFullMatch},
+		{"address in cidr", "10.0.0.0/8", "10.240.0.4/32", reachabilityFullMatch},
+		{"cidr wider than the rule", "10.240.0.0/25", "10.240.0.0/24", reachabilityPartialMatch},
+		{"rule within the cidr", "10.240.0.128/25", "10.240.0.0/24", reachabilityPartialMatch},
+		{"disjoint", "192.168.0.0/16", "10.240.0.0/24", reachabilityNoMatch},
+		{"other address", "10.240.0.5/32", "10.240.0.4/32", reachabilityNoMatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var rule *net.IPNet
+			if c.rule != "" {
+				rule = testReachabilityCIDR(t, c.rule)
+			}
+			if match := matchCIDR(rule, testReachabilityCIDR(t, c.addresses)); match != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, match)
+			}
+		})
+	}
+}
+
+func TestMatchPorts(t *testing.T) {
+	cases := []struct {
+		name     string
+		ruleMin  int64
+		ruleMax  int64
+		ports    [2]int64
+		expected reachabilityMatch
+	}{
+		{"any port", 0, 0, [2]int64{22, 22}, reachabilityFullMatch},
+		{"same port", 22, 22, [2]int64{22, 22}, reachabilityFullMatch},
+		{"port in range", 1, 1024, [2]int64{443, 443}, reachabilityFullMatch},
+		{"whole ephemeral range", 1024, 65535, [2]int64{1024, 65535}, reachabilityFullMatch},
+		{"part of the ephemeral range", 32768, 65535, [2]int64{1024, 65535}, reachabilityPartialMatch},
+		{"other port", 80, 80, [2]int64{443, 443}, reachabilityNoMatch},
+		{"below range", 1024, 2048, [2]int64{22, 22}, reachabilityNoMatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if match := matchPorts(c.ruleMin, c.ruleMax, c.ports); match != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, match)
+			}
+		})
+	}
+}
+
+func TestMatchProtocol(t *testing.T) {
+	source, destination := testReachabilityCIDR(t, "10.240.0.4"), testReachabilityCIDR(t, "10.240.1.4")
+	tcpRequest := reachabilityTraffic{protocol: "tcp", port: 443}.request(source, destination)
+	tcpReply := reachabilityTraffic{protocol: "tcp", port: 443}.reply(source, destination)
+	icmpRequest := reachabilityTraffic{protocol: "icmp"}.request(source, destination)
+	icmpReply := reachabilityTraffic{protocol: "icmp"}.reply(source, destination)
+	cases := []struct {
+		name     string
+		rule     reachabilityRule
+		packet   reachabilityPacket
+		expected reachabilityMatch
+	}{
+		{"all protocols", reachabilityRule{protocol: "all"}, tcpRequest, reachabilityFullMatch},
+		{"other protocol", reachabilityRule{protocol: "udp"}, tcpRequest, reachabilityNoMatch},
+		{"tcp any port", reachabilityRule{protocol: "tcp"}, tcpRequest, reachabilityFullMatch},
+		{"tcp destination port", reachabilityRule{protocol: "tcp", destinationPortMin: 443, destinationPortMax: 443}, tcpRequest, reachabilityFullMatch},
+		{"tcp other destination port", reachabilityRule{protocol: "tcp", destinationPortMin: 22, destinationPortMax: 22}, tcpRequest, reachabilityNoMatch},
+		{"tcp part of the source ports", reachabilityRule{protocol: "tcp", sourcePortMin: 32768, sourcePortMax: 65535}, tcpRequest, reachabilityPartialMatch},
+		{"tcp reply to the ephemeral ports", reachabilityRule{protocol: "tcp", sourcePortMin: 443, sourcePortMax: 443, destinationPortMin: 1024, destinationPortMax: 65535}, tcpReply, reachabilityFullMatch},
+		{"icmp any type", reachabilityRule{protocol: "icmp"}, icmpRequest, reachabilityFullMatch},
+		{"icmp echo request", reachabilityRule{protocol: "icmp", icmpType: core.Int64Ptr(8)}, icmpRequest, reachabilityFullMatch},
+		{"icmp echo request on a reply", reachabilityRule{protocol: "icmp", icmpType: core.Int64Ptr(8)}, icmpReply, reachabilityNoMatch},
+		{"icmp echo reply", reachabilityRule{protocol: "icmp", icmpType: core.Int64Ptr(0)}, icmpReply, reachabilityFullMatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if match := c.rule.matchProtocol(c.packet); match != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, match)
+			}
+		})
+	}
+}
+
+func TestEvaluateSecurityGroups(t *testing.T) {
+	local := testReachabilityCIDR(t, "10.240.0.4")
+	peer := &reachabilityEndpoint{
+		kind:           isReachabilityInstance,
+		id:             "peer-instance",
+		addresses:      testReachabilityCIDR(t, "10.240.1.4"),
+		securityGroups: []reachabilitySecurityGroup{{id: "peer-group"}},
+	}
+	packet := reachabilityTraffic{protocol: "tcp", port: 443}.request(peer.addresses, local)
+	cases := []struct {
+		name      string
+		direction string
+		rules     []reachabilityRule
+		result    string
+		ruleID    string
+	}{
+		{
+			name:      "rule with a remote cidr",
+			direction: "inbound",
+			rules:     []reachabilityRule{{id: "rule-1", direction: "inbound", protocol: "tcp", destinationPortMin: 443, destinationPortMax: 443, remoteCIDR: testReachabilityCIDR(t, "10.240.1.0/24")}},
+			result:    isReachabilityResultAllowed,
+			ruleID:    "rule-1",
+		},
+		{
+			name:      "rule with a remote security group of the peer",
+			direction: "inbound",
+			rules:     []reachabilityRule{{id: "rule-1", direction: "inbound", protocol: "all", remoteSecurityGroup: "peer-group"}},
+			result:    isReachabilityResultAllowed,
+			ruleID:    "rule-1",
+		},
+		{
+			name:      "rule with another remote security group",
+			direction: "inbound",
+			rules:     []reachabilityRule{{id: "rule-1", direction: "inbound", protocol: "all", remoteSecurityGroup: "other-group"}},
+			result:    isReachabilityResultDenied,
+		},
+		{
+			name:      "rule of the other direction",
+			direction: "inbound",
+			rules:     []reachabilityRule{{id: "rule-1", direction: "outbound", protocol: "all"}},
+			result:    isReachabilityResultDenied,
+		},
+		{
+			name:      "rule with another port",
+			direction: "inbound",
+			rules:     []reachabilityRule{{id: "rule-1", direction: "inbound", protocol: "tcp", destinationPortMin: 22, destinationPortMax: 22}},
+			result:    isReachabilityResultDenied,
+		},
+		{
+			name:      "rule for another local address",
+			direction: "inbound",
+			rules:     []reachabilityRule{{id: "rule-1", direction: "inbound", protocol: "all", localCIDR: testReachabilityCIDR(t, "10.240.0.5")}},
+			result:    isReachabilityResultDenied,
+		},
+		{
+			name:      "second rule",
+			direction: "inbound",
+			rules: []reachabilityRule{
+				{id: "rule-1", direction: "inbound", protocol: "udp"},
+				{id: "rule-2", direction: "inbound", protocol: "tcp"},
+			},
+			result: isReachabilityResultAllowed,
+			ruleID: "rule-2",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			groups := []reachabilitySecurityGroup{{id: "group-1", name: "web"}, {id: "group-2", name: "app", rules: c.rules}}
+			check := evaluateSecurityGroups("destination_security_groups", c.direction, groups, local, peer, packet)
+			if check.result != c.result || check.ruleID != c.ruleID {
+				t.Errorf("expected %s by rule %q, got %s by rule %q", c.result, c.ruleID, check.result, check.ruleID)
+			}
+			if c.result == isReachabilityResultAllowed && check.resourceID != "group-2" {
+				t.Errorf("expected the check to name the security group group-2, got %s", check.resourceID)
+			}
+			if c.result == isReachabilityResultDenied && check.resourceID != "group-1,group-2" {
+				t.Errorf("expected the check to name all the security groups, got %s", check.resourceID)
+			}
+		})
+	}
+}
+
+func TestEvaluateNetworkACL(t *testing.T) {
+	source, destination := testReachabilityCIDR(t, "10.0.0.0/8"), testReachabilityCIDR(t, "10.240.0.0/24")
+	request := reachabilityTraffic{protocol: "tcp", port: 22}.request(source, destination)
+	reply := reachabilityTraffic{protocol: "tcp", port: 22}.reply(source, destination)
+	allowAll := reachabilityRule{id: "rule-allow", name: "allow-all", direction: "inbound", action: "allow", protocol: "all"}
+	cases := []struct {
+		name      string
+		direction string
+		packet    reachabilityPacket
+		rules     []reachabilityRule
+		result    string
+		ruleName  string
+	}{
+		{
+			name:      "allow rule",
+			direction: "inbound",
+			packet:    request,
+			rules:     []reachabilityRule{allowAll},
+			result:    isReachabilityResultAllowed,
+			ruleName:  "allow-all",
+		},
+		{
+			name:      "deny rule before an allow rule",
+			direction: "inbound",
+			packet:    request,
+			rules: []reachabilityRule{
+				{id: "rule-deny", name: "deny-ssh", direction: "inbound", action: "deny", protocol: "tcp", destinationPortMin: 22, destinationPortMax: 22},
+				allowAll,
+			},
+			result:   isReachabilityResultDenied,
+			ruleName: "deny-ssh",
+		},
+		{
+			name:      "allow rule for part of the source addresses",
+			direction: "inbound",
+			packet:    request,
+			rules: []reachabilityRule{
+				{id: "rule-partial", name: "allow-subnet", direction: "inbound", action: "allow", protocol: "all", remoteCIDR: testReachabilityCIDR(t, "10.240.1.0/24")},
+			},
+			result: isReachabilityResultDenied,
+		},
+		{
+			name:      "deny rule for part of the source addresses",
+			direction: "inbound",
+			packet:    request,
+			rules: []reachabilityRule{
+				{id: "rule-partial", name: "deny-subnet", direction: "inbound", action: "deny", protocol: "all", remoteCIDR: testReachabilityCIDR(t, "10.240.1.0/24")},
+				allowAll,
+			},
+			result:   isReachabilityResultDenied,
+			ruleName: "deny-subnet",
+		},
+		{
+			name:      "rule of the other direction",
+			direction: "outbound",
+			packet:    reply,
+			rules:     []reachabilityRule{allowAll},
+			result:    isReachabilityResultDenied,
+		},
+		{
+			name:      "reply to part of the ephemeral ports",
+			direction: "outbound",
+			packet:    reply,
+			rules: []reachabilityRule{
+				{id: "rule-reply", name: "allow-reply", direction: "outbound", action: "allow", protocol: "tcp", sourcePortMin: 22, sourcePortMax: 22, destinationPortMin: 32768, destinationPortMax: 65535},
+			},
+			result: isReachabilityResultDenied,
+		},
+		{
+			name:      "reply to the ephemeral ports",
+			direction: "outbound",
+			packet:    reply,
+			rules: []reachabilityRule{
+				{id: "rule-reply", name: "allow-reply", direction: "outbound", action: "allow", protocol: "tcp", sourcePortMin: 22, sourcePortMax: 22, destinationPortMin: 1024, destinationPortMax: 65535},
+			},
+			result:   isReachabilityResultAllowed,
+			ruleName: "allow-reply",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			acl := reachabilityNetworkACL{id: "acl-id", name: "acl", rules: c.rules}
+			check := evaluateNetworkACL("destination_network_acl", c.direction, acl, c.packet)
+			if check.result != c.result || check.ruleName != c.ruleName {
+				t.Errorf("expected %s by rule %q, got %s by rule %q", c.result, c.ruleName, check.result, check.ruleName)
+			}
+		})
+	}
+}
+
+func TestSelectRoute(t *testing.T) {
+	routes := []reachabilityRoute{
+		{id: "default", action: "delegate", destination: testReachabilityCIDR(t, "0.0.0.0/0"), priority: 2},
+		{id: "private", action: "deliver", destination: testReachabilityCIDR(t, "10.0.0.0/8"), priority: 2},
+		{id: "private-preferred", action: "deliver", destination: testReachabilityCIDR(t, "10.0.0.0/8"), priority: 1},
+		{id: "blackhole", action: "drop", destination: testReachabilityCIDR(t, "192.168.10.0/24"), priority: 2},
+	}
+	cases := []struct {
+		name        string
+		routes      []reachabilityRoute
+		destination string
+		expected    string
+	}{
+		{"longest prefix", routes, "10.240.0.4", "private-preferred"},
+		{"default route", routes, "161.26.0.1", "default"},
+		{"drop route", routes, "192.168.10.4", "blackhole"},
+		{"drop route for part of the destination", routes, "192.168.0.0/16", "blackhole"},
+		{"no route", routes[1:], "161.26.0.1", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			route := selectRoute(c.routes, testReachabilityCIDR(t, c.destination))
+			id := ""
+			if route != nil {
+				id = route.id
+			}
+			if id != c.expected {
+				t.Errorf("expected route %q, got %q", c.expected, id)
+			}
+		})
+	}
+}
+
+func TestReachabilityDecision(t *testing.T) {
+	allowed := &reachabilityCheck{name: "allowed", result: isReachabilityResultAllowed}
+	lastAllowed := &reachabilityCheck{name: "last_allowed", result: isReachabilityResultAllowed}
+	denied := &reachabilityCheck{name: "denied", result: isReachabilityResultDenied}
+	notEvaluated := &reachabilityCheck{name: "not_evaluated", result: isReachabilityResultNotEvaluated}
+	cases := []struct {
+		name      string
+		checks    []*reachabilityCheck
+		allowed   bool
+		complete  bool
+		decidedBy *reachabilityCheck
+	}{
+		{"no check", nil, true, true, nil},
+		{"all allowed", []*reachabilityCheck{allowed, lastAllowed}, true, true, lastAllowed},
+		{"denied", []*reachabilityCheck{allowed, denied, lastAllowed}, false, true, denied},
+		{"not evaluated", []*reachabilityCheck{allowed, notEvaluated, lastAllowed}, true, false, lastAllowed},
+		{"denied after a check that is not evaluated", []*reachabilityCheck{notEvaluated, denied}, false, false, denied},
+		{"denied before a check that is not evaluated", []*reachabilityCheck{denied, notEvaluated}, false, true, denied},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			isAllowed, complete, decidedBy := reachabilityDecision(c.checks)
+			if isAllowed != c.allowed || complete != c.complete || decidedBy != c.decidedBy {
+				t.Errorf("expected %t, %t and %v, got %t, %t and %v", c.allowed, c.complete, c.decidedBy, isAllowed, complete, decidedBy)
+			}
+		})
+	}
+}
+
+func TestReachabilityEvaluateSubnetEndpoint(t *testing.T) {
+	subnet := &vpcv1.Subnet{ID: core.StringPtr("subnet-id")}
+	source := &reachabilityEndpoint{kind: isReachabilitySubnet, id: "subnet-id", addresses: testReachabilityCIDR(t, "10.240.0.0/24"), subnet: subnet}
+	destination := &reachabilityEndpoint{
+		kind:      isReachabilityVirtualNetworkInterface,
+		id:        "vni-id",
+		addresses: testReachabilityCIDR(t, "10.240.0.4"),
+		subnet:    subnet,
+		securityGroups: []reachabilitySecurityGroup{{id: "group-id", name: "web", rules: []reachabilityRule{
+			{id: "rule-id", direction: "inbound", action: "allow", protocol: "all"},
+		}}},
+	}
+
+	checks, err := (&reachabilityResolver{}).evaluate(source, destination, reachabilityTraffic{protocol: "tcp", port: 443})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(checks) != 2 || checks[0].name != "source_security_groups" || checks[0].result != isReachabilityResultNotEvaluated {
+		t.Fatalf("expected the security groups of the source subnet not to be evaluated, got %v", checks)
+	}
+	if allowed, complete, _ := reachabilityDecision(checks); !allowed || complete {
+		t.Errorf("expected the traffic to be allowed and the evaluation not to be complete, got %t and %t", allowed, complete)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISReachabilityDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfreach-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfreach-subnet-%d", acctest.RandIntRange(10, 100))
+	aclname := fmt.Sprintf("tfreach-acl-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISReachabilityDataSourceConfig(vpcname, subnetname, aclname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_reachability.allowed", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_reachability.allowed", "complete", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_reachability.denied", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_reachability.denied", "decided_by.0.name", "destination_network_acl"),
+					resource.TestCheckResourceAttr("data.ibm_is_reachability.denied", "decided_by.0.rule_name", "deny-ssh"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISReachabilityDataSourceConfig(vpcname, subnetname, aclname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_network_acl" "testacc_acl" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		rules {
+			name        = "deny-ssh"
+			action      = "deny"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+		rules {
+			name        = "allow-inbound"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+		}
+		rules {
+			name        = "allow-outbound"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "outbound"
+		}
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+		network_acl     = ibm_is_network_acl.testacc_acl.id
+	}
+
+	data "ibm_is_reachability" "allowed" {
+		source {
+			cidr = "10.0.0.0/8"
+		}
+		destination {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		protocol = "tcp"
+		port     = 443
+	}
+
+	data "ibm_is_reachability" "denied" {
+		source {
+			cidr = "10.0.0.0/8"
+		}
+		destination {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		protocol = "tcp"
+		port     = 22
+	}`, vpcname, aclname, subnetname, acc.ISZoneName, acc.ISCIDR)
+}
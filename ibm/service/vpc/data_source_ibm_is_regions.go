@@ -24,6 +24,7 @@ func DataSourceIBMISRegions() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISRegionsRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			isRegions: {
 				Type:        schema.TypeList,
@@ -64,6 +65,14 @@ func dataSourceIBMISRegionsRead(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_regions", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listRegionOptions := &vpcv1.ListRegionsOptions{}
 	regioncollection, _, err := sess.ListRegionsWithContext(context, listRegionOptions)
 	if err != nil {
@@ -83,6 +92,12 @@ func dataSourceIBMISRegionsRead(context context.Context, d *schema.ResourceData,
 		regionInfo = append(regionInfo, l)
 	}
 	d.SetId(dataSourceIBMISRegionsID(d))
+	regionInfo, err = filters.apply(context, meta, regionInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering regions: %s", err), "(Data) ibm_is_regions", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("regions", regionInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting regions: %s", err), "(Data) ibm_is_regions", "read", "set-regions").GetDiag()
 	}
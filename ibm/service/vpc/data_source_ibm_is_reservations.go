@@ -21,6 +21,7 @@ func DataSourceIBMIsReservations() *schema.Resource {
 		ReadContext: dataSourceIBMIsReservationsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "Filters the collection to resources with the exact specified name",
@@ -240,7 +241,17 @@ func dataSourceIBMIsReservationsRead(context context.Context, d *schema.Resource
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_reservations", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	zoneName := d.Get("zone_name").(string)
+	if zone, ok := filters.pushdown(isFilterZone); ok && zoneName == "" {
+		zoneName = zone
+	}
 	resourceGroupId := d.Get("resource_group").(string)
 
 	start := ""
@@ -284,7 +295,14 @@ func dataSourceIBMIsReservationsRead(context context.Context, d *schema.Resource
 	d.SetId(dataSourceIBMIsReservationsID(d))
 
 	if reservations != nil {
-		err = d.Set("reservations", dataSourceReservationCollectionFlattenReservations(reservations))
+		reservationsInfo := dataSourceReservationCollectionFlattenReservations(reservations)
+		reservationsInfo, err = filters.apply(context, meta, reservationsInfo)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering reservations: %s", err), "(Data) ibm_is_reservations", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("reservations", reservationsInfo)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reservations %s", err), "(Data) ibm_is_reservations", "read", "reservations-set").GetDiag()
 		}
@@ -20,6 +20,7 @@ func DataSourceIBMISSecurityGroupTargets() *schema.Resource {
 		ReadContext: dataSourceIBMISSecurityGroupTargetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			"security_group": {
 				Type:        schema.TypeString,
@@ -79,6 +80,13 @@ func dataSourceIBMISSecurityGroupTargetsRead(context context.Context, d *schema.
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_security_group_targets", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	securityGroupID := d.Get("security_group").(string)
 
 	// Support for pagination
@@ -126,6 +134,12 @@ func dataSourceIBMISSecurityGroupTargetsRead(context context.Context, d *schema.
 		}
 		targets = append(targets, tr)
 	}
+	targets, err = filters.apply(context, meta, targets)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering targets: %s", err), "(Data) ibm_is_security_group_targets", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("targets", targets); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting targets %s", err), "(Data) ibm_is_security_group_targets", "read", "targets-set").GetDiag()
 	}
@@ -22,6 +22,7 @@ func DataSourceIBMIsSecurityGroups() *schema.Resource {
 		ReadContext: dataSourceIBMIsSecurityGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -337,6 +338,13 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_security_groups", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	vpcId := d.Get("vpc_id").(string)
 	vpcCrn := d.Get("vpc_crn").(string)
@@ -377,8 +385,15 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		}
 	}
 
+	securityGroups := dataSourceSecurityGroupCollectionFlattenSecurityGroups(allrecs, d, meta)
+	securityGroups, err = filters.apply(context, meta, securityGroups)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering security groups: %s", err), "(Data) ibm_is_security_groups", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMIsSecurityGroupsID(d))
-	err = d.Set("security_groups", dataSourceSecurityGroupCollectionFlattenSecurityGroups(allrecs, d, meta))
+	err = d.Set("security_groups", securityGroups)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting security_groups %s", err), "(Data) ibm_is_security_groups", "read", "security_groups-set").GetDiag()
 	}
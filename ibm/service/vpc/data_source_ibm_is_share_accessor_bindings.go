@@ -26,6 +26,7 @@ func DataSourceIBMIsShareAccessorBindings() *schema.Resource {
 		ReadContext: dataSourceIBMIsShareAccessorBindingsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"share": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -172,6 +173,13 @@ func dataSourceIBMIsShareAccessorBindingsRead(context context.Context, d *schema
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_share_accessor_bindings", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listShareAccessorBindingsOptions := &vpcv1.ListShareAccessorBindingsOptions{}
 
 	listShareAccessorBindingsOptions.SetID(d.Get("share").(string))
@@ -203,6 +211,12 @@ func dataSourceIBMIsShareAccessorBindingsRead(context context.Context, d *schema
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering accessor bindings: %s", err), "(Data) ibm_is_share_accessor_bindings", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("accessor_bindings", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting accessor_bindings: %s", err), "(Data) ibm_is_share_accessor_bindings", "read", "set-accessor_bindings").GetDiag()
 	}
@@ -21,6 +21,7 @@ func DataSourceIBMIsShareTargets() *schema.Resource {
 		ReadContext: dataSourceIBMIsShareTargetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"share": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -289,6 +290,13 @@ func dataSourceIBMIsShareTargetsRead(context context.Context, d *schema.Resource
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_share_mount_targets", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.ShareMountTarget{}
 	listShareTargetsOptions := &vpcv1.ListShareMountTargetsOptions{}
@@ -317,7 +325,14 @@ func dataSourceIBMIsShareTargetsRead(context context.Context, d *schema.Resource
 	d.SetId(dataSourceIBMIsShareTargetsID(d))
 
 	if len(allrecs) > 0 {
-		err = d.Set("mount_targets", dataSourceShareMountTargetCollectionFlattenTargets(allrecs))
+		mountTargets := dataSourceShareMountTargetCollectionFlattenTargets(allrecs)
+		mountTargets, err = filters.apply(context, meta, mountTargets)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering mount targets: %s", err), "(Data) ibm_is_share_mount_targets", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("mount_targets", mountTargets)
 		if err != nil {
 			err = fmt.Errorf("Error setting mount_targets: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_share_mount_targets", "read", "set-mount_targets").GetDiag()
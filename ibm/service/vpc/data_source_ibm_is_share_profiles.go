@@ -21,6 +21,7 @@ func DataSourceIbmIsShareProfiles() *schema.Resource {
 		ReadContext: dataSourceIbmIsShareProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"profiles": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -157,6 +158,13 @@ func dataSourceIbmIsShareProfilesRead(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_share_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listShareProfilesOptions := &vpcv1.ListShareProfilesOptions{}
 
 	shareProfileCollection, response, err := vpcClient.ListShareProfilesWithContext(context, listShareProfilesOptions)
@@ -169,7 +177,14 @@ func dataSourceIbmIsShareProfilesRead(context context.Context, d *schema.Resourc
 	d.SetId(dataSourceIbmIsShareProfilesID(d))
 
 	if shareProfileCollection.Profiles != nil {
-		err = d.Set("profiles", dataSourceShareProfileCollectionFlattenProfiles(shareProfileCollection.Profiles))
+		profiles := dataSourceShareProfileCollectionFlattenProfiles(shareProfileCollection.Profiles)
+		profiles, err = filters.apply(context, meta, profiles)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering profiles: %s", err), "(Data) ibm_is_share_profiles", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("profiles", profiles)
 		if err != nil {
 			err = fmt.Errorf("Error setting profiles: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_share_profiles", "read", "set-profiles").GetDiag()
@@ -26,6 +26,7 @@ func DataSourceIBMIsShareSnapshots() *schema.Resource {
 		ReadContext: dataSourceIBMIsShareSnapshotsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"share": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -271,6 +272,13 @@ func dataSourceIBMIsShareSnapshotsRead(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_share_snapshots", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listShareSnapshotsOptions := &vpcv1.ListShareSnapshotsOptions{}
 
 	if shareIntf, ok := d.GetOk("share"); ok {
@@ -313,6 +321,12 @@ func dataSourceIBMIsShareSnapshotsRead(context context.Context, d *schema.Resour
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering snapshots: %s", err), "(Data) ibm_is_share_snapshots", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("snapshots", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting snapshots: %s", err), "(Data) ibm_is_share_snapshots", "read", "set-snapshots").GetDiag()
 	}
@@ -23,6 +23,7 @@ func DataSourceIbmIsShares() *schema.Resource {
 		ReadContext: dataSourceIbmIsSharesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -580,6 +581,13 @@ func dataSourceIbmIsSharesRead(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_shares", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	shareName := ""
 	if shareNameIntf, ok := d.GetOk("name"); ok {
 		shareName = shareNameIntf.(string)
@@ -626,6 +634,12 @@ func dataSourceIbmIsSharesRead(context context.Context, d *schema.ResourceData,
 		if err != nil {
 			return err
 		}
+		shares, errFilteringShares := filters.apply(context, meta, shares)
+		if errFilteringShares != nil {
+			tfErr := flex.TerraformErrorf(errFilteringShares, fmt.Sprintf("Error filtering shares: %s", errFilteringShares), "(Data) ibm_is_shares", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 		errSettingShares := d.Set("shares", shares)
 		if errSettingShares != nil {
 			errSettingShares = fmt.Errorf("Error setting shares: %s", errSettingShares)
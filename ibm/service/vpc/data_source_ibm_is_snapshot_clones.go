@@ -29,6 +29,7 @@ func DataSourceSnapshotClones() *schema.Resource {
 		ReadContext: dataSourceIBMISSnapshotClonesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isSnapshot: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -81,6 +82,13 @@ func getSnapshotClones(context context.Context, d *schema.ResourceData, meta int
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_snapshot_clones", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listSnapshotClonesOptions := &vpcv1.ListSnapshotClonesOptions{
 		ID: &id,
 	}
@@ -108,6 +116,12 @@ func getSnapshotClones(context context.Context, d *schema.ResourceData, meta int
 		clonesInfo = append(clonesInfo, l)
 	}
 	d.SetId(dataSourceIBMISSnapshotClonesID(d))
+	clonesInfo, err = filters.apply(context, meta, clonesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering clones: %s", err), "(Data) ibm_is_snapshot_clones", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("clones", clonesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting clones: %s", err), "(Data) ibm_is_snapshot_clones", "read", "set-clones").GetDiag()
 	}
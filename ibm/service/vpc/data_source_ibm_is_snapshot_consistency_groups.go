@@ -23,6 +23,7 @@ func DataSourceIBMIsSnapshotConsistencyGroups() *schema.Resource {
 		ReadContext: dataSourceIBMIsSnapshotConsistencyGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -257,6 +258,13 @@ func dataSourceIBMIsSnapshotConsistencyGroupsRead(context context.Context, d *sc
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_snapshot_consistency_groups", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.SnapshotConsistencyGroup{}
 	for {
@@ -360,6 +368,12 @@ func dataSourceIBMIsSnapshotConsistencyGroupsRead(context context.Context, d *sc
 		snapshotConsistencyGroupsInfo = append(snapshotConsistencyGroupsInfo, l)
 	}
 
+	snapshotConsistencyGroupsInfo, err = filters.apply(context, meta, snapshotConsistencyGroupsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering snapshot consistency groups: %s", err), "(Data) ibm_is_snapshot_consistency_groups", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMIsSnapshotConsistencyGroupsID(d))
 	if err = d.Set("snapshot_consistency_groups", snapshotConsistencyGroupsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting snapshot_consistency_groups %s", err), "(Data) ibm_is_snapshot_consistency_groups", "read", "snapshot_consistency_groups-set").GetDiag()
@@ -35,6 +35,7 @@ func DataSourceSnapshots() *schema.Resource {
 		ReadContext: dataSourceIBMISSnapshotsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			isSnapshotResourceGroup: {
 				Type:        schema.TypeString,
@@ -538,6 +539,19 @@ func getSnapshots(context context.Context, d *schema.ResourceData, meta interfac
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_snapshots", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	var tag string
+	if v, ok := d.GetOk("tag"); ok {
+		tag = v.(string)
+	} else if tagFilter, ok := filters.pushdownTag(); ok {
+		tag = tagFilter
+	}
 	start := ""
 	allrecs := []vpcv1.Snapshot{}
 	for {
@@ -565,9 +579,8 @@ func getSnapshots(context context.Context, d *schema.ResourceData, meta interfac
 			backupPolicyPlanIdFilter := backupPolicyPlanIdFilterOk.(string)
 			listSnapshotOptions.BackupPolicyPlanID = &backupPolicyPlanIdFilter
 		}
-		if tagFilterOk, ok := d.GetOk("tag"); ok {
-			tagFilter := tagFilterOk.(string)
-			listSnapshotOptions.Tag = &tagFilter
+		if tag != "" {
+			listSnapshotOptions.Tag = &tag
 		}
 		if copiesId, ok := d.GetOk(isSnapshotCopiesId); ok {
 			copiesIdFilter := copiesId.(string)
@@ -770,6 +783,12 @@ func getSnapshots(context context.Context, d *schema.ResourceData, meta interfac
 		l[isSnapshotAccessTags] = accesstags
 		snapshotsInfo = append(snapshotsInfo, l)
 	}
+	snapshotsInfo, err = filters.apply(context, meta, snapshotsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering snapshots: %s", err), "(Data) ibm_is_snapshots", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISSnapshotsID(d))
 	if err = d.Set("snapshots", snapshotsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting snapshots %s", err), "(Data) ibm_is_snapshots", "read", "snapshots-set").GetDiag()
@@ -34,6 +34,7 @@ func DataSourceIBMIsSshKeys() *schema.Resource {
 		ReadContext: dataSourceIBMIsSshKeysRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isKeys: &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -138,6 +139,13 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_ssh_keys", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.Key{}
 	listKeysOptions := &vpcv1.ListKeysOptions{}
@@ -163,8 +171,15 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 
 	}
 
+	keys := dataSourceKeyCollectionFlattenKeys(allrecs, d, meta)
+	keys, err = filters.apply(context, meta, keys)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering ssh keys: %s", err), "(Data) ibm_is_ssh_keys", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMIsSshKeysID(d))
-	err = d.Set(isKeys, dataSourceKeyCollectionFlattenKeys(allrecs, d, meta))
+	err = d.Set(isKeys, keys)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting keys %s", err), "(Data) ibm_is_ssh_keys", "read", "keys-set").GetDiag()
 	}
@@ -28,6 +28,7 @@ func DataSourceIBMISReservedIPs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSdataSourceIBMISReservedIPsRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			/*
 				Request Parameters
 				==================
@@ -174,6 +175,13 @@ func dataSdataSourceIBMISReservedIPsRead(context context.Context, d *schema.Reso
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_subnet_reserved_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	subnetID := d.Get(isSubNetID).(string)
 
 	// Flatten all the reserved IPs
@@ -231,6 +239,12 @@ func dataSdataSourceIBMISReservedIPsRead(context context.Context, d *schema.Reso
 	}
 
 	d.SetId(time.Now().UTC().String()) // This is not any reserved ip or subnet id but state id
+	reservedIPs, err = filters.apply(context, meta, reservedIPs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering reserved ips: %s", err), "(Data) ibm_is_subnet_reserved_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("reserved_ips", reservedIPs); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reserved_ips %s", err), "(Data) ibm_is_subnet_reserved_ips", "read", "reserved_ips-set").GetDiag()
 	}
@@ -31,6 +31,7 @@ func DataSourceIBMISSubnets() *schema.Resource {
 		ReadContext: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isSubnetResourceVpc: {
 				Type:        schema.TypeString,
 				Description: "ID of the VPC",
@@ -193,6 +194,13 @@ func subnetList(context context.Context, d *schema.ResourceData, meta interface{
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_subnets", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allrecs := []vpcv1.Subnet{}
 
@@ -215,6 +223,9 @@ func subnetList(context context.Context, d *schema.ResourceData, meta interface{
 	if v, ok := d.GetOk(isSubnetResourceZone); ok {
 		zone = v.(string)
 	}
+	if zoneFilter, ok := filters.pushdown(isFilterZone); ok && zone == "" {
+		zone = zoneFilter
+	}
 
 	var vpc string
 	if v, ok := d.GetOk(isSubnetResourceVpc); ok {
@@ -297,6 +308,12 @@ func subnetList(context context.Context, d *schema.ResourceData, meta interface{
 		}
 		subnetsInfo = append(subnetsInfo, l)
 	}
+	subnetsInfo, err = filters.apply(context, meta, subnetsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering subnets: %s", err), "(Data) ibm_is_subnets", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISSubnetsID(d))
 	if err = d.Set("subnets", subnetsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting subnets %s", err), "(Data) ibm_is_subnets", "read", "subnets-set").GetDiag()
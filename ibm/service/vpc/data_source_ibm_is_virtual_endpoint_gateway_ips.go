@@ -20,6 +20,7 @@ func DataSourceIBMISEndpointGatewayIPs() *schema.Resource {
 		ReadContext: dataSourceIBMISEndpointGatewayIPsRead,
 		Importer:    &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isVirtualEndpointGatewayID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -97,6 +98,14 @@ func dataSourceIBMISEndpointGatewayIPsRead(context context.Context, d *schema.Re
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_virtual_endpoint_gateway_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 
 	start := ""
@@ -133,6 +142,12 @@ func dataSourceIBMISEndpointGatewayIPsRead(context context.Context, d *schema.Re
 		endpointGatewayIPs = append(endpointGatewayIPs, ipsOutput)
 	}
 	d.SetId(dataSourceIBMISEndpointGatewayIPsCheckID(d))
+	endpointGatewayIPs, err = filters.apply(context, meta, endpointGatewayIPs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering ips: %s", err), "(Data) ibm_is_virtual_endpoint_gateway_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.Set(isVirtualEndpointGatewayIPs, endpointGatewayIPs)
 	return nil
 }
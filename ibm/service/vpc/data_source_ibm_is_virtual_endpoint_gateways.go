@@ -25,6 +25,7 @@ func DataSourceIBMISEndpointGateways() *schema.Resource {
 		ReadContext: dataSourceIBMISEndpointGatewaysRead,
 		Importer:    &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -207,6 +208,13 @@ func dataSourceIBMISEndpointGatewaysRead(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_virtual_endpoint_gateways", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allrecs := []vpcv1.EndpointGateway{}
 	options := sess.NewListEndpointGatewaysOptions()
@@ -218,6 +226,9 @@ func dataSourceIBMISEndpointGatewaysRead(context context.Context, d *schema.Reso
 		name := nameintf.(string)
 		options.Name = &name
 	}
+	if lifecycleStates, ok := filters.pushdownValues(isFilterStatus); ok {
+		options.LifecycleState = lifecycleStates
+	}
 	for {
 
 		if start != "" {
@@ -274,6 +285,12 @@ func dataSourceIBMISEndpointGatewaysRead(context context.Context, d *schema.Reso
 		endpointGatewayOutput[isVirtualEndpointGatewayAccessTags] = accesstags
 		endpointGateways = append(endpointGateways, endpointGatewayOutput)
 	}
+	endpointGateways, err = filters.apply(context, meta, endpointGateways)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering virtual endpoint gateways: %s", err), "(Data) ibm_is_virtual_endpoint_gateways", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISEndpointGatewaysCheckID(d))
 	d.Set(isVirtualEndpointGateways, endpointGateways)
 	return nil
@@ -22,6 +22,7 @@ func DataSourceIBMIsVirtualNetworkInterfaceFloatingIPs() *schema.Resource {
 		ReadContext: dataSourceIBMIsVirtualNetworkInterfaceFloatingIPsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"virtual_network_interface": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -86,6 +87,14 @@ func dataSourceIBMIsVirtualNetworkInterfaceFloatingIPsRead(context context.Conte
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_virtual_network_interface_floating_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	vniId := d.Get("virtual_network_interface").(string)
 
 	start := ""
@@ -129,6 +138,12 @@ func dataSourceIBMIsVirtualNetworkInterfaceFloatingIPsRead(context context.Conte
 	}
 	d.SetId(dataSourceIBMISVirtualNetworkInterfaceFloatingIPsID(d))
 
+	floatingIpsInfo, err = filters.apply(context, meta, floatingIpsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering floating ips: %s", err), "(Data) ibm_is_virtual_network_interface_floating_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("floating_ips", floatingIpsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting virtual_network_interfaces %s", err), "(Data) ibm_is_virtual_network_interface_floating_ips", "read", "floating_ips-set").GetDiag()
 	}
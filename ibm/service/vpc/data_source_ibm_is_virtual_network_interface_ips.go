@@ -21,6 +21,7 @@ func DataSourceIBMIsVirtualNetworkInterfaceIPs() *schema.Resource {
 		ReadContext: dataSourceIsVirtualNetworkInterfaceIPsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"virtual_network_interface": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -77,6 +78,13 @@ func dataSourceIsVirtualNetworkInterfaceIPsRead(context context.Context, d *sche
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_virtual_network_interface_ips", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listVirtualNetworkInterfaceIpsOptions := &vpcv1.ListVirtualNetworkInterfaceIpsOptions{}
 
 	listVirtualNetworkInterfaceIpsOptions.SetVirtualNetworkInterfaceID(d.Get("virtual_network_interface").(string))
@@ -106,6 +114,12 @@ func dataSourceIsVirtualNetworkInterfaceIPsRead(context context.Context, d *sche
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering reserved ips: %s", err), "(Data) ibm_is_virtual_network_interface_ips", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("reserved_ips", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting virtual_network_interfaces %s", err), "(Data) ibm_is_virtual_network_interface_ips", "read", "virtual_network_interfaces-set").GetDiag()
 	}
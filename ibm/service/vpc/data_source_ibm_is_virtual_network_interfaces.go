@@ -22,6 +22,7 @@ func DataSourceIBMIsVirtualNetworkInterfaces() *schema.Resource {
 		ReadContext: dataSourceIBMIsVirtualNetworkInterfacesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"virtual_network_interfaces": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -447,6 +448,13 @@ func dataSourceIBMIsVirtualNetworkInterfacesRead(context context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_virtual_network_interfaces", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listVirtualNetworkInterfacesOptions := &vpcv1.ListVirtualNetworkInterfacesOptions{}
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -491,6 +499,12 @@ func dataSourceIBMIsVirtualNetworkInterfacesRead(context context.Context, d *sch
 		mapSlice = append(mapSlice, modelMap)
 	}
 
+	mapSlice, err = filters.apply(context, meta, mapSlice)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering virtual network interfaces: %s", err), "(Data) ibm_is_virtual_network_interfaces", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("virtual_network_interfaces", mapSlice); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting virtual_network_interfaces %s", err), "(Data) ibm_is_virtual_network_interfaces", "read", "virtual_network_interfaces-set").GetDiag()
 	}
@@ -25,6 +25,7 @@ func DataSourceIBMISVolumeProfiles() *schema.Resource {
 		ReadContext: dataSourceIBMISVolumeProfilesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 
 			isVolumeProfiles: {
 				Type:        schema.TypeList,
@@ -252,6 +253,13 @@ func volumeProfilesList(context context.Context, d *schema.ResourceData, meta in
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_volume_profiles", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.VolumeProfile{}
 	for {
@@ -285,6 +293,12 @@ func volumeProfilesList(context context.Context, d *schema.ResourceData, meta in
 		}
 		profilesInfo = append(profilesInfo, modelMap)
 	}
+	profilesInfo, err = filters.apply(context, meta, profilesInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering profiles: %s", err), "(Data) ibm_is_volume_profiles", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("profiles", profilesInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting profiles %s", err), "(Data) ibm_is_volume_profiles", "read", "profiles-set").GetDiag()
 	}
@@ -98,6 +98,7 @@ func DataSourceIBMIsVolumes() *schema.Resource {
 		ReadContext: dataSourceIBMIsVolumesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isVolumeAttachmentState: &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -656,6 +657,13 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_volumes", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	// filters - volume-name and zone-name
 	volumeName := d.Get("volume_name").(string)
 	zoneName := d.Get("zone_name").(string)
@@ -673,9 +681,15 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	if volumeName != "" {
 		listVolumesOptions.Name = &volumeName
 	}
+	if zone, ok := filters.pushdown(isFilterZone); ok && zoneName == "" {
+		zoneName = zone
+	}
 	if zoneName != "" {
 		listVolumesOptions.ZoneName = &zoneName
 	}
+	if tag, ok := filters.pushdownTag(); ok {
+		listVolumesOptions.Tag = &tag
+	}
 	if attachmentState != "" {
 		listVolumesOptions.AttachmentState = &attachmentState
 	}
@@ -710,9 +724,16 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 
 	}
 
+	volumes := dataSourceVolumeCollectionFlattenVolumes(allrecs, meta)
+	volumes, err = filters.apply(context, meta, volumes)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering volumes: %s", err), "(Data) ibm_is_volumes", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMIsVolumesID(d))
 
-	err = d.Set(isVolumes, dataSourceVolumeCollectionFlattenVolumes(allrecs, meta))
+	err = d.Set(isVolumes, volumes)
 	if err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting volumes %s", err), "(Data) ibm_is_volumes", "read", "volumes-set").GetDiag()
 	}
@@ -42,6 +42,24 @@ func TestAccIBMIsVolumesDataSourceBasic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMIsVolumesDataSourceFilterByTag(t *testing.T) {
+	name := fmt.Sprintf("tf-vol-filter-%d", acctest.RandIntRange(10, 100))
+	tag := fmt.Sprintf("tf-vol-filter:%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVolumesDataSourceConfigFilterByTag(name, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.is_volumes", "volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.is_volumes", "volumes.0.name", name),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMIsVolumesDataSourceSdpBasic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acc.TestAccPreCheck(t) },
@@ -179,6 +197,30 @@ func testAccCheckIBMIsVolumesDataSourceConfigFilterByZone() string {
 	`)
 }
 
+func testAccCheckIBMIsVolumesDataSourceConfigFilterByTag(name, tag string) string {
+	// A single tag is matched by the list volumes API, so the new volume is
+	// found without waiting for Global Search to index its tags.
+	return fmt.Sprintf(`
+		resource "ibm_is_volume" "storage" {
+			name    = "%s"
+			profile = "10iops-tier"
+			zone    = "%s"
+			tags    = ["%s"]
+		}
+
+		data "ibm_is_volumes" "is_volumes" {
+			filter {
+				name   = "tag"
+				values = ["%s"]
+			}
+			filter {
+				name   = "crn_prefix"
+				values = [ibm_is_volume.storage.crn]
+			}
+		}
+	`, name, acc.ISZoneName, tag, tag)
+}
+
 func testAccCheckIBMIsVolumesDataSourceConfigFilterByName() string {
 	return fmt.Sprintf(`
 		data "ibm_is_volumes" "is_volumes" {
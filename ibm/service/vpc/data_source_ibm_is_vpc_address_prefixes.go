@@ -22,6 +22,7 @@ func DataSourceIbmIsVpcAddressPrefixes() *schema.Resource {
 		ReadContext: dataSourceIbmIsVpcAddressPrefixRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"vpc": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -108,6 +109,13 @@ func dataSourceIbmIsVpcAddressPrefixRead(ctx context.Context, d *schema.Resource
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_prefixes", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.AddressPrefix{}
 	for {
@@ -161,7 +169,14 @@ func dataSourceIbmIsVpcAddressPrefixRead(ctx context.Context, d *schema.Resource
 	}
 
 	if matchAddressPrefixes != nil {
-		err = d.Set("address_prefixes", dataSourceAddressPrefixCollectionFlattenAddressPrefixes(matchAddressPrefixes))
+		addressPrefixes := dataSourceAddressPrefixCollectionFlattenAddressPrefixes(matchAddressPrefixes)
+		addressPrefixes, err = filters.apply(ctx, meta, addressPrefixes)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering address prefixes: %s", err), "(Data) ibm_is_vpc_address_prefixes", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("address_prefixes", addressPrefixes)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting address_prefixes %s", err), "(Data) ibm_is_vpc_address_prefixes", "read", "address_prefixes-set").GetDiag()
 		}
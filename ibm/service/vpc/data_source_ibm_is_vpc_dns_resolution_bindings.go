@@ -36,6 +36,7 @@ func DataSourceIBMIsVPCDnsResolutionBindings() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMIsVPCDnsResolutionBindingsRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isVPCDnsResolutionBindings: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -282,6 +283,14 @@ func dataSourceIBMIsVPCDnsResolutionBindingsRead(context context.Context, d *sch
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_dns_resolution_bindings", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listVPCDnsResolutionBindingOptions := &vpcv1.ListVPCDnsResolutionBindingsOptions{}
 
 	listVPCDnsResolutionBindingOptions.SetVPCID(d.Get(isVPCDnsResolutionBindingVpcId).(string))
@@ -357,6 +366,12 @@ func dataSourceIBMIsVPCDnsResolutionBindingsRead(context context.Context, d *sch
 		}
 	}
 	d.SetId(dataSourceIBMIsVPCDnsResolutionBindingsId(d))
+	vpcdnsResolutionBindingsInfo, err = filters.apply(context, meta, vpcdnsResolutionBindingsInfo)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering dns resolution bindings: %s", err), "(Data) ibm_is_vpc_dns_resolution_bindings", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isVPCDnsResolutionBindings, vpcdnsResolutionBindingsInfo); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting dns_resolution_bindings %s", err), "(Data) ibm_is_vpc_dns_resolution_bindings", "read", "dns_resolution_bindings-set").GetDiag()
 	}
@@ -0,0 +1,830 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPCImportBlocksVPC               = "vpc"
+	isVPCImportBlocksImportBlocks      = "import_blocks"
+	isVPCImportBlocksResourceSkeletons = "resource_skeletons"
+	isVPCImportBlocksResources         = "resources"
+	isVPCImportBlocksNotImported       = "not_imported"
+	isVPCImportBlocksType              = "type"
+	isVPCImportBlocksName              = "name"
+	isVPCImportBlocksID                = "id"
+	isVPCImportBlocksImportID          = "import_id"
+	isVPCImportBlocksAddress           = "address"
+	isVPCImportBlocksReason            = "reason"
+)
+
+// vpcImportResourceTypes are the resources that the VPC is walked for, whose
+// importers are checked before any import block is generated for them.
+var vpcImportResourceTypes = map[string]func() *schema.Resource{
+	"ibm_is_vpc":                        ResourceIBMISVPC,
+	"ibm_is_vpc_address_prefix":         ResourceIBMISVpcAddressPrefix,
+	"ibm_is_public_gateway":             ResourceIBMISPublicGateway,
+	"ibm_is_subnet":                     ResourceIBMISSubnet,
+	"ibm_is_security_group":             ResourceIBMISSecurityGroup,
+	"ibm_is_security_group_rule":        ResourceIBMISSecurityGroupRule,
+	"ibm_is_network_acl":                ResourceIBMISNetworkACL,
+	"ibm_is_network_acl_rule":           ResourceIBMISNetworkACLRule,
+	"ibm_is_vpc_routing_table":          ResourceIBMISVPCRoutingTable,
+	"ibm_is_vpc_routing_table_route":    ResourceIBMISVPCRoutingTableRoute,
+	"ibm_is_lb":                         ResourceIBMISLB,
+	"ibm_is_lb_listener":                ResourceIBMISLBListener,
+	"ibm_is_lb_pool":                    ResourceIBMISLBPool,
+	"ibm_is_lb_pool_member":             ResourceIBMISLBPoolMember,
+	"ibm_is_instance":                   ResourceIBMISInstance,
+	"ibm_is_volume":                     ResourceIBMISVolume,
+	"ibm_is_instance_volume_attachment": ResourceIBMISInstanceVolumeAttachment,
+}
+
+func DataSourceIBMISVPCImportBlocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPCImportBlocksRead,
+
+		Schema: map[string]*schema.Schema{
+			isVPCImportBlocksVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the VPC to generate import blocks for",
+			},
+			isVPCImportBlocksImportBlocks: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The import blocks of the resources of the VPC",
+			},
+			isVPCImportBlocksResourceSkeletons: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource blocks that match the import blocks, with their main arguments",
+			},
+			isVPCImportBlocksResources: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources that import blocks are generated for",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPCImportBlocksType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource",
+						},
+						isVPCImportBlocksName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource in the configuration",
+						},
+						isVPCImportBlocksAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address of the resource in the configuration",
+						},
+						isVPCImportBlocksImportID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID that the resource is imported with",
+						},
+					},
+				},
+			},
+			isVPCImportBlocksNotImported: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources of the VPC that no import block is generated for",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPCImportBlocksType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource",
+						},
+						isVPCImportBlocksID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource",
+						},
+						isVPCImportBlocksName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource",
+						},
+						isVPCImportBlocksReason: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason why no import block is generated for the resource",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPCImportBlocksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_import_blocks", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID := d.Get(isVPCImportBlocksVPC).(string)
+	generator := &vpcImportGenerator{
+		sess:      sess,
+		context:   context,
+		addresses: map[string]string{},
+		names:     map[string]bool{},
+		importers: map[string]bool{},
+	}
+	for resourceType, resource := range vpcImportResourceTypes {
+		generator.importers[resourceType] = resource().Importer != nil
+	}
+	if err = generator.walk(vpcID); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error walking VPC %s: %s", vpcID, err.Error()), "(Data) ibm_is_vpc_import_blocks", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(vpcID)
+	if err = d.Set(isVPCImportBlocksImportBlocks, generator.importBlocks()); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting import_blocks: %s", err), "(Data) ibm_is_vpc_import_blocks", "read", "set-import_blocks").GetDiag()
+	}
+	if err = d.Set(isVPCImportBlocksResourceSkeletons, generator.resourceSkeletons()); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting resource_skeletons: %s", err), "(Data) ibm_is_vpc_import_blocks", "read", "set-resource_skeletons").GetDiag()
+	}
+	resources := make([]map[string]interface{}, 0, len(generator.resources))
+	for _, resource := range generator.resources {
+		resources = append(resources, map[string]interface{}{
+			isVPCImportBlocksType:     resource.resourceType,
+			isVPCImportBlocksName:     resource.name,
+			isVPCImportBlocksAddress:  resource.address(),
+			isVPCImportBlocksImportID: resource.importID,
+		})
+	}
+	if err = d.Set(isVPCImportBlocksResources, resources); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting resources: %s", err), "(Data) ibm_is_vpc_import_blocks", "read", "set-resources").GetDiag()
+	}
+	if err = d.Set(isVPCImportBlocksNotImported, generator.notImported); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting not_imported: %s", err), "(Data) ibm_is_vpc_import_blocks", "read", "set-not_imported").GetDiag()
+	}
+	return nil
+}
+
+// vpcImportResource is a resource that an import block and a resource
+// skeleton are generated for. The lines of the body are indented when the
+// skeleton is rendered.
+type vpcImportResource struct {
+	resourceType string
+	name         string
+	importID     string
+	body         []string
+}
+
+func (r *vpcImportResource) address() string {
+	return fmt.Sprintf("%s.%s", r.resourceType, r.name)
+}
+
+// vpcImportGenerator walks a VPC and collects the resources to import, in an
+// order where the resources that others refer to come first.
+type vpcImportGenerator struct {
+	sess        *vpcv1.VpcV1
+	context     context.Context
+	resources   []*vpcImportResource
+	notImported []map[string]interface{}
+	// addresses are the addresses of the generated resources by API ID, so
+	// that the skeletons refer to each other.
+	addresses map[string]string
+	names     map[string]bool
+	importers map[string]bool
+}
+
+var vpcImportNameInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// add adds a resource with its import ID and returns it, or returns nil when
+// the type of the resource has no importer.
+func (g *vpcImportGenerator) add(resourceType, id, name, importID string, body ...string) *vpcImportResource {
+	if !g.importers[resourceType] {
+		g.skip(resourceType, id, name, "The resource has no importer")
+		return nil
+	}
+	tfName := vpcImportNameInvalid.ReplaceAllString(strings.ToLower(name), "_")
+	tfName = strings.Trim(tfName, "_")
+	if tfName == "" || (tfName[0] >= '0' && tfName[0] <= '9') {
+		tfName = "r_" + tfName
+	}
+	unique := tfName
+	for i := 2; g.names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", tfName, i)
+	}
+	g.names[resourceType+"."+unique] = true
+
+	resource := &vpcImportResource{resourceType: resourceType, name: unique, importID: importID, body: body}
+	g.resources = append(g.resources, resource)
+	g.addresses[id] = resource.address()
+	return resource
+}
+
+func (g *vpcImportGenerator) skip(resourceType, id, name, reason string) {
+	g.notImported = append(g.notImported, map[string]interface{}{
+		isVPCImportBlocksType:   resourceType,
+		isVPCImportBlocksID:     id,
+		isVPCImportBlocksName:   name,
+		isVPCImportBlocksReason: reason,
+	})
+}
+
+// ref returns a reference to the ID of a generated resource, or the ID
+// itself when the resource is not generated.
+func (g *vpcImportGenerator) ref(id string) string {
+	if address, ok := g.addresses[id]; ok {
+		return address + ".id"
+	}
+	return vpcImportString(id)
+}
+
+func (g *vpcImportGenerator) walk(vpcID string) error {
+	vpc, response, err := g.sess.GetVPCWithContext(g.context, &vpcv1.GetVPCOptions{ID: &vpcID})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting VPC %s: %s\n%s", vpcID, err, response)
+	}
+	g.add("ibm_is_vpc", vpcID, *vpc.Name, vpcID,
+		vpcImportAttr("name", vpcImportString(*vpc.Name)),
+	)
+	defaults := map[string]string{}
+	if vpc.DefaultSecurityGroup != nil {
+		defaults[*vpc.DefaultSecurityGroup.ID] = "default_security_group"
+	}
+	if vpc.DefaultNetworkACL != nil {
+		defaults[*vpc.DefaultNetworkACL.ID] = "default_network_acl"
+	}
+	if vpc.DefaultRoutingTable != nil {
+		defaults[*vpc.DefaultRoutingTable.ID] = "default_routing_table"
+	}
+
+	for _, walk := range []func(string, map[string]string) error{
+		g.walkAddressPrefixes,
+		g.walkPublicGateways,
+		g.walkNetworkACLs,
+		g.walkRoutingTables,
+		g.walkSubnets,
+		g.walkSecurityGroups,
+		g.walkLoadBalancers,
+		g.walkInstances,
+	} {
+		if err := walk(vpcID, defaults); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *vpcImportGenerator) walkAddressPrefixes(vpcID string, _ map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListVPCAddressPrefixesOptions{}
+		options.SetVPCID(vpcID)
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListVPCAddressPrefixesWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the address prefixes of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, prefix := range result.AddressPrefixes {
+			if prefix.IsDefault != nil && *prefix.IsDefault {
+				g.skip("ibm_is_vpc_address_prefix", *prefix.ID, *prefix.Name, "The default address prefix of the zone is created and deleted with the VPC")
+				continue
+			}
+			g.add("ibm_is_vpc_address_prefix", *prefix.ID, *prefix.Name, fmt.Sprintf("%s/%s", vpcID, *prefix.ID),
+				vpcImportAttr("name", vpcImportString(*prefix.Name)),
+				vpcImportAttr("vpc", g.ref(vpcID)),
+				vpcImportAttr("zone", vpcImportString(*prefix.Zone.Name)),
+				vpcImportAttr("cidr", vpcImportString(*prefix.CIDR)),
+			)
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkPublicGateways(vpcID string, _ map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListPublicGatewaysOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListPublicGatewaysWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the public gateways: %s\n%s", err, response)
+		}
+		for _, gateway := range result.PublicGateways {
+			if *gateway.VPC.ID != vpcID {
+				continue
+			}
+			g.add("ibm_is_public_gateway", *gateway.ID, *gateway.Name, *gateway.ID,
+				vpcImportAttr("name", vpcImportString(*gateway.Name)),
+				vpcImportAttr("vpc", g.ref(vpcID)),
+				vpcImportAttr("zone", vpcImportString(*gateway.Zone.Name)),
+			)
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkNetworkACLs(vpcID string, defaults map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListNetworkAclsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListNetworkAclsWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the network ACLs: %s\n%s", err, response)
+		}
+		for _, acl := range result.NetworkAcls {
+			if *acl.VPC.ID != vpcID {
+				continue
+			}
+			if reason, ok := defaults[*acl.ID]; ok {
+				g.skip("ibm_is_network_acl", *acl.ID, *acl.Name, fmt.Sprintf("The %s of the VPC is created and deleted with it, its rules are imported", reason))
+			} else {
+				g.add("ibm_is_network_acl", *acl.ID, *acl.Name, *acl.ID,
+					vpcImportAttr("name", vpcImportString(*acl.Name)),
+					vpcImportAttr("vpc", g.ref(vpcID)),
+				)
+			}
+			for _, ruleIntf := range acl.Rules {
+				var id, name, action, direction, source, destination string
+				switch rule := ruleIntf.(type) {
+				case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+					id, name, action, direction, source, destination = *rule.ID, *rule.Name, *rule.Action, *rule.Direction, *rule.Source, *rule.Destination
+				case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+					id, name, action, direction, source, destination = *rule.ID, *rule.Name, *rule.Action, *rule.Direction, *rule.Source, *rule.Destination
+				case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+					id, name, action, direction, source, destination = *rule.ID, *rule.Name, *rule.Action, *rule.Direction, *rule.Source, *rule.Destination
+				default:
+					continue
+				}
+				g.add("ibm_is_network_acl_rule", id, fmt.Sprintf("%s_%s", *acl.Name, name), makeTerraformACLRuleID(*acl.ID, id),
+					vpcImportAttr("network_acl", g.ref(*acl.ID)),
+					vpcImportAttr("name", vpcImportString(name)),
+					vpcImportAttr("action", vpcImportString(action)),
+					vpcImportAttr("direction", vpcImportString(direction)),
+					vpcImportAttr("source", vpcImportString(source)),
+					vpcImportAttr("destination", vpcImportString(destination)),
+				)
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkRoutingTables(vpcID string, defaults map[string]string) error {
+	start := ""
+	for {
+		options := g.sess.NewListVPCRoutingTablesOptions(vpcID)
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListVPCRoutingTablesWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the routing tables of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, table := range result.RoutingTables {
+			if reason, ok := defaults[*table.ID]; ok {
+				g.skip("ibm_is_vpc_routing_table", *table.ID, *table.Name, fmt.Sprintf("The %s of the VPC is created and deleted with it, its routes are imported", reason))
+			} else {
+				g.add("ibm_is_vpc_routing_table", *table.ID, *table.Name, fmt.Sprintf("%s/%s", vpcID, *table.ID),
+					vpcImportAttr("name", vpcImportString(*table.Name)),
+					vpcImportAttr("vpc", g.ref(vpcID)),
+				)
+			}
+			if err := g.walkRoutes(vpcID, *table.ID); err != nil {
+				return err
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkRoutes(vpcID, tableID string) error {
+	start := ""
+	for {
+		options := g.sess.NewListVPCRoutingTableRoutesOptions(vpcID, tableID)
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListVPCRoutingTableRoutesWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the routes of routing table %s: %s\n%s", tableID, err, response)
+		}
+		for _, route := range result.Routes {
+			if route.Origin != nil && *route.Origin != "user" {
+				g.skip("ibm_is_vpc_routing_table_route", *route.ID, *route.Name, fmt.Sprintf("The route is managed by the %s origin", *route.Origin))
+				continue
+			}
+			body := []string{
+				vpcImportAttr("vpc", g.ref(vpcID)),
+				vpcImportAttr("routing_table", g.routingTableRef(tableID)),
+				vpcImportAttr("name", vpcImportString(*route.Name)),
+				vpcImportAttr("zone", vpcImportString(*route.Zone.Name)),
+				vpcImportAttr("destination", vpcImportString(*route.Destination)),
+				vpcImportAttr("action", vpcImportString(*route.Action)),
+			}
+			if nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && nextHop != nil {
+				if nextHop.Address != nil {
+					body = append(body, vpcImportAttr("next_hop", vpcImportString(*nextHop.Address)))
+				} else if nextHop.ID != nil {
+					body = append(body, vpcImportAttr("next_hop", vpcImportString(*nextHop.ID)))
+				}
+			}
+			g.add("ibm_is_vpc_routing_table_route", *route.ID, *route.Name, fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID), body...)
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+// routingTableRef returns a reference to the ID of a routing table, which is
+// not the ID of the resource, since that is a composite ID.
+func (g *vpcImportGenerator) routingTableRef(tableID string) string {
+	if address, ok := g.addresses[tableID]; ok {
+		return address + ".routing_table"
+	}
+	return vpcImportString(tableID)
+}
+
+func (g *vpcImportGenerator) walkSubnets(vpcID string, _ map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetsOptions{}
+		options.SetVPCID(vpcID)
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListSubnetsWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the subnets of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, subnet := range result.Subnets {
+			body := []string{
+				vpcImportAttr("name", vpcImportString(*subnet.Name)),
+				vpcImportAttr("vpc", g.ref(vpcID)),
+				vpcImportAttr("zone", vpcImportString(*subnet.Zone.Name)),
+				vpcImportAttr("ipv4_cidr_block", vpcImportString(*subnet.Ipv4CIDRBlock)),
+			}
+			if subnet.NetworkACL != nil {
+				body = append(body, vpcImportAttr("network_acl", g.ref(*subnet.NetworkACL.ID)))
+			}
+			if subnet.PublicGateway != nil {
+				body = append(body, vpcImportAttr("public_gateway", g.ref(*subnet.PublicGateway.ID)))
+			}
+			if subnet.RoutingTable != nil {
+				body = append(body, vpcImportAttr("routing_table", g.routingTableRef(*subnet.RoutingTable.ID)))
+			}
+			g.add("ibm_is_subnet", *subnet.ID, *subnet.Name, *subnet.ID, body...)
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkSecurityGroups(vpcID string, defaults map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListSecurityGroupsOptions{VPCID: &vpcID}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListSecurityGroupsWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the security groups of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, group := range result.SecurityGroups {
+			if reason, ok := defaults[*group.ID]; ok {
+				g.skip("ibm_is_security_group", *group.ID, *group.Name, fmt.Sprintf("The %s of the VPC is created and deleted with it, its rules are imported", reason))
+			} else {
+				g.add("ibm_is_security_group", *group.ID, *group.Name, *group.ID,
+					vpcImportAttr("name", vpcImportString(*group.Name)),
+					vpcImportAttr("vpc", g.ref(vpcID)),
+				)
+			}
+		}
+		// The rules are added once all the groups are, since their remotes
+		// can refer to any group of the VPC.
+		for _, group := range result.SecurityGroups {
+			for _, ruleIntf := range group.Rules {
+				id, rule := flattenSecurityGroupInlineRule(ruleIntf)
+				if rule == nil {
+					continue
+				}
+				body := []string{
+					vpcImportAttr("group", g.ref(*group.ID)),
+					vpcImportAttr("direction", vpcImportString(rule[isSecurityGroupRuleDirection].(string))),
+				}
+				if remote := rule[isSecurityGroupRuleRemote].(string); remote != "" {
+					body = append(body, vpcImportAttr("remote", g.ref(remote)))
+				}
+				for _, protocol := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+					blocks := rule[protocol].([]interface{})
+					if len(blocks) == 0 {
+						continue
+					}
+					body = append(body, protocol+" {")
+					for _, key := range []string{isSecurityGroupRuleType, isSecurityGroupRuleCode, isSecurityGroupRulePortMin, isSecurityGroupRulePortMax} {
+						if v, ok := blocks[0].(map[string]interface{})[key]; ok {
+							body = append(body, vpcImportAttr(key, strconv.Itoa(v.(int))))
+						}
+					}
+					body = append(body, "}")
+				}
+				g.add("ibm_is_security_group_rule", id, fmt.Sprintf("%s_%s", *group.Name, rule[isSecurityGroupRuleDirection]), makeTerraformRuleID(*group.ID, id), body...)
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkLoadBalancers(vpcID string, _ map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListLoadBalancersOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListLoadBalancersWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the load balancers: %s\n%s", err, response)
+		}
+		for _, lb := range result.LoadBalancers {
+			subnets := []string{}
+			inVPC := false
+			for _, subnet := range lb.Subnets {
+				// The subnets of the VPC were generated before the load
+				// balancers, so that a subnet with an address is in the VPC.
+				if _, ok := g.addresses[*subnet.ID]; ok {
+					inVPC = true
+				}
+				subnets = append(subnets, g.ref(*subnet.ID))
+			}
+			if !inVPC {
+				continue
+			}
+			lbType := "private"
+			if lb.IsPublic != nil && *lb.IsPublic {
+				lbType = "public"
+			}
+			g.add("ibm_is_lb", *lb.ID, *lb.Name, *lb.ID,
+				vpcImportAttr("name", vpcImportString(*lb.Name)),
+				vpcImportAttr("type", vpcImportString(lbType)),
+				vpcImportAttr("subnets", "["+strings.Join(subnets, ", ")+"]"),
+			)
+			if err := g.walkLoadBalancerPools(*lb.ID, *lb.Name); err != nil {
+				return err
+			}
+			if err := g.walkLoadBalancerListeners(*lb.ID, *lb.Name); err != nil {
+				return err
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) walkLoadBalancerPools(lbID, lbName string) error {
+	pools, response, err := g.sess.ListLoadBalancerPoolsWithContext(g.context, &vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: &lbID})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the pools of load balancer %s: %s\n%s", lbID, err, response)
+	}
+	for _, pool := range pools.Pools {
+		body := []string{
+			vpcImportAttr("lb", g.ref(lbID)),
+			vpcImportAttr("name", vpcImportString(*pool.Name)),
+			vpcImportAttr("algorithm", vpcImportString(*pool.Algorithm)),
+			vpcImportAttr("protocol", vpcImportString(*pool.Protocol)),
+		}
+		if monitor, ok := pool.HealthMonitor.(*vpcv1.LoadBalancerPoolHealthMonitor); ok && monitor != nil {
+			body = append(body,
+				vpcImportAttr("health_delay", strconv.FormatInt(*monitor.Delay, 10)),
+				vpcImportAttr("health_retries", strconv.FormatInt(*monitor.MaxRetries, 10)),
+				vpcImportAttr("health_timeout", strconv.FormatInt(*monitor.Timeout, 10)),
+				vpcImportAttr("health_type", vpcImportString(*monitor.Type)),
+			)
+		}
+		g.add("ibm_is_lb_pool", *pool.ID, fmt.Sprintf("%s_%s", lbName, *pool.Name), fmt.Sprintf("%s/%s", lbID, *pool.ID), body...)
+
+		members, response, err := g.sess.ListLoadBalancerPoolMembersWithContext(g.context, &vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: &lbID, PoolID: pool.ID})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the members of pool %s: %s\n%s", *pool.ID, err, response)
+		}
+		for _, member := range members.Members {
+			body := []string{
+				vpcImportAttr("lb", g.ref(lbID)),
+				vpcImportAttr("pool", g.ref(*pool.ID)),
+				vpcImportAttr("port", strconv.FormatInt(*member.Port, 10)),
+			}
+			if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok && target != nil {
+				if target.Address != nil {
+					body = append(body, vpcImportAttr("target_address", vpcImportString(*target.Address)))
+				} else if target.ID != nil {
+					body = append(body, vpcImportAttr("target_id", g.ref(*target.ID)))
+				}
+			}
+			g.add("ibm_is_lb_pool_member", *member.ID, fmt.Sprintf("%s_%s_member", lbName, *pool.Name), fmt.Sprintf("%s/%s/%s", lbID, *pool.ID, *member.ID), body...)
+		}
+	}
+	return nil
+}
+
+func (g *vpcImportGenerator) walkLoadBalancerListeners(lbID, lbName string) error {
+	listeners, response, err := g.sess.ListLoadBalancerListenersWithContext(g.context, &vpcv1.ListLoadBalancerListenersOptions{LoadBalancerID: &lbID})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the listeners of load balancer %s: %s\n%s", lbID, err, response)
+	}
+	for _, listener := range listeners.Listeners {
+		body := []string{
+			vpcImportAttr("lb", g.ref(lbID)),
+			vpcImportAttr("protocol", vpcImportString(*listener.Protocol)),
+		}
+		if listener.Port != nil {
+			body = append(body, vpcImportAttr("port", strconv.FormatInt(*listener.Port, 10)))
+		}
+		if listener.DefaultPool != nil {
+			body = append(body, vpcImportAttr("default_pool", g.ref(*listener.DefaultPool.ID)))
+		}
+		name := fmt.Sprintf("%s_%s", lbName, *listener.Protocol)
+		if listener.Port != nil {
+			name = fmt.Sprintf("%s_%d", name, *listener.Port)
+		}
+		g.add("ibm_is_lb_listener", *listener.ID, name, fmt.Sprintf("%s/%s", lbID, *listener.ID), body...)
+	}
+	return nil
+}
+
+func (g *vpcImportGenerator) walkInstances(vpcID string, _ map[string]string) error {
+	start := ""
+	for {
+		options := &vpcv1.ListInstancesOptions{VPCID: &vpcID}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := g.sess.ListInstancesWithContext(g.context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the instances of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, instance := range result.Instances {
+			body := []string{
+				vpcImportAttr("name", vpcImportString(*instance.Name)),
+				vpcImportAttr("vpc", g.ref(vpcID)),
+				vpcImportAttr("zone", vpcImportString(*instance.Zone.Name)),
+				vpcImportAttr("profile", vpcImportString(*instance.Profile.Name)),
+			}
+			if instance.Image != nil {
+				body = append(body, vpcImportAttr("image", vpcImportString(*instance.Image.ID)))
+			}
+			if instance.PrimaryNetworkInterface != nil {
+				body = append(body,
+					"primary_network_interface {",
+					vpcImportAttr("subnet", g.ref(*instance.PrimaryNetworkInterface.Subnet.ID)),
+					"}",
+				)
+			} else if instance.PrimaryNetworkAttachment != nil {
+				body = append(body,
+					"primary_network_attachment {",
+					vpcImportAttr("name", vpcImportString(*instance.PrimaryNetworkAttachment.Name)),
+					"virtual_network_interface {",
+					vpcImportAttr("subnet", g.ref(*instance.PrimaryNetworkAttachment.Subnet.ID)),
+					"}",
+					"}",
+				)
+			}
+			g.add("ibm_is_instance", *instance.ID, *instance.Name, *instance.ID, body...)
+
+			for _, attachment := range instance.VolumeAttachments {
+				if attachment.Volume == nil {
+					continue
+				}
+				if instance.BootVolumeAttachment != nil && *attachment.ID == *instance.BootVolumeAttachment.ID {
+					g.skip("ibm_is_volume", *attachment.Volume.ID, *attachment.Volume.Name, "The boot volume is managed with the boot_volume block of the instance")
+					continue
+				}
+				if err := g.addVolume(*instance.ID, *instance.Name, attachment); err != nil {
+					return err
+				}
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (g *vpcImportGenerator) addVolume(instanceID, instanceName string, attachment vpcv1.VolumeAttachmentReferenceInstanceContext) error {
+	volumeID := *attachment.Volume.ID
+	volume, response, err := g.sess.GetVolumeWithContext(g.context, &vpcv1.GetVolumeOptions{ID: &volumeID})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting volume %s: %s\n%s", volumeID, err, response)
+	}
+	body := []string{
+		vpcImportAttr("name", vpcImportString(*volume.Name)),
+		vpcImportAttr("profile", vpcImportString(*volume.Profile.Name)),
+		vpcImportAttr("zone", vpcImportString(*volume.Zone.Name)),
+		vpcImportAttr("capacity", strconv.FormatInt(*volume.Capacity, 10)),
+	}
+	if *volume.Profile.Name == "custom" && volume.Iops != nil {
+		body = append(body, vpcImportAttr("iops", strconv.FormatInt(*volume.Iops, 10)))
+	}
+	g.add("ibm_is_volume", volumeID, *volume.Name, volumeID, body...)
+	g.add("ibm_is_instance_volume_attachment", *attachment.ID, fmt.Sprintf("%s_%s", instanceName, *attachment.Name), makeTerraformVolAttID(instanceID, *attachment.ID),
+		vpcImportAttr("instance", g.ref(instanceID)),
+		vpcImportAttr("name", vpcImportString(*attachment.Name)),
+		vpcImportAttr("volume", g.ref(volumeID)),
+	)
+	return nil
+}
+
+// importBlocks renders the import blocks of the resources.
+func (g *vpcImportGenerator) importBlocks() string {
+	var b strings.Builder
+	for i, resource := range g.resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = %s\n  id = %s\n}\n", resource.address(), vpcImportString(resource.importID))
+	}
+	return b.String()
+}
+
+// resourceSkeletons renders the resource blocks of the resources, whose body
+// lines are indented by their nesting.
+func (g *vpcImportGenerator) resourceSkeletons() string {
+	var b strings.Builder
+	for i, resource := range g.resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "resource %q %q {\n", resource.resourceType, resource.name)
+		depth := 1
+		for _, line := range resource.body {
+			if line == "}" {
+				depth--
+			}
+			fmt.Fprintf(&b, "%s%s\n", strings.Repeat("  ", depth), line)
+			if strings.HasSuffix(line, "{") {
+				depth++
+			}
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func vpcImportAttr(name, value string) string {
+	return fmt.Sprintf("%s = %s", name, value)
+}
+
+// vpcImportString returns s as an HCL string literal, whose template sequences are
+// escaped.
+func vpcImportString(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
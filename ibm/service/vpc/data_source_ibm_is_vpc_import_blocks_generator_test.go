@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// testVPCImportGenerator returns a generator whose client is served the
+// responses by path, with the VPC vpc-id already generated.
+func testVPCImportGenerator(t *testing.T, responses map[string]string) *vpcImportGenerator {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g := &vpcImportGenerator{
+		sess:      sess,
+		context:   context.Background(),
+		addresses: map[string]string{},
+		names:     map[string]bool{},
+		importers: map[string]bool{},
+	}
+	for resourceType, resource := range vpcImportResourceTypes {
+		g.importers[resourceType] = resource().Importer != nil
+	}
+	g.add("ibm_is_vpc", "vpc-id", "vpc", "vpc-id", vpcImportAttr("name", vpcImportString("vpc")))
+	return g
+}
+
+func testVPCImportIDs(g *vpcImportGenerator) map[string]string {
+	ids := map[string]string{}
+	for _, resource := range g.resources {
+		ids[resource.address()] = resource.importID
+	}
+	return ids
+}
+
+func TestVPCImportString(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected string
+	}{
+		{"web", `"web"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`c:\path`, `"c:\\path"`},
+		{"line\nbreak", `"line\nbreak"`},
+		{"${var.name}", `"$${var.name}"`},
+		{"%{ if true }", `"%%{ if true }"`},
+		{"$5 and 100%", `"$5 and 100%"`},
+	}
+	for _, c := range cases {
+		t.Run(c.s, func(t *testing.T) {
+			if s := vpcImportString(c.s); s != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, s)
+			}
+		})
+	}
+}
+
+func TestVPCImportGeneratorNames(t *testing.T) {
+	g := testVPCImportGenerator(t, nil)
+	for _, name := range []string{"Web Tier", "web-tier", "1st", "${}"} {
+		g.add("ibm_is_subnet", name+"-id", name, name+"-id")
+	}
+	g.importers["ibm_is_subnet"] = false
+	if g.add("ibm_is_subnet", "other-id", "other", "other-id") != nil {
+		t.Errorf("expected a resource type without importer not to be generated")
+	}
+
+	addresses := []string{}
+	for _, resource := range g.resources[1:] {
+		addresses = append(addresses, resource.address())
+	}
+	expected := "ibm_is_subnet.web_tier ibm_is_subnet.web_tier_2 ibm_is_subnet.r_1st ibm_is_subnet.r_"
+	if strings.Join(addresses, " ") != expected {
+		t.Errorf("expected %s, got %s", expected, strings.Join(addresses, " "))
+	}
+	if len(g.notImported) != 1 || g.notImported[0][isVPCImportBlocksID] != "other-id" {
+		t.Errorf("expected the resource without importer not to be imported, got %v", g.notImported)
+	}
+}
+
+func TestVPCImportGeneratorSecurityGroups(t *testing.T) {
+	g := testVPCImportGenerator(t, map[string]string{
+		"/security_groups": `{"limit": 50, "first": {"href": "https://vpc"}, "security_groups": [
+			{"id": "default-sg-id", "name": "default", "vpc": {"id": "vpc-id"}, "rules": []},
+			{"id": "sg-id", "name": "web ${env}", "vpc": {"id": "vpc-id"}, "rules": [
+				{"id": "rule-id", "direction": "inbound", "ip_version": "ipv4", "protocol": "tcp", "port_min": 22, "port_max": 22, "remote": {"id": "default-sg-id"}},
+				{"id": "other-rule-id", "direction": "outbound", "ip_version": "ipv4", "protocol": "all", "remote": {"cidr_block": "0.0.0.0/0"}}
+			]}
+		]}`,
+	})
+	if err := g.walkSecurityGroups("vpc-id", map[string]string{"default-sg-id": "default_security_group"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := testVPCImportIDs(g)
+	expected := map[string]string{
+		"ibm_is_vpc.vpc":                              "vpc-id",
+		"ibm_is_security_group.web_env":               "sg-id",
+		"ibm_is_security_group_rule.web_env_inbound":  "sg-id.rule-id",
+		"ibm_is_security_group_rule.web_env_outbound": "sg-id.other-rule-id",
+	}
+	if len(ids) != len(expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	for address, id := range expected {
+		if ids[address] != id {
+			t.Errorf("expected %s to be imported with %s, got %q", address, id, ids[address])
+		}
+	}
+	if len(g.notImported) != 1 || g.notImported[0][isVPCImportBlocksID] != "default-sg-id" {
+		t.Errorf("expected the default security group not to be imported, got %v", g.notImported)
+	}
+
+	skeletons := g.resourceSkeletons()
+	for _, expected := range []string{
+		"resource \"ibm_is_security_group\" \"web_env\" {\n  name = \"web $${env}\"\n  vpc = ibm_is_vpc.vpc.id\n}\n",
+		"resource \"ibm_is_security_group_rule\" \"web_env_inbound\" {\n  group = ibm_is_security_group.web_env.id\n  direction = \"inbound\"\n  remote = \"default-sg-id\"\n  tcp {\n    port_min = 22\n    port_max = 22\n  }\n}\n",
+		"resource \"ibm_is_security_group_rule\" \"web_env_outbound\" {\n  group = ibm_is_security_group.web_env.id\n  direction = \"outbound\"\n  remote = \"0.0.0.0/0\"\n}\n",
+	} {
+		if !strings.Contains(skeletons, expected) {
+			t.Errorf("expected the skeletons to contain\n%s\ngot\n%s", expected, skeletons)
+		}
+	}
+	if blocks := g.importBlocks(); !strings.Contains(blocks, "import {\n  to = ibm_is_security_group_rule.web_env_inbound\n  id = \"sg-id.rule-id\"\n}\n") {
+		t.Errorf("expected the import block of the rule, got\n%s", blocks)
+	}
+}
+
+func TestVPCImportGeneratorNetworkACLs(t *testing.T) {
+	g := testVPCImportGenerator(t, map[string]string{
+		"/network_acls": `{"limit": 50, "first": {"href": "https://vpc"}, "network_acls": [
+			{"id": "other-acl-id", "name": "other", "vpc": {"id": "other-vpc-id"}, "rules": []},
+			{"id": "acl-id", "name": "acl", "vpc": {"id": "vpc-id"}, "rules": [
+				{"id": "rule-id", "name": "allow \"all\"", "action": "allow", "direction": "inbound", "ip_version": "ipv4", "protocol": "all", "source": "0.0.0.0/0", "destination": "10.0.0.0/8"}
+			]}
+		]}`,
+	})
+	if err := g.walkNetworkACLs("vpc-id", map[string]string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := testVPCImportIDs(g)
+	if len(ids) != 3 || ids["ibm_is_network_acl.acl"] != "acl-id" || ids["ibm_is_network_acl_rule.acl_allow_all"] != "acl-id/rule-id" {
+		t.Errorf("expected the ACL and its rule, got %v", ids)
+	}
+	expected := "resource \"ibm_is_network_acl_rule\" \"acl_allow_all\" {\n  network_acl = ibm_is_network_acl.acl.id\n  name = \"allow \\\"all\\\"\"\n  action = \"allow\"\n  direction = \"inbound\"\n  source = \"0.0.0.0/0\"\n  destination = \"10.0.0.0/8\"\n}\n"
+	if skeletons := g.resourceSkeletons(); !strings.Contains(skeletons, expected) {
+		t.Errorf("expected the skeletons to contain\n%s\ngot\n%s", expected, skeletons)
+	}
+}
+
+func TestVPCImportGeneratorVolumeAttachments(t *testing.T) {
+	g := testVPCImportGenerator(t, map[string]string{
+		"/instances": `{"limit": 50, "first": {"href": "https://vpc"}, "instances": [
+			{"id": "instance-id", "name": "app", "zone": {"name": "us-south-1"}, "profile": {"name": "bx2-2x8"}, "image": {"id": "image-id"},
+			 "primary_network_interface": {"id": "nic-id", "subnet": {"id": "subnet-id"}},
+			 "boot_volume_attachment": {"id": "boot-attachment-id"},
+			 "volume_attachments": [
+				{"id": "boot-attachment-id", "name": "boot", "volume": {"id": "boot-volume-id", "name": "app-boot"}},
+				{"id": "attachment-id", "name": "data", "volume": {"id": "volume-id", "name": "app-data"}}
+			 ]}
+		]}`,
+		"/volumes/volume-id": `{"id": "volume-id", "name": "app-data", "capacity": 100, "iops": 3000, "profile": {"name": "custom"}, "zone": {"name": "us-south-1"}}`,
+	})
+	if err := g.walkInstances("vpc-id", map[string]string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := testVPCImportIDs(g)
+	expected := map[string]string{
+		"ibm_is_vpc.vpc":                             "vpc-id",
+		"ibm_is_instance.app":                        "instance-id",
+		"ibm_is_volume.app_data":                     "volume-id",
+		"ibm_is_instance_volume_attachment.app_data": "instance-id/attachment-id",
+	}
+	if len(ids) != len(expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	for address, id := range expected {
+		if ids[address] != id {
+			t.Errorf("expected %s to be imported with %s, got %q", address, id, ids[address])
+		}
+	}
+	if len(g.notImported) != 1 || g.notImported[0][isVPCImportBlocksID] != "boot-volume-id" {
+		t.Errorf("expected the boot volume not to be imported, got %v", g.notImported)
+	}
+
+	skeletons := g.resourceSkeletons()
+	for _, expected := range []string{
+		"resource \"ibm_is_instance\" \"app\" {\n  name = \"app\"\n  vpc = ibm_is_vpc.vpc.id\n  zone = \"us-south-1\"\n  profile = \"bx2-2x8\"\n  image = \"image-id\"\n  primary_network_interface {\n    subnet = \"subnet-id\"\n  }\n}\n",
+		"resource \"ibm_is_volume\" \"app_data\" {\n  name = \"app-data\"\n  profile = \"custom\"\n  zone = \"us-south-1\"\n  capacity = 100\n  iops = 3000\n}\n",
+		"resource \"ibm_is_instance_volume_attachment\" \"app_data\" {\n  instance = ibm_is_instance.app.id\n  name = \"data\"\n  volume = ibm_is_volume.app_data.id\n}\n",
+	} {
+		if !strings.Contains(skeletons, expected) {
+			t.Errorf("expected the skeletons to contain\n%s\ngot\n%s", expected, skeletons)
+		}
+	}
+}
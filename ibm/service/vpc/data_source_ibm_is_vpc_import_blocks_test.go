@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCImportBlocksDataSource_basic(t *testing.T) {
+	dataSourceName := "data.ibm_is_vpc_import_blocks.testacc_import"
+	vpcname := fmt.Sprintf("tfimport-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfimport-subnet-%d", acctest.RandIntRange(10, 100))
+	sgname := fmt.Sprintf("tfimport-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCImportBlocksDataSourceConfig(vpcname, subnetname, sgname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "import_blocks", regexp.MustCompile(`to = ibm_is_subnet\.tfimport_subnet_\d+`)),
+					resource.TestMatchResourceAttr(dataSourceName, "import_blocks", regexp.MustCompile(`to = ibm_is_security_group_rule\.tfimport_sg_\d+_inbound\n  id = "r\d+-[^."]+\.[^"]+"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "resource_skeletons", regexp.MustCompile(`resource "ibm_is_subnet" "tfimport_subnet_\d+" \{\n  name = "tfimport-subnet-\d+"\n  vpc = ibm_is_vpc\.tfimport_vpc_\d+\.id`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "not_imported.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCImportBlocksDataSourceConfig(vpcname, subnetname, sgname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_sg" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_rule" {
+		group     = ibm_is_security_group.testacc_sg.id
+		direction = "inbound"
+		tcp {
+			port_min = 443
+			port_max = 443
+		}
+	}
+
+	data "ibm_is_vpc_import_blocks" "testacc_import" {
+		vpc        = ibm_is_vpc.testacc_vpc.id
+		depends_on = [ibm_is_subnet.testacc_subnet, ibm_is_security_group_rule.testacc_rule]
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sgname)
+}
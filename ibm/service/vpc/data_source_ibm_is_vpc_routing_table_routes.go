@@ -34,6 +34,7 @@ func DataSourceIBMISVPCRoutingTableRoutes() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISVPCRoutingTableRoutesList,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isRoutingTableRouteVpcID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -173,6 +174,13 @@ func dataSourceIBMISVPCRoutingTableRoutesList(context context.Context, d *schema
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_routing_table_routes", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	vpcID := d.Get(isRoutingTableRouteVpcID).(string)
 	routingTableID := d.Get(isRouteTableID).(string)
 	start := ""
@@ -266,6 +274,12 @@ func dataSourceIBMISVPCRoutingTableRoutesList(context context.Context, d *schema
 	if err = d.Set(isRouteTableID, routingTableID); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting routing_table: %s", err), "(Data) ibm_is_vpc_routing_table_routes", "read", "set-routing_table").GetDiag()
 	}
+	vpcRoutingTableRoutes, err = filters.apply(context, meta, vpcRoutingTableRoutes)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering routes: %s", err), "(Data) ibm_is_vpc_routing_table_routes", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set(isRoutingTableRoutes, vpcRoutingTableRoutes); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting dns_resolution_bindings %s", err), "(Data) ibm_is_vpc_routing_table_routes", "read", "routes-set").GetDiag()
 	}
@@ -41,6 +41,7 @@ func DataSourceIBMISVPCRoutingTables() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISVPCRoutingTablesList,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			isVpcID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -231,6 +232,13 @@ func dataSourceIBMISVPCRoutingTablesList(context context.Context, d *schema.Reso
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_routing_tables", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	vpcID := d.Get(isVpcID).(string)
 	listOptions := sess.NewListVPCRoutingTablesOptions(vpcID)
 
@@ -362,6 +370,12 @@ func dataSourceIBMISVPCRoutingTablesList(context context.Context, d *schema.Reso
 	if err = d.Set(isVpcID, vpcID); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpc: %s", err), "(Data) ibm_is_vpc_routing_tables", "read", "set-vpc").GetDiag()
 	}
+	vpcRoutingTables, err = filters.apply(context, meta, vpcRoutingTables)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering routing tables: %s", err), "(Data) ibm_is_vpc_routing_tables", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("routing_tables", vpcRoutingTables); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting routing_tables %s", err), "(Data) ibm_is_vpc_routing_tables", "read", "routing_tables-set").GetDiag()
 	}
@@ -29,6 +29,7 @@ func DataSourceIBMISVPCs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISVPCListRead,
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -524,6 +525,13 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpcs", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	start := ""
 	allrecs := []vpcv1.VPC{}
 	listOptions := &vpcv1.ListVpcsOptions{}
@@ -866,6 +874,12 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 
 		vpcs = append(vpcs, l)
 	}
+	vpcs, err = filters.apply(context, meta, vpcs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering vpcs: %s", err), "(Data) ibm_is_vpcs", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMISVPCsID(d))
 	if err = d.Set(isVPCs, vpcs); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpcs %s", err), "(Data) ibm_is_vpcs", "read", "vpcs-set").GetDiag()
@@ -57,6 +57,25 @@ func TestAccIBMISVPCsDatasource_basicDefaultAddressPrefixes(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPCsDatasource_filter(t *testing.T) {
+	node := "data.ibm_is_vpcs.test1"
+	vpcname := fmt.Sprintf("tf-vpcname-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDSCheckIBMISVPCsFilterConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "vpcs.#", "1"),
+					resource.TestCheckResourceAttr(node, "vpcs.0.name", vpcname),
+					resource.TestCheckResourceAttr(node, "vpcs.0.status", "available"),
+				),
+			},
+		},
+	})
+}
+
 func testDSCheckIBMISVPCsConfig(vpcname string) string {
 	return fmt.Sprintf(`
 
@@ -83,3 +102,30 @@ func testDSCheckIBMISVPCsConfig1(vpcname, apm string) string {
 
 	`, vpcname, apm)
 }
+
+func testDSCheckIBMISVPCsFilterConfig(vpcname string) string {
+	// The VPC is matched by its CRN rather than by a tag filter, which
+	// depends on Global Search indexing the tags of the new VPC.
+	return fmt.Sprintf(`
+
+	resource "ibm_is_vpc" "test_vpc1" {
+		name = "%s"
+	}
+
+	data "ibm_is_vpcs" "test1" {
+		filter {
+			name   = "crn_prefix"
+			values = [ibm_is_vpc.test_vpc1.crn]
+		}
+		filter {
+			name   = "name_regex"
+			values = ["^${ibm_is_vpc.test_vpc1.name}$"]
+		}
+		filter {
+			name   = "status"
+			values = ["available"]
+		}
+	}
+
+	`, vpcname)
+}
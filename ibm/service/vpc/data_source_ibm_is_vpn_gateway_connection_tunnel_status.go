@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isVPNTunnelStatusSampleCount = "sample_count"
+	isVPNTunnelStatusInterval    = "interval"
+	isVPNTunnelStatusSamples     = "samples"
+	isVPNTunnelStatusSampledAt   = "sampled_at"
+	isVPNTunnelStatusReasonCode  = "reason_codes"
+)
+
+func DataSourceIBMISVPNGatewayConnectionTunnelStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPNGatewayConnectionTunnelStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway identifier.",
+			},
+			"vpn_gateway_connection": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway connection identifier.",
+			},
+			isVPNTunnelStatusSampleCount: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 30),
+				Description:  "The number of times the status of the tunnels is sampled during the read of the data source. The read takes (sample_count - 1) * interval seconds.",
+			},
+			isVPNTunnelStatusInterval: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 60),
+				Description:  "The time in seconds between the samples of the status of the tunnels.",
+			},
+			isVPNGatewayConnectionStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the VPN gateway connection at the last sample.",
+			},
+			isVPNGatewayConnectionStatusreasons: dataSourceIBMISVPNTunnelStatusReasonsSchema("The reasons for the status of the VPN gateway connection at the last sample."),
+			isVPNTunnelStatusSamples:            dataSourceIBMISVPNTunnelStatusSamplesSchema("The status of the VPN gateway connection at each sample taken during the read."),
+			isVPNGatewayConnectionTunnels: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tunnels of the VPN gateway connection (in static route mode), one per member of the VPN gateway.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the VPN gateway member in which the tunnel resides.",
+						},
+						"member_role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The high availability role of the VPN gateway member in which the tunnel resides.",
+						},
+						"member_health_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health state of the VPN gateway member in which the tunnel resides.",
+						},
+						isVPNGatewayConnectionStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the tunnel at the last sample.",
+						},
+						isVPNGatewayConnectionStatusreasons: dataSourceIBMISVPNTunnelStatusReasonsSchema("The reasons for the status of the tunnel at the last sample, such as the peer or the IKE negotiation failures."),
+						isVPNTunnelStatusSamples:            dataSourceIBMISVPNTunnelStatusSamplesSchema("The status of the tunnel at each sample taken during the read."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNTunnelStatusReasonsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"code": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "A snake case string succinctly identifying the status reason.",
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "An explanation of the reason for this status.",
+				},
+				"more_info": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Link to documentation about this status reason.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNTunnelStatusSamplesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				isVPNTunnelStatusSampledAt: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time of the sample.",
+				},
+				isVPNGatewayConnectionStatus: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status at the sample.",
+				},
+				isVPNTunnelStatusReasonCode: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The codes of the status reasons at the sample.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNGatewayConnectionTunnelStatusRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	gID := d.Get("vpn_gateway").(string)
+	gConnID := d.Get("vpn_gateway_connection").(string)
+	vpnGatewayIntf, _, err := sess.GetVPNGatewayWithContext(context, &vpcv1.GetVPNGatewayOptions{ID: &gID})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVPNGatewayWithContext failed: %s", err.Error()), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	members := map[string]vpcv1.VPNGatewayMember{}
+	if vpnGateway, ok := vpnGatewayIntf.(*vpcv1.VPNGateway); ok {
+		for _, member := range vpnGateway.Members {
+			if member.PublicIP != nil && member.PublicIP.Address != nil {
+				members[*member.PublicIP.Address] = member
+			}
+		}
+	}
+
+	// The API only returns the current status of the tunnels, so that the
+	// samples are all taken during this read and none are kept between reads.
+	sampleCount := d.Get(isVPNTunnelStatusSampleCount).(int)
+	interval := time.Duration(d.Get(isVPNTunnelStatusInterval).(int)) * time.Second
+	var last *vpnGatewayConnectionTunnelStatus
+	samples := []map[string]interface{}{}
+	tunnels := []map[string]interface{}{}
+	tunnelsByAddress := map[string]map[string]interface{}{}
+	for i := 0; i < sampleCount; i++ {
+		if i > 0 {
+			select {
+			case <-context.Done():
+				return flex.TerraformErrorf(context.Err(), context.Err().Error(), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read").GetDiag()
+			case <-time.After(interval):
+			}
+		}
+		status, err := getVPNGatewayConnectionTunnelStatus(context, sess, gID, gConnID)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("getVPNGatewayConnectionTunnelStatus failed: %s", err.Error()), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		last = status
+		sampledAt := time.Now().UTC().Format(time.RFC3339)
+
+		codes := []string{}
+		for _, reason := range status.statusReasons {
+			codes = append(codes, flex.StringValue(reason.Code))
+		}
+		samples = append(samples, map[string]interface{}{
+			isVPNTunnelStatusSampledAt:   sampledAt,
+			isVPNGatewayConnectionStatus: status.status,
+			isVPNTunnelStatusReasonCode:  codes,
+		})
+
+		for _, tunnel := range status.tunnels {
+			address := ""
+			if tunnel.PublicIP != nil {
+				address = flex.StringValue(tunnel.PublicIP.Address)
+			}
+			tunnelMap, ok := tunnelsByAddress[address]
+			if !ok {
+				tunnelMap = map[string]interface{}{
+					"public_ip":              address,
+					isVPNTunnelStatusSamples: []map[string]interface{}{},
+				}
+				if member, ok := members[address]; ok {
+					tunnelMap["member_role"] = flex.StringValue(member.Role)
+					tunnelMap["member_health_state"] = flex.StringValue(member.HealthState)
+				}
+				tunnelsByAddress[address] = tunnelMap
+				tunnels = append(tunnels, tunnelMap)
+			}
+			tunnelCodes := []string{}
+			for _, reason := range tunnel.StatusReasons {
+				tunnelCodes = append(tunnelCodes, flex.StringValue(reason.Code))
+			}
+			tunnelMap[isVPNGatewayConnectionStatus] = flex.StringValue(tunnel.Status)
+			tunnelMap[isVPNGatewayConnectionStatusreasons] = dataSourceVPNGatewayConnectionFlattenTunnelStatusReasons(tunnel.StatusReasons)
+			tunnelMap[isVPNTunnelStatusSamples] = append(tunnelMap[isVPNTunnelStatusSamples].([]map[string]interface{}), map[string]interface{}{
+				isVPNTunnelStatusSampledAt:   sampledAt,
+				isVPNGatewayConnectionStatus: flex.StringValue(tunnel.Status),
+				isVPNTunnelStatusReasonCode:  tunnelCodes,
+			})
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gID, gConnID))
+	if err = d.Set(isVPNGatewayConnectionStatus, last.status); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting status: %s", err), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read", "set-status").GetDiag()
+	}
+	if err = d.Set(isVPNGatewayConnectionStatusreasons, resourceVPNGatewayConnectionFlattenLifecycleReasons(last.statusReasons)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting status_reasons: %s", err), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read", "set-status_reasons").GetDiag()
+	}
+	if err = d.Set(isVPNTunnelStatusSamples, samples); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting samples: %s", err), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read", "set-samples").GetDiag()
+	}
+	if err = d.Set(isVPNGatewayConnectionTunnels, tunnels); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting tunnels: %s", err), "(Data) ibm_is_vpn_gateway_connection_tunnel_status", "read", "set-tunnels").GetDiag()
+	}
+	return nil
+}
+
+func dataSourceVPNGatewayConnectionFlattenTunnelStatusReasons(statusReasons []vpcv1.VPNGatewayConnectionTunnelStatusReason) []map[string]interface{} {
+	statusReasonsList := make([]map[string]interface{}, 0)
+	for _, reason := range statusReasons {
+		reasonMap := map[string]interface{}{
+			"code":    flex.StringValue(reason.Code),
+			"message": flex.StringValue(reason.Message),
+		}
+		if reason.MoreInfo != nil {
+			reasonMap["more_info"] = *reason.MoreInfo
+		}
+		statusReasonsList = append(statusReasonsList, reasonMap)
+	}
+	return statusReasonsList
+}
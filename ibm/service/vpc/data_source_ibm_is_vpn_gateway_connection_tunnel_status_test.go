@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPNGatewayConnectionTunnelStatusDataSourceBasic(t *testing.T) {
+	vpcname1 := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname1 := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(100, 200))
+	vpnname1 := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(100, 200))
+	name1 := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(100, 200))
+
+	vpcname2 := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname2 := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(100, 200))
+	vpnname2 := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(100, 200))
+	name2 := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNGatewayConnectionTunnelStatusDataSourceConfig(vpcname1, subnetname1, vpnname1, name1, vpcname2, subnetname2, vpnname2, name2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "status", "up"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "samples.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "tunnels.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "tunnels.0.status", "up"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "tunnels.0.samples.#", "2"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "tunnels.0.public_ip"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "tunnels.0.member_role"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_tunnel_status.example", "tunnels.0.samples.0.sampled_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNGatewayConnectionTunnelStatusDataSourceConfig(vpc1, subnet1, vpnname1, name1, vpc2, subnet2, vpnname2, name2 string) string {
+	return testAccCheckIBMISVPNGatewayConnectionWaitForTunnelUpConfig(vpc1, subnet1, vpnname1, name1, vpc2, subnet2, vpnname2, name2) + `
+	data "ibm_is_vpn_gateway_connection_tunnel_status" "example" {
+		vpn_gateway            = ibm_is_vpn_gateway.testacc_VPNGateway2.id
+		vpn_gateway_connection = ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection2.gateway_connection
+		sample_count           = 2
+		interval               = 5
+	}
+	`
+}
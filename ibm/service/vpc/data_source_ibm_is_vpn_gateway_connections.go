@@ -27,6 +27,7 @@ func DataSourceIBMISVPNGatewayConnections() *schema.Resource {
 		ReadContext: dataSourceIBMVPNGatewayConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -304,6 +305,14 @@ func dataSourceIBMVPNGatewayConnectionsRead(context context.Context, d *schema.R
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateway_connections", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	vpngatewayID := d.Get(isVPNGatewayID).(string)
 	listvpnGWConnectionOptions := sess.NewListVPNGatewayConnectionsOptions(vpngatewayID)
 	if statusIntf, ok := d.GetOk("status"); ok {
@@ -326,6 +335,12 @@ func dataSourceIBMVPNGatewayConnectionsRead(context context.Context, d *schema.R
 	}
 
 	d.SetId(dataSourceIBMVPNGatewayConnectionsID(d))
+	vpngatewayconnections, err = filters.apply(context, meta, vpngatewayconnections)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering connections: %s", err), "(Data) ibm_is_vpn_gateway_connections", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if err = d.Set("connections", vpngatewayconnections); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting connections %s", err), "(Data) ibm_is_vpn_gateway_connections", "read", "connections-set").GetDiag()
 	}
@@ -26,6 +26,7 @@ func DataSourceIBMISVPNGateways() *schema.Resource {
 		ReadContext: dataSourceIBMVPNGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -249,6 +250,13 @@ func dataSourceIBMVPNGatewaysRead(context context.Context, d *schema.ResourceDat
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateways", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listvpnGWOptions := sess.NewListVPNGatewaysOptions()
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -330,6 +338,12 @@ func dataSourceIBMVPNGatewaysRead(context context.Context, d *schema.ResourceDat
 		vpngateways = append(vpngateways, gateway)
 	}
 
+	vpngateways, err = filters.apply(context, meta, vpngateways)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering vpn gateways: %s", err), "(Data) ibm_is_vpn_gateways", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	d.SetId(dataSourceIBMVPNGatewaysID(d))
 	if err = d.Set("vpn_gateways", vpngateways); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpn_gateways %s", err), "(Data) ibm_is_vpn_gateways", "read", "vpn_gateways-set").GetDiag()
@@ -22,6 +22,7 @@ func DataSourceIBMIsVPNServerClients() *schema.Resource {
 		ReadContext: dataSourceIBMIsVPNServerClientsRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"vpn_server": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -121,6 +122,13 @@ func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.Reso
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_server_clients", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.VPNServerClient{}
 
@@ -146,7 +154,14 @@ func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.Reso
 	d.SetId(dataSourceIBMIsVPNServerClientsID(d))
 
 	if allrecs != nil {
-		err = d.Set("clients", dataSourceVPNServerClientCollectionFlattenClients(allrecs))
+		clients := dataSourceVPNServerClientCollectionFlattenClients(allrecs)
+		clients, err = filters.apply(context, meta, clients)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering clients: %s", err), "(Data) ibm_is_vpn_server_clients", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("clients", clients)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting clients %s", err), "(Data) ibm_is_vpn_server_clients", "read", "clients-set").GetDiag()
 		}
@@ -22,6 +22,7 @@ func DataSourceIBMIsVPNServerRoutes() *schema.Resource {
 		ReadContext: dataSourceIBMIsVPNServerRoutesRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"vpn_server": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -144,6 +145,13 @@ func dataSourceIBMIsVPNServerRoutesRead(context context.Context, d *schema.Resou
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_server_routes", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	start := ""
 	allrecs := []vpcv1.VPNServerRoute{}
 
@@ -170,7 +178,14 @@ func dataSourceIBMIsVPNServerRoutesRead(context context.Context, d *schema.Resou
 	d.SetId(dataSourceIBMIsVPNServerRoutesID(d))
 
 	if allrecs != nil {
-		err = d.Set("routes", dataSourceVPNServerRouteCollectionFlattenRoutes(allrecs))
+		routes := dataSourceVPNServerRouteCollectionFlattenRoutes(allrecs)
+		routes, err = filters.apply(context, meta, routes)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering routes: %s", err), "(Data) ibm_is_vpn_server_routes", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("routes", routes)
 		if err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_server_routes", "read", "VPNServers-to-map").GetDiag()
 		}
@@ -22,6 +22,7 @@ func DataSourceIBMIsVPNServers() *schema.Resource {
 		ReadContext: dataSourceIBMIsVPNServersRead,
 
 		Schema: map[string]*schema.Schema{
+			isFilter: dataSourceIBMISFilterSchema(),
 			"resource_group_id": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -445,6 +446,13 @@ func dataSourceIBMIsVPNServersRead(context context.Context, d *schema.ResourceDa
 		return tfErr.GetDiag()
 	}
 
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_servers", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	resourceGrp := d.Get("resource_group_id").(string)
 
 	start := ""
@@ -475,7 +483,14 @@ func dataSourceIBMIsVPNServersRead(context context.Context, d *schema.ResourceDa
 	d.SetId(dataSourceIBMIsVPNServersID(d))
 
 	if allrecs != nil {
-		err = d.Set("vpn_servers", dataSourceVPNServerCollectionFlattenVPNServers(allrecs, meta))
+		vpnServers := dataSourceVPNServerCollectionFlattenVPNServers(allrecs, meta)
+		vpnServers, err = filters.apply(context, meta, vpnServers)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering vpn servers: %s", err), "(Data) ibm_is_vpn_servers", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = d.Set("vpn_servers", vpnServers)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("[ERROR] Error setting vpn_servers %s", err), "(Data) ibm_is_vpn_servers", "read")
 			log.Printf("[DEBUG] %s", tfErr.GetDebugMessage())
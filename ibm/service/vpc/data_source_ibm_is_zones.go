@@ -26,6 +26,7 @@ func DataSourceIBMISZones() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			isFilter: dataSourceIBMISFilterSchema(),
 			isZoneRegion: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -83,6 +84,14 @@ func zonesList(ctx context.Context, d *schema.ResourceData, meta interface{}, re
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	filters, err := expandIBMISFilters(d)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_zones", "read", "expand-filter")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
 	listRegionZonesOptions := &vpcv1.ListRegionZonesOptions{
 		RegionName: &regionName,
 	}
@@ -92,13 +101,11 @@ func zonesList(ctx context.Context, d *schema.ResourceData, meta interface{}, re
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	names := make([]string, 0)
 	status := d.Get(isZoneStatus).(string)
 	zonesList := make([]map[string]interface{}, 0)
 	for _, zone := range availableZones.Zones {
 		zoneInfo := map[string]interface{}{}
 		if status == "" || *zone.Status == status {
-			names = append(names, *zone.Name)
 			zoneInfo[isZoneName] = *zone.Name
 			zoneInfo[isZoneStatus] = *zone.Status
 			if zone.DataCenter != nil {
@@ -111,6 +118,18 @@ func zonesList(ctx context.Context, d *schema.ResourceData, meta interface{}, re
 		zonesList = append(zonesList, zoneInfo)
 	}
 	d.SetId(dataSourceIBMISZonesId(d))
+	zonesList, err = filters.apply(ctx, meta, zonesList)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error filtering zones: %s", err), "(Data) ibm_is_zones", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	names := make([]string, 0, len(zonesList))
+	for _, zoneInfo := range zonesList {
+		if name, ok := zoneInfo[isZoneName].(string); ok {
+			names = append(names, name)
+		}
+	}
 	if err = d.Set(isZoneNames, names); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting zones: %s", err), "(Data) ibm_is_zones", "read", "set-zones").GetDiag()
 	}
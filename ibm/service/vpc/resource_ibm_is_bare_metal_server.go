@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -118,6 +119,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				},
 			),
 			validateBareMetalServerNicNames,
+			resourceIBMISBareMetalServerQuotaPreflight,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -2067,7 +2069,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	}
 	d.SetId(*bms.ID)
 	log.Printf("[INFO] Bare Metal Server : %s", *bms.ID)
-	_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForBareMetalServerAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2076,7 +2078,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
@@ -2084,14 +2086,14 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	}
 	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok {
 		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *bms.CRN, "", isBareMetalServerAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource bare metal server (%s) access tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceIBMISBareMetalServerRead(context, d, meta)
+	return append(isQuotaPreflightWarnings(meta, "ibm_is_bare_metal_server"), resourceIBMISBareMetalServerRead(context, d, meta)...)
 }
 
 func resourceIBMISBareMetalServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -2739,7 +2741,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerStoppedOnReload(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerStoppedOnReload(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedOnReload failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2935,7 +2937,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2956,7 +2958,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2978,7 +2980,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		ntsIntf := nts.([]interface{})
 
 		// out := make([]string, len(otsIntf))
-		listToRemove, listToAdd, serverToStop, listToUpdate := findNetworkAttachmentDifferences(otsIntf, ntsIntf, d.Id(), sess, d)
+		listToRemove, listToAdd, serverToStop, listToUpdate := findNetworkAttachmentDifferences(context, otsIntf, ntsIntf, d.Id(), sess, d)
 
 		if listToUpdate != nil {
 			err = fmt.Errorf("[ERROR] Error while updating network attachment BareMetalServer(%s) \n%s", d.Id(), err)
@@ -3311,7 +3313,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 		if d.HasChange(isBareMetalServerAccessTags) {
 			oldList, newList := d.GetChange(isBareMetalServerAccessTags)
-			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, bmscrn, "", isBareMetalServerAccessTagType)
+			err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, bmscrn, "", isBareMetalServerAccessTagType)
 			if err != nil {
 				log.Printf(
 					"[ERROR] Error on update of resource vpc Bare metal server (%s) access tags: %s", id, err)
@@ -3933,7 +3935,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 
 				// Wait for the server to start
 				// ctx := context.TODO()
-				_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForBareMetalServerAvailable(context, sess, id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4067,7 +4069,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+					_, err = isWaitForBareMetalServerAvailable(context, sess, id, d.Timeout(schema.TimeoutUpdate), d)
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4089,7 +4091,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+					_, err = isWaitForBareMetalServerAvailable(context, sess, id, d.Timeout(schema.TimeoutUpdate), d)
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4119,7 +4121,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+			_, err = isWaitForBareMetalServerAvailable(context, sess, id, d.Timeout(schema.TimeoutUpdate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4198,11 +4200,11 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			action = actionOk.(string)
 		}
 		if action == "start" {
-			isBareMetalServerStart(sess, d.Id(), d, 10)
+			isBareMetalServerStart(context, sess, d.Id(), d, 10)
 		} else if action == "stop" {
-			isBareMetalServerStop(sess, d.Id(), d, 10)
+			isBareMetalServerStop(context, sess, d.Id(), d, 10)
 		} else if action == "restart" {
-			isBareMetalServerRestart(sess, d.Id(), d, 10)
+			isBareMetalServerRestart(context, sess, d.Id(), d, 10)
 		}
 	}
 
@@ -4265,7 +4267,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutDelete), id, d)
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", err.Error()), "ibm_is_bare_metal_server", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
@@ -4280,7 +4282,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForBareMetalServerDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerDeleted failed: %s", err.Error()), "ibm_is_bare_metal_server", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4290,7 +4292,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func isWaitForBareMetalServerDeleted(bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerDeleted(ctx context.Context, bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -4302,7 +4304,7 @@ func isWaitForBareMetalServerDeleted(bmsC *vpcv1.VpcV1, id string, timeout time.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -4324,7 +4326,7 @@ func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.S
 	}
 }
 
-func isWaitForBareMetalServerAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be available.", id)
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
@@ -4335,7 +4337,7 @@ func isWaitForBareMetalServerAvailable(client *vpcv1.VpcV1, id string, timeout t
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
@@ -4391,7 +4393,7 @@ func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.Reso
 		return bms, isBareMetalServerStatusPending, nil
 	}
 }
-func isWaitForBareMetalServerStoppedOnReload(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerStoppedOnReload(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be stopped for reload success.", id)
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
@@ -4402,7 +4404,7 @@ func isWaitForBareMetalServerStoppedOnReload(client *vpcv1.VpcV1, id string, tim
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isBareMetalServerRefreshFuncForReload(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
@@ -4459,7 +4461,7 @@ func isBareMetalServerRefreshFuncForReload(client *vpcv1.VpcV1, id string, d *sc
 	}
 }
 
-func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionStop(ctx context.Context, bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
@@ -4490,7 +4492,7 @@ func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -4519,7 +4521,7 @@ func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStart(ctx context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.StartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -4530,13 +4532,13 @@ func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(ctx, bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStop(ctx context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	stoppingType := "soft"
 	createbmsactoptions := &vpcv1.StopBareMetalServerOptions{
 		ID:   &id,
@@ -4549,13 +4551,13 @@ func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData,
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server Action stop: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerActionStop(bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
+	_, err = isWaitForBareMetalServerActionStop(ctx, bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerRestart(ctx context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.RestartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -4566,7 +4568,7 @@ func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action restart: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(ctx, bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
@@ -4596,7 +4598,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 			return isServerStopped, fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
 		}
 		isServerStopped = true
-		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutDelete), id, d)
 	}
 	return isServerStopped, nil
 }
@@ -4625,7 +4627,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 			return isServerStopped, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 		}
 		isServerStopped = true
-		_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return isServerStopped, err
 		}
@@ -5025,7 +5027,7 @@ func resourceIBMIsBareMetalServerMapToBareMetalServerNetworkAttachmentPrototypeB
 	return model, nil
 }
 
-func findNetworkAttachmentDifferences(oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) ([]vpcv1.DeleteBareMetalServerNetworkAttachmentOptions, []vpcv1.CreateBareMetalServerNetworkAttachmentOptions, bool, error) {
+func findNetworkAttachmentDifferences(ctx context.Context, oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) ([]vpcv1.DeleteBareMetalServerNetworkAttachmentOptions, []vpcv1.CreateBareMetalServerNetworkAttachmentOptions, bool, error) {
 	var wg sync.WaitGroup
 	wg.Add(3)
 
@@ -5045,7 +5047,7 @@ func findNetworkAttachmentDifferences(oldList, newList []interface{}, bareMetalS
 	}()
 
 	go func() {
-		err = compareModifiedNacs(oldList, newList, bareMetalServerId, sess, d)
+		err = compareModifiedNacs(ctx, oldList, newList, bareMetalServerId, sess, d)
 		wg.Done()
 	}()
 
@@ -5287,7 +5289,7 @@ func compareAddedNacs(oldList, newList []interface{}, bareMetalServerId string)
 	return added, restartNeeded
 }
 
-func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) error {
+func compareModifiedNacs(ctx context.Context, oldList, newList []interface{}, bareMetalServerId string, sess *vpcv1.VpcV1, d *schema.ResourceData) error {
 	list2Map := make(map[string]interface{})
 
 	for _, newListitem := range newList {
@@ -5463,7 +5465,7 @@ func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId strin
 								if err != nil {
 									return (fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], d.Id(), err, response))
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(ctx, sess, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									return (err)
 								}
@@ -5480,7 +5482,7 @@ func compareModifiedNacs(oldList, newList []interface{}, bareMetalServerId strin
 								if err != nil {
 									return (fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response))
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(ctx, sess, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									return (err)
 								}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -150,7 +151,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
+		_, waitErr := isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
 		if waitErr != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", err.Error()), "ibm_is_bare_metal_server_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -168,7 +169,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -185,7 +186,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("RestartBareMetalServerWithContext failed: %s", err.Error()), "ibm_is_bare_metal_server_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -290,7 +291,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
+			_, waitErr := isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
 			if waitErr != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", err.Error()), "ibm_is_bare_metal_server_action", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -307,7 +308,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_action", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -324,7 +325,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_action", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -344,7 +345,7 @@ func resourceIBMISBareMetalServerActionDelete(context context.Context, d *schema
 	return nil
 }
 
-func isWaitForBareMetalServerActionAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be running.", id)
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
@@ -355,7 +356,7 @@ func isWaitForBareMetalServerActionAvailable(client *vpcv1.VpcV1, id string, tim
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
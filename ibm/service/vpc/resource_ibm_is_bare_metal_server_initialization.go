@@ -10,6 +10,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -124,7 +125,7 @@ func resourceIBMISBareMetalServerInitializationCreate(context context.Context, d
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForBareMetalServerInitializationStopped(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerInitializationStopped(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerInitializationStopped failed: %s", err.Error()), "ibm_is_bare_metal_server_initialization", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -188,7 +189,7 @@ func resourceIBMISBareMetalServerInitializationDelete(context context.Context, d
 	return nil
 }
 
-func isWaitForBareMetalServerInitializationStopped(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerInitializationStopped(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be stopped for reload success.", id)
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
@@ -199,7 +200,7 @@ func isWaitForBareMetalServerInitializationStopped(client *vpcv1.VpcV1, id strin
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isBareMetalServerInitializationRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
@@ -444,7 +444,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerStoppedForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerStoppedForNIC(context, vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -471,7 +471,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context
 			if err != nil || res.StatusCode != 204 {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error starting bare metal server (%s) after attachment creation failed err %s\n%s", bareMetalServerId, err, response))
 			}
-			_, err = isWaitForBareMetalServerAvailableForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerAvailableForNIC(context, vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -521,7 +521,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1043,7 +1043,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentUpdate(context context.Context
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1064,7 +1064,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentUpdate(context context.Context
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1123,7 +1123,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentDelete(context context.Context
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForBareMetalServerStoppedForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForBareMetalServerStoppedForNIC(context, vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerStoppedForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1162,7 +1162,7 @@ func resourceIBMIsBareMetalServerNetworkAttachmentDelete(context context.Context
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForBareMetalServerAvailableForNIC(vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForBareMetalServerAvailableForNIC(context, vpcClient, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerAvailableForNIC failed: %s", err.Error()), "ibm_is_bare_metal_server_network_attachment", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
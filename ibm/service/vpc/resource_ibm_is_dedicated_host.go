@@ -485,13 +485,13 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 	d.SetId(*dedicatedHost.ID)
 	if _, ok := d.GetOk(isDedicatedHostAccessTags); ok {
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource dedicated host (%s) access tags: %s", d.Id(), err)
 		}
 	}
-	_, err = isWaitForDedicatedHostAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForDedicatedHostAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -729,7 +729,7 @@ func resourceIbmIsDedicatedHostUpdate(context context.Context, d *schema.Resourc
 	}
 	if d.HasChange(isDedicatedHostAccessTags) {
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isDedicatedHostAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get("crn").(string), "", isDedicatedHostAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource dedicated host (%s) access tags: %s", d.Id(), err)
@@ -782,7 +782,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForDedicatedHostDelete(vpcClient, d, d.Id())
+	_, err = isWaitForDedicatedHostDelete(context, vpcClient, d, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -792,7 +792,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
-func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForDedicatedHostDelete(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isDedicatedHostDeleting, isDedicatedHostStable},
@@ -818,10 +818,10 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForDedicatedHostAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -833,7 +833,7 @@ func isWaitForDedicatedHostAvailable(instanceC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -86,6 +86,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 					return nil
 				},
 			),
+			resourceIBMISFloatingIPQuotaPreflight,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -323,7 +324,7 @@ func resourceIBMISFloatingIPCreate(context context.Context, d *schema.ResourceDa
 		return err
 	}
 
-	return resourceIBMISFloatingIPRead(context, d, meta)
+	return append(isQuotaPreflightWarnings(meta, "ibm_is_floating_ip"), resourceIBMISFloatingIPRead(context, d, meta)...)
 }
 
 func fipCreate(context context.Context, d *schema.ResourceData, meta interface{}, name string) diag.Diagnostics {
@@ -376,14 +377,14 @@ func fipCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForInstanceFloatingIP(vpcClient, d.Id(), d)
+	_, err = isWaitForInstanceFloatingIP(context, vpcClient, d.Id(), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
 		oldList, newList := d.GetChange(isFloatingIPTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc Floating IP (%s) tags: %s", d.Id(), err)
@@ -392,7 +393,7 @@ func fipCreate(context context.Context, d *schema.ResourceData, meta interface{}
 
 	if _, ok := d.GetOk(isFloatingIPAccessTags); ok {
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *floatingip.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource Floating IP (%s) access tags: %s", d.Id(), err)
@@ -554,7 +555,7 @@ func fipUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 		}
 
 		oldList, newList := d.GetChange(isFloatingIPTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *fip.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *fip.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of vpc Floating IP (%s) tags: %s", id, err)
@@ -572,7 +573,7 @@ func fipUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 		}
 
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *fip.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *fip.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource Floating IP (%s) access tags: %s", d.Id(), err)
@@ -660,7 +661,7 @@ func fipDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForFloatingIPDeleted(vpcClient, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForFloatingIPDeleted(context, vpcClient, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -692,7 +693,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForFloatingIPDeleted(ctx context.Context, fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -704,7 +705,7 @@ func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -724,7 +725,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceFloatingIP(ctx context.Context, floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -736,7 +737,7 @@ func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -272,7 +272,7 @@ func resourceIBMISFlowLogCreate(ctx context.Context, d *schema.ResourceData, met
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
 		oldList, newList := d.GetChange(isFlowLogTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc flow log (%s) tags: %s", d.Id(), err)
@@ -280,7 +280,7 @@ func resourceIBMISFlowLogCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 	if _, ok := d.GetOk(isFlowLogAccessTags); ok {
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(ctx, oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource VPC Flow Log (%s) access tags: %s", d.Id(), err)
@@ -450,7 +450,7 @@ func resourceIBMISFlowLogUpdate(context context.Context, d *schema.ResourceData,
 
 	if d.HasChange(isFlowLogTags) {
 		oldList, newList := d.GetChange(isFlowLogTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource flow log (%s) tags: %s", *flowlogCollector.ID, err)
@@ -459,7 +459,7 @@ func resourceIBMISFlowLogUpdate(context context.Context, d *schema.ResourceData,
 
 	if d.HasChange(isFlowLogAccessTags) {
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource flow log (%s) access tags: %s", d.Id(), err)
@@ -10,6 +10,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -376,7 +377,7 @@ func imgCreateByFile(context context.Context, d *schema.ResourceData, meta inter
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageAvailable failed: %s", err.Error()), "ibm_is_image", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -385,7 +386,7 @@ func imgCreateByFile(context context.Context, d *schema.ResourceData, meta inter
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isImageTags); ok || v != "" {
 		oldList, newList := d.GetChange(isImageTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
@@ -393,7 +394,7 @@ func imgCreateByFile(context context.Context, d *schema.ResourceData, meta inter
 	}
 	if _, ok := d.GetOk(isImageAccessTags); ok {
 		oldList, newList := d.GetChange(isImageAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) access tags: %s", d.Id(), err)
@@ -457,14 +458,14 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(context, sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_image", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 	} else if *instance.Status != "stopped" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(context, sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_image", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -511,7 +512,7 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageAvailable failed: %s", err.Error()), "ibm_is_image", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -520,7 +521,7 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isImageTags); ok || v != "" {
 		oldList, newList := d.GetChange(isImageTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
@@ -528,7 +529,7 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 	}
 	if _, ok := d.GetOk(isImageAccessTags); ok {
 		oldList, newList := d.GetChange(isImageAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Image (%s) access tags: %s", d.Id(), err)
@@ -537,7 +538,7 @@ func imgCreateByVolume(context context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageAvailable(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -549,7 +550,7 @@ func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -606,7 +607,7 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForImageDeprecate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForImageDeprecate(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageDeprecate failed: %s", err.Error()), "ibm_is_image", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -626,7 +627,7 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForImageObsolete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForImageObsolete(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageObsolete failed: %s", err.Error()), "ibm_is_image", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -645,7 +646,7 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isImageTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Image (%s) tags: %s", id, err)
@@ -662,7 +663,7 @@ func imgUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isImageAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Image (%s) access tags: %s", id, err)
@@ -940,7 +941,7 @@ func imgDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForImageDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForImageDeleted failed: %s", err.Error()), "ibm_is_image", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -950,7 +951,7 @@ func imgDelete(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeleted(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -962,7 +963,7 @@ func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -206,7 +207,7 @@ func imgDeprecateCreate(context context.Context, d *schema.ResourceData, meta in
 	}
 	d.SetId(id)
 	log.Printf("[INFO] Image ID : %s", id)
-	_, err = isWaitForImageDeprecate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageDeprecate(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -214,7 +215,7 @@ func imgDeprecateCreate(context context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func isWaitForImageDeprecate(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeprecate(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deprecate.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -226,7 +227,7 @@ func isWaitForImageDeprecate(imageC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isImageDeprecateRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
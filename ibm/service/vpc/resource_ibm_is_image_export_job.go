@@ -512,7 +512,7 @@ func isWaitForImageExportJobDeleted(context context.Context, d *schema.ResourceD
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func isImageExportJobDeleteRefreshFunc(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
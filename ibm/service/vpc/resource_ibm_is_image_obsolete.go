@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -200,7 +201,7 @@ func imgObsoleteCreate(context context.Context, d *schema.ResourceData, meta int
 	}
 	d.SetId(id)
 	log.Printf("[INFO] Image ID : %s", id)
-	_, err = isWaitForImageObsolete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageObsolete(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -208,7 +209,7 @@ func imgObsoleteCreate(context context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func isWaitForImageObsolete(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageObsolete(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be obsolete.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -220,7 +221,7 @@ func isWaitForImageObsolete(imageC *vpcv1.VpcV1, id string, timeout time.Duratio
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isImageObsoleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
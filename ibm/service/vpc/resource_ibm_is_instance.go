@@ -10,9 +10,11 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -98,6 +101,7 @@ const (
 	isInstanceBootEncryption           = "encryption"
 	isInstanceBootProfile              = "profile"
 	isInstanceAction                   = "action"
+	isInstanceWaitForCloudInit         = "wait_for_cloud_init"
 	isInstanceVolumeAttachments        = "volume_attachments"
 	isInstanceVolumeAttaching          = "attaching"
 	isInstanceVolumeAttached           = "attached"
@@ -187,6 +191,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			resourceIBMISInstanceQuotaPreflight,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -1838,6 +1843,44 @@ func ResourceIBMISInstance() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 			},
+			isInstanceWaitForCloudInit: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Waits for a line of the serial console output of the instance to match a marker after the instance is created",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceConsoleLogMarker: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      `Cloud-init v\. .* finished`,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "The regular expression that a line of the serial console output must match",
+						},
+						isInstanceConsoleLogTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      900,
+							ValidateFunc: validation.IntBetween(1, 3600),
+							Description:  "The time in seconds to wait for the marker",
+						},
+						isInstanceConsoleLogIdleTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(1, 3600),
+							Description:  "The time in seconds after which the wait stops when the serial console prints nothing",
+						},
+						isInstanceConsoleLogMaxLines: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of lines of the serial console output to report when the marker is not found",
+						},
+					},
+				},
+			},
 			isInstanceDisks: &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -2720,7 +2763,7 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2730,7 +2773,7 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
 		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
@@ -2738,7 +2781,7 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -3300,7 +3343,7 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3859,7 +3902,7 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3877,7 +3920,7 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -4430,7 +4473,7 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4440,7 +4483,7 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
 		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
@@ -4448,7 +4491,7 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -4948,7 +4991,7 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4966,7 +5009,7 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
@@ -5019,10 +5062,69 @@ func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(context, d, meta)
+	diags := append(isQuotaPreflightWarnings(meta, "ibm_is_instance"), waitForInstanceCloudInit(context, d, meta)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceIBMisInstanceUpdate(context, d, meta)...)
+}
+
+// waitForInstanceCloudInit blocks until a line of the serial console output
+// of the created instance matches the marker of wait_for_cloud_init. When it
+// does not in time, the create fails with the last lines of the output. The
+// console only streams the output printed once it is connected, so a boot
+// that finished before then leaves the console silent: when it prints
+// nothing for the idle timeout, a warning is returned instead of an error.
+func waitForInstanceCloudInit(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	waitForCloudInit := d.Get(isInstanceWaitForCloudInit).([]interface{})
+	if len(waitForCloudInit) == 0 || waitForCloudInit[0] == nil {
+		return nil
+	}
+	wait := waitForCloudInit[0].(map[string]interface{})
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	marker := regexp.MustCompile(wait[isInstanceConsoleLogMarker].(string))
+	timeout := time.Duration(wait[isInstanceConsoleLogTimeout].(int)) * time.Second
+	idleTimeout := time.Duration(wait[isInstanceConsoleLogIdleTimeout].(int)) * time.Second
+	log.Printf("[INFO] Waiting for the serial console of instance (%s) to match %s", d.Id(), marker)
+	output, err := readInstanceSerialConsole(context, sess, d.Id(), marker, timeout, idleTimeout, wait[isInstanceConsoleLogMaxLines].(int))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("readInstanceSerialConsole failed: %s", err.Error()), "ibm_is_instance", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return instanceCloudInitDiagnostics(d.Id(), marker, timeout, output)
+}
+
+// instanceCloudInitDiagnostics returns the result of the wait for the marker
+// of wait_for_cloud_init from the serial console output read for it.
+func instanceCloudInitDiagnostics(instanceID string, marker *regexp.Regexp, timeout time.Duration, output instanceSerialConsoleOutput) diag.Diagnostics {
+	if output.markerFound {
+		return nil
+	}
+	if output.idle && !output.received {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The serial console of instance %s printed nothing while waiting for %q", instanceID, marker),
+			Detail:   "The serial console only streams the output printed while the provider is connected to it. The boot of the instance may have finished before the provider connected, so wait_for_cloud_init could not confirm that cloud-init finished.",
+		}}
+	}
+	err := fmt.Errorf("no line of the serial console output of instance %s matched %q within %s", instanceID, marker, timeout)
+	if output.idle {
+		err = fmt.Errorf("no line of the serial console output of instance %s matched %q before the console went idle", instanceID, marker)
+	}
+	tfErr := flex.TerraformErrorf(err, fmt.Sprintf("wait_for_cloud_init failed: %s\n\nSerial console output:\n%s", err.Error(), output.log), "ibm_is_instance", "create")
+	log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+	return tfErr.GetDiag()
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	communicator := make(chan interface{})
@@ -5038,10 +5140,10 @@ func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
@@ -5099,7 +5201,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -5118,7 +5220,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(ctx, instanceC, waitTimeout, id, d)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -5842,7 +5944,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 	id := d.Id()
 	// network attachments
 
-	err = handleVolumePrototypesUpdate(d, instanceC)
+	err = handleVolumePrototypesUpdate(context, d, instanceC)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("handleVolumePrototypesUpdate failed: %s", err.Error()), "ibm_is_instance", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6111,7 +6213,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 									return tfErr.GetDiag()
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6133,7 +6235,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 									return tfErr.GetDiag()
 								}
-								_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+								_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
 									tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 									log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6334,7 +6436,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6355,7 +6457,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
+						_, err = isWaitForVirtualNetworkInterfaceAvailable(context, instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6473,7 +6575,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6515,7 +6617,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6551,7 +6653,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6599,7 +6701,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6701,7 +6803,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6756,7 +6858,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStart(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStart failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6805,14 +6907,14 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				return tfErr.GetDiag()
 			}
 			if actiontype == "stop" {
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
 			} else if actiontype == "start" || actiontype == "reboot" {
-				_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStart(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStart failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6857,7 +6959,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(context, instanceC, d, id, *vol.ID)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeAttached failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6889,7 +6991,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(context, instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6921,7 +7023,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -6943,7 +7045,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7011,7 +7113,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7080,7 +7182,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7103,7 +7205,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 							return tfErr.GetDiag()
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7208,7 +7310,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7238,7 +7340,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+			_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7378,7 +7480,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7426,7 +7528,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(context, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7478,7 +7580,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 	}
 	if d.HasChange(isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on update of resource Instance (%s) access tags: %s", d.Id(), err)
@@ -7537,7 +7639,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(context, instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7564,7 +7666,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(context, instanceC, d, d.Id(), *vol.ID)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_instance", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7585,7 +7687,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+	_, err = isWaitForInstanceDelete(context, instanceC, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceDelete failed: %s", err.Error()), "ibm_is_instance", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7595,7 +7697,7 @@ func instanceDelete(context context.Context, d *schema.ResourceData, meta interf
 		if _, ok := d.GetOk(isInstanceBootVolume); ok {
 			autoDel := d.Get("boot_volume.0.auto_delete_volume").(bool)
 			if autoDel {
-				_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+				_, err = isWaitForVolumeDeleted(context, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed: %s", err.Error()), "ibm_is_instance", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7646,7 +7748,7 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForInstanceDelete(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -7672,10 +7774,10 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStop(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -7711,10 +7813,10 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStart(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceActionStatusStopped, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting},
@@ -7750,7 +7852,7 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -7780,7 +7882,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) volume (%s) to be attached.", id, volID)
 
 	stateConf := &resource.StateChangeConf{
@@ -7792,7 +7894,7 @@ func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
@@ -7814,7 +7916,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 	}
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeDetached(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -7841,7 +7943,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIbmIsInstanceInstanceDiskToMap(instanceDisk vpcv1.InstanceDisk) map[string]interface{} {
@@ -8505,7 +8607,7 @@ func ResourceValidateInstanceVolumePrototypes(diff *schema.ResourceDiff, meta in
 	return nil
 }
 
-func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1) error {
+func handleVolumePrototypesUpdate(ctx context.Context, d *schema.ResourceData, instanceC *vpcv1.VpcV1) error {
 	if !d.HasChange("volume_prototypes") || d.IsNewResource() {
 		return nil
 	}
@@ -8682,7 +8784,7 @@ func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1
 				return fmt.Errorf("error attaching volume %s: %w", name, err)
 			}
 
-			_, err = isWaitForInstanceVolumeAttached(instanceC, d, instanceID, *newVolume.ID)
+			_, err = isWaitForInstanceVolumeAttached(ctx, instanceC, d, instanceID, *newVolume.ID)
 			if err != nil {
 				return err
 			}
@@ -8708,7 +8810,7 @@ func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1
 				return fmt.Errorf("error removing volume %s: %w", name, err)
 			}
 
-			_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceID, volID)
+			_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d, instanceID, volID)
 			if err != nil {
 				return err
 			}
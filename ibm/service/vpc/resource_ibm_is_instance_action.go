@@ -165,14 +165,14 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStart(context, sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStart failed: %s", err.Error()), "ibm_is_instance_action", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -278,14 +278,14 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		return tfErr.GetDiag()
 	}
 	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStop failed: %s", err.Error()), "ibm_is_instance_action", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStart(context, sess, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceActionStart failed: %s", err.Error()), "ibm_is_instance_action", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -5,12 +5,14 @@ package vpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -31,6 +34,17 @@ const (
 	isInstanceGroupAccessTags    = "access_tags"
 	isInstanceGroupUserTagType   = "user"
 	isInstanceGroupAccessTagType = "access"
+
+	isInstanceGroupRollingUpdate               = "rolling_update"
+	isInstanceGroupRollingUpdateMaxUnavailable = "max_unavailable"
+	isInstanceGroupRollingUpdateMaxSurge       = "max_surge"
+	isInstanceGroupRollingUpdateHealthTimeout  = "health_timeout"
+	isInstanceGroupRollingUpdateRollback       = "rollback_on_failure"
+
+	isInstanceGroupMembershipHealthy  = "healthy"
+	isInstanceGroupMembershipPending  = "pending"
+	isInstanceGroupMembershipFailed   = "failed"
+	isInstanceGroupMembershipDeleting = "deleting"
 )
 
 func ResourceIBMISInstanceGroup() *schema.Resource {
@@ -53,6 +67,11 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceGroupRollingUpdateCustomizeDiff(diff)
+				},
+			),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -167,6 +186,44 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of access management tags",
 			},
+
+			isInstanceGroupRollingUpdate: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replaces the memberships of the instance group in batches when the instance template changes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceGroupRollingUpdateMaxUnavailable: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of memberships below instance_count during the update",
+						},
+						isInstanceGroupRollingUpdateMaxSurge: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of memberships above instance_count during the update",
+						},
+						isInstanceGroupRollingUpdateHealthTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(60),
+							Description:  "The time in seconds to wait for the new memberships of a batch, and their load balancer pool members, to be healthy",
+						},
+						isInstanceGroupRollingUpdateRollback: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Restores the previous instance template on the memberships when the new memberships are not healthy in time",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -283,7 +340,7 @@ func resourceIBMISInstanceGroupCreate(context context.Context, d *schema.Resourc
 	}
 	d.SetId(*instanceGroup.ID)
 
-	_, healthError := waitForHealthyInstanceGroup(d.Id(), meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(context, d.Id(), meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", err.Error()), "ibm_is_instance_group", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -293,7 +350,7 @@ func resourceIBMISInstanceGroupCreate(context context.Context, d *schema.Resourc
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
 		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of instance group (%s) tags: %s", d.Id(), err)
@@ -302,7 +359,7 @@ func resourceIBMISInstanceGroupCreate(context context.Context, d *schema.Resourc
 
 	if _, ok := d.GetOk(isInstanceGroupAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of instance group (%s) tags: %s", d.Id(), err)
@@ -348,6 +405,19 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		changed = true
 	}
 
+	// A rolling update sets the membership count itself, after the memberships
+	// are replaced.
+	rollingUpdate := d.HasChange("instance_template") && len(d.Get(isInstanceGroupRollingUpdate).([]interface{})) > 0
+	if rollingUpdate {
+		// Checked before the instance template changes, so that a rolling
+		// update that cannot run leaves the instance group as it is.
+		if err := checkInstanceGroupAutoScaleManagers(context, sess, d.Id()); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
 	if d.HasChange("instance_template") {
 		instanceTemplate := d.Get("instance_template").(string)
 		instanceGroupPatchModel.InstanceTemplate = &vpcv1.InstanceTemplateIdentity{
@@ -356,7 +426,7 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		changed = true
 	}
 
-	if d.HasChange("instance_count") {
+	if d.HasChange("instance_count") && !rollingUpdate {
 		membershipCount := d.Get("instance_count").(int)
 		mc := int64(membershipCount)
 		instanceGroupPatchModel.MembershipCount = &mc
@@ -402,13 +472,38 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		}
 
 		// wait for instance group health update with update timeout configured.
-		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", err.Error()), "ibm_is_instance_group", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 	}
+
+	if rollingUpdate {
+		oldTemplate, newTemplate := d.GetChange("instance_template")
+		update := expandInstanceGroupRollingUpdate(sess, d, meta)
+		err = update.run(context, newTemplate.(string))
+		var healthErr *instanceGroupHealthError
+		if errors.As(err, &healthErr) && update.rollback {
+			log.Printf("[WARN] Rolling back instance group (%s) to instance template %s: %s", d.Id(), oldTemplate, err)
+			rollbackErr := update.setInstanceTemplate(context, oldTemplate.(string))
+			if rollbackErr == nil {
+				rollbackErr = update.run(context, oldTemplate.(string))
+			}
+			if rollbackErr != nil {
+				err = fmt.Errorf("%s, and the rollback to instance template %s failed: %s", err, oldTemplate, rollbackErr)
+			} else {
+				d.Set("instance_template", oldTemplate)
+				err = fmt.Errorf("%s, the memberships were rolled back to instance template %s", err, oldTemplate)
+			}
+		}
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Rolling update of instance group failed: %s", err.Error()), "ibm_is_instance_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMISInstanceGroupRead(context, d, meta)
 }
 
@@ -571,7 +666,7 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	_, healthError := waitForHealthyInstanceGroup(context, instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForHealthyInstanceGroup failed: %s", err.Error()), "ibm_is_instance_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -593,7 +688,7 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 		}
 		if lbStatus != "active" {
 			log.Printf("Load Balancer [%s] is not active....Waiting it to be active!\n", loadBalancerID)
-			_, err := isWaitForLBAvailable(sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
+			_, err := isWaitForLBAvailable(context, sess, loadBalancerID, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_instance_group", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -625,7 +720,7 @@ func resourceIBMISInstanceGroupDelete(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
-	_, deleteError := waitForInstanceGroupDelete(d, meta)
+	_, deleteError := waitForInstanceGroupDelete(context, d, meta)
 	if deleteError != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForInstanceGroupDelete failed: %s", err.Error()), "ibm_is_instance_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -651,7 +746,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForHealthyInstanceGroup(ctx context.Context, instanceGroupID string, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -680,11 +775,11 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, healthStateConf)
 
 }
 
-func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	healthStateConf := &resource.StateChangeConf{
 		Pending: []string{HEALTHY},
 		Target:  []string{DELETING},
@@ -701,6 +796,276 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, healthStateConf)
 
 }
+
+func resourceIBMISInstanceGroupRollingUpdateCustomizeDiff(diff *schema.ResourceDiff) error {
+	rollingUpdate := diff.Get(isInstanceGroupRollingUpdate).([]interface{})
+	if len(rollingUpdate) == 0 || rollingUpdate[0] == nil {
+		return nil
+	}
+	update := rollingUpdate[0].(map[string]interface{})
+	if update[isInstanceGroupRollingUpdateMaxUnavailable].(int)+update[isInstanceGroupRollingUpdateMaxSurge].(int) == 0 {
+		return fmt.Errorf("[ERROR] max_unavailable and max_surge of rolling_update cannot both be 0")
+	}
+	return nil
+}
+
+// instanceGroupHealthError is returned by a rolling update when the new
+// memberships of a batch are not healthy in time.
+type instanceGroupHealthError struct {
+	err error
+}
+
+func (e *instanceGroupHealthError) Error() string {
+	return fmt.Sprintf("the memberships are not healthy: %s", e.err)
+}
+
+func (e *instanceGroupHealthError) Unwrap() error {
+	return e.err
+}
+
+// instanceGroupRollingUpdate replaces the memberships of an instance group
+// that are not on a given instance template in batches. Each batch creates up
+// to maxSurge memberships above count, waits for the memberships on the
+// template to be healthy, and then deletes up to maxSurge + maxUnavailable of
+// the other memberships.
+type instanceGroupRollingUpdate struct {
+	sess            *vpcv1.VpcV1
+	meta            interface{}
+	instanceGroupID string
+	loadBalancerID  string
+	poolID          string
+	count           int64
+	maxUnavailable  int64
+	maxSurge        int64
+	healthTimeout   time.Duration
+	timeout         time.Duration
+	rollback        bool
+}
+
+func expandInstanceGroupRollingUpdate(sess *vpcv1.VpcV1, d *schema.ResourceData, meta interface{}) *instanceGroupRollingUpdate {
+	rollingUpdate := d.Get(isInstanceGroupRollingUpdate).([]interface{})[0].(map[string]interface{})
+	return &instanceGroupRollingUpdate{
+		sess:            sess,
+		meta:            meta,
+		instanceGroupID: d.Id(),
+		loadBalancerID:  d.Get("load_balancer").(string),
+		poolID:          d.Get("load_balancer_pool").(string),
+		count:           int64(d.Get("instance_count").(int)),
+		maxUnavailable:  int64(rollingUpdate[isInstanceGroupRollingUpdateMaxUnavailable].(int)),
+		maxSurge:        int64(rollingUpdate[isInstanceGroupRollingUpdateMaxSurge].(int)),
+		healthTimeout:   time.Duration(rollingUpdate[isInstanceGroupRollingUpdateHealthTimeout].(int)) * time.Second,
+		timeout:         d.Timeout(schema.TimeoutUpdate),
+		rollback:        rollingUpdate[isInstanceGroupRollingUpdateRollback].(bool),
+	}
+}
+
+func (u *instanceGroupRollingUpdate) run(context context.Context, instanceTemplate string) error {
+	if err := u.replaceMemberships(context, instanceTemplate); err != nil {
+		// A batch that fails leaves the membership count at count + maxSurge,
+		// which is restored so that the surge memberships do not outlive the
+		// rolling update.
+		if restoreErr := u.setMembershipCount(context, u.count); restoreErr != nil {
+			return fmt.Errorf("%w, and restoring the membership count to %d failed: %s", err, u.count, restoreErr)
+		}
+		return err
+	}
+	return u.setMembershipCount(context, u.count)
+}
+
+func (u *instanceGroupRollingUpdate) replaceMemberships(context context.Context, instanceTemplate string) error {
+	batchSize := int(u.maxSurge + u.maxUnavailable)
+	for {
+		if err := u.setMembershipCount(context, u.count+u.maxSurge); err != nil {
+			return err
+		}
+		if err := u.waitForHealthyMemberships(context, instanceTemplate); err != nil {
+			return &instanceGroupHealthError{err: err}
+		}
+
+		memberships, err := u.listMemberships(context)
+		if err != nil {
+			return err
+		}
+		outdated := []vpcv1.InstanceGroupMembership{}
+		for _, membership := range memberships {
+			if *membership.Status != isInstanceGroupMembershipDeleting && !instanceGroupMembershipOnTemplate(membership, instanceTemplate) {
+				outdated = append(outdated, membership)
+			}
+		}
+		if len(outdated) == 0 {
+			break
+		}
+		if len(outdated) > batchSize {
+			outdated = outdated[:batchSize]
+		}
+		for _, membership := range outdated {
+			log.Printf("[INFO] Replacing membership %s of instance group (%s)", *membership.ID, u.instanceGroupID)
+			deleteOptions := &vpcv1.DeleteInstanceGroupMembershipOptions{
+				InstanceGroupID: &u.instanceGroupID,
+				ID:              membership.ID,
+			}
+			response, err := u.sess.DeleteInstanceGroupMembershipWithContext(context, deleteOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error deleting membership %s of instance group: %s\n%s", *membership.ID, err, response)
+			}
+		}
+		if _, err := waitForHealthyInstanceGroup(context, u.instanceGroupID, u.meta, u.timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkInstanceGroupAutoScaleManagers returns an error when an autoscale
+// manager of the instance group is enabled. The manager sets the membership
+// count of the instance group, which a rolling update changes in each batch.
+func checkInstanceGroupAutoScaleManagers(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string) error {
+	start := ""
+	for {
+		listOptions := &vpcv1.ListInstanceGroupManagersOptions{InstanceGroupID: &instanceGroupID}
+		if start != "" {
+			listOptions.Start = &start
+		}
+		collection, response, err := sess.ListInstanceGroupManagersWithContext(context, listOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing managers of instance group (%s): %s\n%s", instanceGroupID, err, response)
+		}
+		for _, managerIntf := range collection.Managers {
+			var id, managerType *string
+			var enabled *bool
+			switch manager := managerIntf.(type) {
+			case *vpcv1.InstanceGroupManagerAutoScale:
+				id, managerType, enabled = manager.ID, manager.ManagerType, manager.ManagementEnabled
+			case *vpcv1.InstanceGroupManager:
+				id, managerType, enabled = manager.ID, manager.ManagerType, manager.ManagementEnabled
+			default:
+				continue
+			}
+			if managerType != nil && *managerType == "autoscale" && enabled != nil && *enabled {
+				return fmt.Errorf("[ERROR] The autoscale manager (%s) of instance group (%s) is enabled, and sets the membership count that rolling_update changes. Set enable_manager to false on the manager during the rolling update, or remove rolling_update", *id, instanceGroupID)
+			}
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func instanceGroupMembershipOnTemplate(membership vpcv1.InstanceGroupMembership, instanceTemplate string) bool {
+	return membership.InstanceTemplate != nil && membership.InstanceTemplate.ID != nil && *membership.InstanceTemplate.ID == instanceTemplate
+}
+
+func (u *instanceGroupRollingUpdate) setInstanceTemplate(context context.Context, instanceTemplate string) error {
+	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{
+		InstanceTemplate: &vpcv1.InstanceTemplateIdentity{
+			ID: &instanceTemplate,
+		},
+	}
+	return u.patch(context, instanceGroupPatchModel)
+}
+
+func (u *instanceGroupRollingUpdate) setMembershipCount(context context.Context, count int64) error {
+	getInstanceGroupOptions := &vpcv1.GetInstanceGroupOptions{ID: &u.instanceGroupID}
+	instanceGroup, response, err := u.sess.GetInstanceGroupWithContext(context, getInstanceGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response)
+	}
+	if instanceGroup.MembershipCount != nil && *instanceGroup.MembershipCount == count {
+		return nil
+	}
+	return u.patch(context, vpcv1.InstanceGroupPatch{MembershipCount: &count})
+}
+
+func (u *instanceGroupRollingUpdate) patch(context context.Context, instanceGroupPatchModel vpcv1.InstanceGroupPatch) error {
+	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+	if err != nil {
+		return err
+	}
+	updateOptions := &vpcv1.UpdateInstanceGroupOptions{
+		ID:                 &u.instanceGroupID,
+		InstanceGroupPatch: instanceGroupPatch,
+	}
+	_, response, err := u.sess.UpdateInstanceGroupWithContext(context, updateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating instance group: %s\n%s", err, response)
+	}
+	_, err = waitForHealthyInstanceGroup(context, u.instanceGroupID, u.meta, u.timeout)
+	return err
+}
+
+func (u *instanceGroupRollingUpdate) listMemberships(context context.Context) ([]vpcv1.InstanceGroupMembership, error) {
+	start := ""
+	allrecs := []vpcv1.InstanceGroupMembership{}
+	for {
+		listOptions := &vpcv1.ListInstanceGroupMembershipsOptions{InstanceGroupID: &u.instanceGroupID}
+		if start != "" {
+			listOptions.Start = &start
+		}
+		collection, response, err := u.sess.ListInstanceGroupMembershipsWithContext(context, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing memberships of instance group: %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, collection.Memberships...)
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+// waitForHealthyMemberships waits for the memberships on instanceTemplate to
+// be healthy and, when the instance group has a load balancer pool, for their
+// pool members to pass the health checks of the pool.
+func (u *instanceGroupRollingUpdate) waitForHealthyMemberships(context context.Context, instanceTemplate string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isInstanceGroupMembershipPending},
+		Target:  []string{isInstanceGroupMembershipHealthy},
+		Refresh: func() (interface{}, string, error) {
+			memberships, err := u.listMemberships(context)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, membership := range memberships {
+				if *membership.Status == isInstanceGroupMembershipDeleting || !instanceGroupMembershipOnTemplate(membership, instanceTemplate) {
+					continue
+				}
+				if *membership.Status == isInstanceGroupMembershipFailed {
+					return memberships, *membership.Status, fmt.Errorf("membership %s failed", *membership.ID)
+				}
+				if *membership.Status != isInstanceGroupMembershipHealthy {
+					return memberships, isInstanceGroupMembershipPending, nil
+				}
+				if u.poolID == "" {
+					continue
+				}
+				if membership.PoolMember == nil || membership.PoolMember.ID == nil {
+					return memberships, isInstanceGroupMembershipPending, nil
+				}
+				getPoolMemberOptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
+					LoadBalancerID: &u.loadBalancerID,
+					PoolID:         &u.poolID,
+					ID:             membership.PoolMember.ID,
+				}
+				member, response, err := u.sess.GetLoadBalancerPoolMemberWithContext(context, getPoolMemberOptions)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error getting load balancer pool member %s: %s\n%s", *membership.PoolMember.ID, err, response)
+				}
+				if member.Health == nil || *member.Health != "ok" {
+					return memberships, isInstanceGroupMembershipPending, nil
+				}
+			}
+			return memberships, isInstanceGroupMembershipHealthy, nil
+		},
+		Timeout:    u.healthTimeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := conns.WaitForStateContext(context, stateConf)
+	return err
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func testInstanceGroupManagersClient(t *testing.T, managers string) *vpcv1.VpcV1 {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/instance_groups/group-id/managers") {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"limit": 50, "total_count": 1, "first": {"href": "https://vpc"}, "managers": [` + managers + `]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return sess
+}
+
+func TestCheckInstanceGroupAutoScaleManagers(t *testing.T) {
+	cases := []struct {
+		name     string
+		managers string
+		fail     bool
+	}{
+		{
+			name: "no manager",
+		},
+		{
+			name:     "enabled autoscale manager",
+			managers: `{"id": "manager-id", "name": "autoscale", "manager_type": "autoscale", "management_enabled": true, "aggregation_window": 90, "cooldown": 300, "max_membership_count": 5, "min_membership_count": 1}`,
+			fail:     true,
+		},
+		{
+			name:     "disabled autoscale manager",
+			managers: `{"id": "manager-id", "name": "autoscale", "manager_type": "autoscale", "management_enabled": false, "aggregation_window": 90, "cooldown": 300, "max_membership_count": 5, "min_membership_count": 1}`,
+		},
+		{
+			name:     "enabled scheduled manager",
+			managers: `{"id": "manager-id", "name": "scheduled", "manager_type": "scheduled", "management_enabled": true}`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkInstanceGroupAutoScaleManagers(context.Background(), testInstanceGroupManagersClient(t, c.managers), "group-id")
+			if c.fail && (err == nil || !strings.Contains(err.Error(), "manager-id")) {
+				t.Errorf("expected an error naming the manager, got %v", err)
+			}
+			if !c.fail && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+type testInstanceGroupSession struct {
+	conns.ClientSession
+	sess *vpcv1.VpcV1
+}
+
+func (s testInstanceGroupSession) VpcV1API() (*vpcv1.VpcV1, error) {
+	return s.sess, nil
+}
+
+func TestInstanceGroupRollingUpdateRestoresMembershipCount(t *testing.T) {
+	membershipCount := int64(3)
+	patches := []int64{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, "/instance_groups/group-id"):
+			patch := struct {
+				MembershipCount int64 `json:"membership_count"`
+			}{}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			patches = append(patches, patch.MembershipCount)
+			membershipCount = patch.MembershipCount
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/instance_groups/group-id"):
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/instance_groups/group-id/memberships"):
+			w.Write([]byte(`{"limit": 50, "total_count": 0, "first": {"href": "https://vpc"}, "memberships": []}`))
+			return
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprintf(w, `{"id": "group-id", "membership_count": %d, "status": "healthy"}`, membershipCount)
+	}))
+	defer server.Close()
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	update := &instanceGroupRollingUpdate{
+		sess:            sess,
+		meta:            testInstanceGroupSession{sess: sess},
+		instanceGroupID: "group-id",
+		count:           2,
+		maxUnavailable:  0,
+		maxSurge:        1,
+		healthTimeout:   time.Millisecond,
+		timeout:         time.Millisecond,
+	}
+
+	// The memberships on the new template are never healthy, so the first
+	// batch fails after the surge membership count is set.
+	err = update.run(context.Background(), "template-id")
+	var healthErr *instanceGroupHealthError
+	if !errors.As(err, &healthErr) {
+		t.Fatalf("expected a health error, got %v", err)
+	}
+	if fmt.Sprint(patches) != "[2]" {
+		t.Errorf("expected the membership count to be restored to 2, got the patches %v", patches)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -346,7 +347,7 @@ func resourceIBMIsInstanceNetworkAttachmentCreate(context context.Context, d *sc
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkAttachmentOptions.InstanceID, *instanceNetworkAttachment.ID))
-	_, err = isWaitForInstanceNetworkAttachmentStable(vpcClient, *createInstanceNetworkAttachmentOptions.InstanceID, *instanceNetworkAttachment.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForInstanceNetworkAttachmentStable(context, vpcClient, *createInstanceNetworkAttachmentOptions.InstanceID, *instanceNetworkAttachment.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceNetworkAttachmentStable failed: %s", err.Error()), "ibm_is_instance_network_attachment", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -651,7 +652,7 @@ func resourceIBMIsInstanceNetworkAttachmentUpdate(context context.Context, d *sc
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -672,7 +673,7 @@ func resourceIBMIsInstanceNetworkAttachmentUpdate(context context.Context, d *sc
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 						return tfErr.GetDiag()
 					}
-					_, err = isWaitForVirtualNetworkInterfaceAvailable(vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
+					_, err = isWaitForVirtualNetworkInterfaceAvailable(context, vpcClient, vniId, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
 						tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_attachment", "update")
 						log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -740,7 +741,7 @@ func resourceIBMIsInstanceNetworkAttachmentDelete(context context.Context, d *sc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceNetworkAttachmentDeleted(vpcClient, parts[0], parts[1], ina, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForInstanceNetworkAttachmentDeleted(context, vpcClient, parts[0], parts[1], ina, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceNetworkAttachmentDeleted failed: %s", err.Error()), "ibm_is_instance_network_attachment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1056,7 +1057,7 @@ func resourceIBMIsInstanceNetworkAttachmentSubnetReferenceDeletedToMap(model *vp
 	return modelMap, nil
 }
 
-func isWaitForInstanceNetworkAttachmentStable(instanceC *vpcv1.VpcV1, instanceId, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForInstanceNetworkAttachmentStable(ctx context.Context, instanceC *vpcv1.VpcV1, instanceId, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for instance network attachment (%s) to be stable.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1068,7 +1069,7 @@ func isWaitForInstanceNetworkAttachmentStable(instanceC *vpcv1.VpcV1, instanceId
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isInstanceNetworkAttachmentRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -1088,7 +1089,7 @@ func isInstanceNetworkAttachmentRefreshFunc(instanceC *vpcv1.VpcV1, instanceId,
 		return networkAttachment, *networkAttachment.LifecycleState, nil
 	}
 }
-func isWaitForInstanceNetworkAttachmentDeleted(instanceC *vpcv1.VpcV1, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment, timeout time.Duration) (interface{}, error) {
+func isWaitForInstanceNetworkAttachmentDeleted(ctx context.Context, instanceC *vpcv1.VpcV1, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for instance network attachment (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1100,7 +1101,7 @@ func isWaitForInstanceNetworkAttachmentDeleted(instanceC *vpcv1.VpcV1, instanceI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 func isInstanceNetworkAttachmentDeleteRefreshFunc(instanceC *vpcv1.VpcV1, instanceId, id string, ina *vpcv1.InstanceNetworkAttachment) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
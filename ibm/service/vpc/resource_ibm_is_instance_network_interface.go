@@ -359,7 +359,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -383,7 +383,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -392,7 +392,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -649,7 +649,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -670,7 +670,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -754,13 +754,13 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -799,14 +799,14 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceDelete(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForNetworkInterfaceDelete failed: %s", err.Error()), "ibm_is_instance_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(context, vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -818,7 +818,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	return nil
 }
 
-func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -830,7 +830,7 @@ func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeo
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -858,7 +858,7 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -870,7 +870,7 @@ func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -430,7 +430,7 @@ func instanceVolAttachmentCreate(context context.Context, d *schema.ResourceData
 		return tfErr.GetDiag()
 	}
 	d.SetId(makeTerraformVolAttID(instanceId, *instanceVolAtt.ID))
-	volAtt, err := isWaitForInstanceVolumeAttached(sess, d, instanceId, *instanceVolAtt.ID)
+	volAtt, err := isWaitForInstanceVolumeAttached(context, sess, d, instanceId, *instanceVolAtt.ID)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeAttached failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -441,7 +441,7 @@ func instanceVolAttachmentCreate(context context.Context, d *schema.ResourceData
 	if _, ok := d.GetOk(isInstanceVolAttTags); ok || v != "" {
 		volAttRef := volAtt.(*vpcv1.VolumeAttachment)
 		oldList, newList := d.GetChange(isInstanceVolAttTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *volAttRef.Volume.CRN, "", isInstanceUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *volAttRef.Volume.CRN, "", isInstanceUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource instance volume attachment (%s) tags: %s", d.Id(), err)
@@ -757,7 +757,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -787,7 +787,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(context, instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -856,7 +856,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -906,7 +906,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForInstanceAvailable(context, instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -933,7 +933,7 @@ func instanceVolAttUpdate(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -975,7 +975,7 @@ func instanceVolAttDelete(context context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceId, id)
+	_, err = isWaitForInstanceVolumeDetached(context, instanceC, d, instanceId, id)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -991,7 +991,7 @@ func instanceVolAttDelete(context context.Context, d *schema.ResourceData, meta
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForVolumeDeleted(context, instanceC, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed: %s", err.Error()), "ibm_is_instance_volume_attachment", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
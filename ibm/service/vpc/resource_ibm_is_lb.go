@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
+	"strconv"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -52,6 +56,18 @@ const (
 
 	isAttachedLoadBalancerPoolMembers = "attached_load_balancer_pool_members"
 	isLBAccessTags                    = "access_tags"
+
+	isLBCompositePool     = "pool"
+	isLBCompositeListener = "listener"
+	isLBCompositeMember   = "member"
+
+	// isLBCompositePoolTag and isLBCompositeListenerTag are the user tags of
+	// the load balancers whose pools or listeners are managed by the pool or
+	// listener blocks. They are read at plan time by ibm_is_lb_pool,
+	// ibm_is_lb_pool_member and ibm_is_lb_listener, and by the refresh of the
+	// load balancer, and they are not reported in tags.
+	isLBCompositePoolTag     = "terraform:ibm_is_lb:pool"
+	isLBCompositeListenerTag = "terraform:ibm_is_lb:listener"
 )
 
 func ResourceIBMISLB() *schema.Resource {
@@ -83,6 +99,10 @@ func ResourceIBMISLB() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISLBCompositeTagsCustomizeDiff(diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -330,6 +350,24 @@ func ResourceIBMISLB() *schema.Resource {
 				ConflictsWith: []string{isLBProfile},
 			},
 
+			isLBCompositePool: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "The pools of the load balancer with their members. When set, the pools that are not listed are deleted",
+				Elem:        resourceIBMISLBCompositePool(),
+			},
+
+			isLBCompositeListener: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "The listeners of the load balancer. When set, the listeners that are not listed are deleted",
+				Elem:        resourceIBMISLBCompositeListener(),
+			},
+
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -534,6 +572,21 @@ func lbCreate(context context.Context, d *schema.ResourceData, meta interface{},
 		options.Logging = loadBalancerLogging
 	}
 
+	compositeBlocks := lbCompositeBlocksFromConfig(d.GetRawConfig())
+	if compositeBlocks.pools || compositeBlocks.listeners {
+		pools := d.Get(isLBCompositePool).([]interface{})
+		listeners := d.Get(isLBCompositeListener).([]interface{})
+		if err = validateLBCompositeBlocks(pools, listeners, lbCompositePoolNames(pools)); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("validateLBCompositeBlocks failed: %s", err.Error()), "ibm_is_lb", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		// The pools, their members and the listeners are created with the load
+		// balancer, which is then waited for once
+		options.Pools = expandLBCompositePoolPrototypes(pools)
+		options.Listeners = expandLBCompositeListenerPrototypes(listeners)
+	}
+
 	lb, _, err := sess.CreateLoadBalancerWithContext(context, options)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateLoadBalancerWithContext failed: %s", err.Error()), "ibm_is_lb", "create")
@@ -542,16 +595,17 @@ func lbCreate(context context.Context, d *schema.ResourceData, meta interface{},
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
-	_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isLBTags); ok || v != "" {
+	if _, ok := d.GetOk(isLBTags); ok || v != "" || compositeBlocks.pools || compositeBlocks.listeners {
 		oldList, newList := d.GetChange(isLBTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
+		newList = lbUserTags(newList, compositeBlocks)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *lb.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
@@ -560,7 +614,7 @@ func lbCreate(context context.Context, d *schema.ResourceData, meta interface{},
 
 	if _, ok := d.GetOk(isLBAccessTags); ok {
 		oldList, newList := d.GetChange(isLBAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *lb.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource load balancer (%s) access tags: %s", d.Id(), err)
@@ -797,10 +851,20 @@ func lbGet(context context.Context, d *schema.ResourceData, meta interface{}, id
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb", "read", "set-udp_supported").GetDiag()
 		}
 	}
+	// The pools and listeners take an API call per pool to read, so they are
+	// only read for the load balancers that use the pool or listener blocks
+	compositeBlocks := lbCompositeBlocks{
+		pools:     len(d.Get(isLBCompositePool).([]interface{})) > 0,
+		listeners: len(d.Get(isLBCompositeListener).([]interface{})) > 0,
+	}
 	tags, err := flex.GetGlobalTagsUsingCRN(meta, *loadBalancer.CRN, "", isUserTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
+	} else {
+		compositeBlocks = lbCompositeBlocksFromTags(tags)
+		tags.Remove(isLBCompositePoolTag)
+		tags.Remove(isLBCompositeListenerTag)
 	}
 	if err = d.Set(isLBTags, tags); err != nil {
 		err = fmt.Errorf("Error setting tags: %s", err)
@@ -839,6 +903,13 @@ func lbGet(context context.Context, d *schema.ResourceData, meta interface{}, id
 		err = fmt.Errorf("Error setting version: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb", "read", "set-version").GetDiag()
 	}
+	if compositeBlocks.pools || compositeBlocks.listeners {
+		if err = lbCompositeGet(context, sess, d, id, compositeBlocks); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("lbCompositeGet failed: %s", err.Error()), "ibm_is_lb", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return nil
 }
 
@@ -869,15 +940,33 @@ func resourceIBMISLBUpdate(context context.Context, d *schema.ResourceData, meta
 		hasChangedSecurityGroups = true
 	}
 
-	err := lbUpdate(context, d, meta, id, name, hasChanged, isLogging, hasChangedLog, hasChangedSecurityGroups, remove, add)
+	compositeBlocks := lbCompositeBlocksFromConfig(d.GetRawConfig())
+	compositeTagsChanged, tagErr := updateLBCompositeTags(context, meta, d.Get(isLBCrn).(string), compositeBlocks)
+	if tagErr != nil {
+		tfErr := flex.TerraformErrorf(tagErr, fmt.Sprintf("updateLBCompositeTags failed: %s", tagErr.Error()), "ibm_is_lb", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	err := lbUpdate(context, d, meta, id, name, hasChanged, isLogging, hasChangedLog, hasChangedSecurityGroups, remove, add, compositeBlocks)
 	if err != nil {
 		return err
 	}
 
+	// Pool or listener blocks that were just added are reconciled even when
+	// they match the state, which did not hold the pools or listeners that
+	// were created outside of Terraform
+	if d.HasChange(isLBCompositePool) || d.HasChange(isLBCompositeListener) || compositeTagsChanged {
+		err = lbCompositeUpdate(context, d, meta, id, compositeBlocks)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMISLBRead(context, d, meta)
 }
 
-func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool, isLogging bool, hasChangedLog bool, hasChangedSecurityGroups bool, remove, add []string) diag.Diagnostics {
+func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool, isLogging bool, hasChangedLog bool, hasChangedSecurityGroups bool, remove, add []string, compositeBlocks lbCompositeBlocks) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb", "update", "initialize-client")
@@ -896,7 +985,8 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 		}
 		if d.HasChange(isLBTags) {
 			oldList, newList := d.GetChange(isLBTags)
-			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
+			oldList, newList = lbUserTags(oldList, compositeBlocks), lbUserTags(newList, compositeBlocks)
+			err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *lb.CRN, "", isUserTagType)
 			if err != nil {
 				log.Printf(
 					"Error on update of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
@@ -904,7 +994,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 		}
 		if d.HasChange(isLBAccessTags) {
 			oldList, newList := d.GetChange(isLBAccessTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *lb.CRN, "", isAccessTagType)
 			if err != nil {
 				log.Printf(
 					"Error on update of resource load balancer (%s) access tags: %s", d.Id(), err)
@@ -954,7 +1044,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -993,7 +1083,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1065,7 +1155,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1096,7 +1186,7 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForLBAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1108,6 +1198,41 @@ func lbUpdate(context context.Context, d *schema.ResourceData, meta interface{},
 	return nil
 }
 
+func lbCompositeUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id string, blocks lbCompositeBlocks) diag.Diagnostics {
+	if !blocks.pools && !blocks.listeners {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb", "update", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	pools := d.Get(isLBCompositePool).([]interface{})
+	listeners := d.Get(isLBCompositeListener).([]interface{})
+	var poolNames map[string]bool
+	if blocks.pools {
+		poolNames = lbCompositePoolNames(pools)
+	}
+	if err = validateLBCompositeBlocks(pools, listeners, poolNames); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("validateLBCompositeBlocks failed: %s", err.Error()), "ibm_is_lb", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	isLBKey := "load_balancer_key_" + id
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	err = reconcileLBComposite(context, sess, id, blocks, pools, listeners, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("reconcileLBComposite failed: %s", err.Error()), "ibm_is_lb", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
 func resourceIBMISLBDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 
@@ -1151,7 +1276,7 @@ func lbDelete(context context.Context, d *schema.ResourceData, meta interface{},
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBDeleted failed: %s", err.Error()), "ibm_is_lb", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1161,7 +1286,7 @@ func lbDelete(context context.Context, d *schema.ResourceData, meta interface{},
 	return nil
 }
 
-func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBDeleted(ctx context.Context, lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1173,7 +1298,7 @@ func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1225,7 +1350,7 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 	return true, nil
 }
 
-func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1237,7 +1362,7 @@ func isWaitForLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration)
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
@@ -1258,3 +1383,990 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 		return lb, isLBProvisioning, nil
 	}
 }
+
+func resourceIBMISLBCompositePool() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isLBPoolName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolName),
+				Description:  "The name of the pool, unique in the load balancer",
+			},
+			isLBPoolAlgorithm: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolAlgorithm),
+				Description:  "The load balancing algorithm of the pool",
+			},
+			isLBPoolProtocol: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolProtocol),
+				Description:  "The protocol of the pool",
+			},
+			isLBPoolHealthDelay: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The health check interval in seconds",
+			},
+			isLBPoolHealthRetries: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The health check max retries",
+			},
+			isLBPoolHealthTimeout: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The health check timeout in seconds",
+			},
+			isLBPoolHealthType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolHealthType),
+				Description:  "The protocol type of the health check",
+			},
+			isLBPoolHealthMonitorURL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The health check URL path",
+			},
+			isLBPoolHealthMonitorPort: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The health check port, the port of the members by default",
+			},
+			isLBPoolProxyProtocol: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolProxyProtocol),
+				Description:  "The PROXY protocol setting of the pool",
+			},
+			isLBPoolSessPersistenceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolSessPersistenceType),
+				Description:  "The session persistence type of the pool",
+			},
+			isLBPoolSessPersistenceAppCookieName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolSessPersistenceAppCookieName),
+				Description:  "The session persistence cookie name, for the app_cookie session persistence type",
+			},
+			isLBCompositeMember: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "The members of the pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMemberPort: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The port the member receives traffic on",
+						},
+						isLBPoolMemberTargetAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address of the member, exactly one of target_address and target_id must be set",
+						},
+						isLBPoolMemberTargetID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the virtual server instance of the member, exactly one of target_address and target_id must be set",
+						},
+						isLBPoolMemberWeight: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_member", isLBPoolMemberWeight),
+							Description:  "The weight of the member, for the weighted_round_robin algorithm",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the member",
+						},
+					},
+				},
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the pool",
+			},
+		},
+	}
+}
+
+func resourceIBMISLBCompositeListener() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isLBListenerPort: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.ValidateLBListenerPort,
+				Description:  "The port of the listener, unique in the load balancer",
+			},
+			isLBListenerProtocol: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener", isLBListenerProtocol),
+				Description:  "The protocol of the listener",
+			},
+			isLBListenerDefaultPool: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the default pool of the listener",
+			},
+			isLBListenerCertificateInstance: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the certificate instance, for the https protocol",
+			},
+			isLBListenerConnectionLimit: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The connection limit of the listener",
+			},
+			isLBListenerIdleConnectionTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener", isLBListenerIdleConnectionTimeout),
+				Description:  "The idle connection timeout of the listener in seconds",
+			},
+			isLBListenerAcceptProxyProtocol: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether the listener forwards the PROXY protocol",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the listener",
+			},
+		},
+	}
+}
+
+// lbCompositeBlocks tells which of the pool and listener blocks of a load
+// balancer are set. A block that is not set leaves the pools or listeners of
+// the load balancer untouched.
+type lbCompositeBlocks struct {
+	pools     bool
+	listeners bool
+}
+
+func lbCompositeBlocksFromConfig(config cty.Value) lbCompositeBlocks {
+	return lbCompositeBlocks{
+		pools:     !config.GetAttr(isLBCompositePool).IsNull(),
+		listeners: !config.GetAttr(isLBCompositeListener).IsNull(),
+	}
+}
+
+func lbCompositeBlocksFromTags(tags *schema.Set) lbCompositeBlocks {
+	return lbCompositeBlocks{
+		pools:     tags.Contains(isLBCompositePoolTag),
+		listeners: tags.Contains(isLBCompositeListenerTag),
+	}
+}
+
+// lbUserTags returns the user tags of a load balancer, with the tags of the
+// pool and listener blocks that are set so that they are kept when the tags
+// are updated.
+func lbUserTags(tags interface{}, blocks lbCompositeBlocks) *schema.Set {
+	userTags := schema.NewSet(flex.ResourceIBMVPCHash, nil)
+	if tags != nil {
+		userTags = schema.CopySet(tags.(*schema.Set))
+	}
+	if blocks.pools {
+		userTags.Add(isLBCompositePoolTag)
+	}
+	if blocks.listeners {
+		userTags.Add(isLBCompositeListenerTag)
+	}
+	return userTags
+}
+
+// updateLBCompositeTags attaches the tags of the pool and listener blocks
+// that are set to a load balancer and detaches the others. It returns true if
+// the tags changed, that is if blocks were added or removed.
+func updateLBCompositeTags(context context.Context, meta interface{}, crn string, blocks lbCompositeBlocks) (bool, error) {
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, crn, "", isUserTagType)
+	if err != nil {
+		return false, err
+	}
+	if lbCompositeBlocksFromTags(tags) == blocks {
+		return false, nil
+	}
+	newTags := schema.CopySet(tags)
+	newTags.Remove(isLBCompositePoolTag)
+	newTags.Remove(isLBCompositeListenerTag)
+	return true, flex.UpdateGlobalTagsUsingCRN(context, tags, lbUserTags(newTags, blocks), meta, crn, "", isUserTagType)
+}
+
+// resourceIBMISLBCompositeTagsCustomizeDiff plans an update of a load
+// balancer whose pool or listener blocks were added or removed while they did
+// not change, so that the tags of the blocks are updated and, for blocks that
+// were added, the pools or listeners that they do not describe are deleted.
+func resourceIBMISLBCompositeTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	blocks := lbCompositeBlocksFromConfig(diff.GetRawConfig())
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, diff.Get(isLBCrn).(string), "", isUserTagType)
+	if err != nil {
+		log.Printf("[WARN] Error getting Load Balancer (%s) tags: %s", diff.Id(), err)
+		return nil
+	}
+	tagged := lbCompositeBlocksFromTags(tags)
+	if tagged.pools != blocks.pools && !diff.HasChange(isLBCompositePool) {
+		if err = diff.SetNewComputed(isLBCompositePool); err != nil {
+			return err
+		}
+	}
+	if tagged.listeners != blocks.listeners && !diff.HasChange(isLBCompositeListener) {
+		return diff.SetNewComputed(isLBCompositeListener)
+	}
+	return nil
+}
+
+// lbCompositeConflict returns an error naming both resources when the user
+// tags of a load balancer show that the pools or listeners of resourceType
+// are managed by the pool or listener blocks of ibm_is_lb, which delete the
+// pools, members and listeners that they do not describe.
+func lbCompositeConflict(resourceType, lbID string, tags *schema.Set) error {
+	block, tag, item := isLBCompositePool, isLBCompositePoolTag, "pool"
+	switch resourceType {
+	case "ibm_is_lb_pool_member":
+		item = "member"
+	case "ibm_is_lb_listener":
+		block, tag, item = isLBCompositeListener, isLBCompositeListenerTag, "listener"
+	}
+	if tags.Contains(tag) {
+		return fmt.Errorf("%s conflicts with the %s blocks of the ibm_is_lb of load balancer %s: the %s blocks are authoritative and delete the %ss that they do not describe. Add the %s to the %s blocks of ibm_is_lb, or remove the %s blocks", resourceType, block, lbID, block, item, item, block, block)
+	}
+	return nil
+}
+
+// resourceIBMISLBCompositeConflictCustomizeDiff fails the plan of a pool,
+// pool member or listener of a load balancer whose pools or listeners are
+// managed by the pool or listener blocks of ibm_is_lb. When the load balancer
+// is created in the same apply, its ID is only known, and the check made,
+// when the resource is applied.
+func resourceIBMISLBCompositeConflictCustomizeDiff(diff *schema.ResourceDiff, meta interface{}, resourceType, lbKey string) error {
+	if !diff.NewValueKnown(lbKey) {
+		return nil
+	}
+	lbID := diff.Get(lbKey).(string)
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	}
+	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Load Balancer (%s): %s\n%s", lbID, err, response)
+	}
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, *lb.CRN, "", isUserTagType)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Load Balancer (%s) tags: %s", lbID, err)
+	}
+	return lbCompositeConflict(resourceType, lbID, tags)
+}
+
+// validateLBCompositeBlocks checks the pool and listener blocks. poolNames
+// holds the pools that the default pools of the listeners can refer to, nil
+// if they are only known from the load balancer.
+func validateLBCompositeBlocks(pools, listeners []interface{}, poolNames map[string]bool) error {
+	names := map[string]bool{}
+	for _, poolIntf := range pools {
+		pool := poolIntf.(map[string]interface{})
+		name := pool[isLBPoolName].(string)
+		if names[name] {
+			return fmt.Errorf("pool name %q is used by more than one pool block", name)
+		}
+		names[name] = true
+		members := map[string]bool{}
+		for _, memberIntf := range pool[isLBCompositeMember].([]interface{}) {
+			member := memberIntf.(map[string]interface{})
+			address := member[isLBPoolMemberTargetAddress].(string)
+			targetID := member[isLBPoolMemberTargetID].(string)
+			if (address == "") == (targetID == "") {
+				return fmt.Errorf("exactly one of target_address and target_id must be set for the members of pool %q", name)
+			}
+			key := lbCompositeMemberKey(member)
+			if members[key] {
+				return fmt.Errorf("member %s is set more than once in pool %q", key, name)
+			}
+			members[key] = true
+		}
+	}
+	ports := map[int]bool{}
+	for _, listenerIntf := range listeners {
+		listener := listenerIntf.(map[string]interface{})
+		port := listener[isLBListenerPort].(int)
+		if ports[port] {
+			return fmt.Errorf("port %d is used by more than one listener block", port)
+		}
+		ports[port] = true
+		defaultPool := listener[isLBListenerDefaultPool].(string)
+		if defaultPool != "" && poolNames != nil && !poolNames[defaultPool] {
+			return fmt.Errorf("default_pool %q of the listener on port %d is not a pool block", defaultPool, port)
+		}
+	}
+	return nil
+}
+
+func lbCompositePoolNames(pools []interface{}) map[string]bool {
+	names := map[string]bool{}
+	for _, pool := range pools {
+		names[pool.(map[string]interface{})[isLBPoolName].(string)] = true
+	}
+	return names
+}
+
+func lbCompositeMemberKey(member map[string]interface{}) string {
+	target := member[isLBPoolMemberTargetAddress].(string)
+	if target == "" {
+		target = member[isLBPoolMemberTargetID].(string)
+	}
+	return fmt.Sprintf("%s:%d", target, member[isLBPoolMemberPort].(int))
+}
+
+func expandLBCompositeMembers(members []interface{}) []vpcv1.LoadBalancerPoolMemberPrototype {
+	prototypes := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, len(members))
+	for _, memberIntf := range members {
+		member := memberIntf.(map[string]interface{})
+		port := int64(member[isLBPoolMemberPort].(int))
+		weight := int64(member[isLBPoolMemberWeight].(int))
+		target := &vpcv1.LoadBalancerPoolMemberTargetPrototype{}
+		if address := member[isLBPoolMemberTargetAddress].(string); address != "" {
+			target.Address = &address
+		} else {
+			targetID := member[isLBPoolMemberTargetID].(string)
+			target.ID = &targetID
+		}
+		prototypes = append(prototypes, vpcv1.LoadBalancerPoolMemberPrototype{
+			Port:   &port,
+			Target: target,
+			Weight: &weight,
+		})
+	}
+	return prototypes
+}
+
+func expandLBCompositeHealthMonitor(pool map[string]interface{}) *vpcv1.LoadBalancerPoolHealthMonitorPrototype {
+	delay := int64(pool[isLBPoolHealthDelay].(int))
+	maxRetries := int64(pool[isLBPoolHealthRetries].(int))
+	timeout := int64(pool[isLBPoolHealthTimeout].(int))
+	healthType := pool[isLBPoolHealthType].(string)
+	healthMonitor := &vpcv1.LoadBalancerPoolHealthMonitorPrototype{
+		Delay:      &delay,
+		MaxRetries: &maxRetries,
+		Timeout:    &timeout,
+		Type:       &healthType,
+	}
+	if urlPath := pool[isLBPoolHealthMonitorURL].(string); urlPath != "" {
+		healthMonitor.URLPath = &urlPath
+	}
+	if port := int64(pool[isLBPoolHealthMonitorPort].(int)); port > 0 {
+		healthMonitor.Port = &port
+	}
+	return healthMonitor
+}
+
+func expandLBCompositeSessionPersistence(pool map[string]interface{}) *vpcv1.LoadBalancerPoolSessionPersistencePrototype {
+	spType := pool[isLBPoolSessPersistenceType].(string)
+	if spType == "" {
+		return nil
+	}
+	sessionPersistence := &vpcv1.LoadBalancerPoolSessionPersistencePrototype{
+		Type: &spType,
+	}
+	if cookieName := pool[isLBPoolSessPersistenceAppCookieName].(string); cookieName != "" {
+		sessionPersistence.CookieName = &cookieName
+	}
+	return sessionPersistence
+}
+
+func expandLBCompositePoolPrototypes(pools []interface{}) []vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext {
+	prototypes := make([]vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext, 0, len(pools))
+	for _, poolIntf := range pools {
+		pool := poolIntf.(map[string]interface{})
+		name := pool[isLBPoolName].(string)
+		algorithm := pool[isLBPoolAlgorithm].(string)
+		protocol := pool[isLBPoolProtocol].(string)
+		proxyProtocol := pool[isLBPoolProxyProtocol].(string)
+		prototype := vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext{
+			Name:          &name,
+			Algorithm:     &algorithm,
+			Protocol:      &protocol,
+			ProxyProtocol: &proxyProtocol,
+			HealthMonitor: expandLBCompositeHealthMonitor(pool),
+			Members:       expandLBCompositeMembers(pool[isLBCompositeMember].([]interface{})),
+		}
+		if sessionPersistence := expandLBCompositeSessionPersistence(pool); sessionPersistence != nil {
+			prototype.SessionPersistence = sessionPersistence
+		}
+		prototypes = append(prototypes, prototype)
+	}
+	return prototypes
+}
+
+func expandLBCompositeListenerPrototypes(listeners []interface{}) []vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext {
+	prototypes := make([]vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext, 0, len(listeners))
+	for _, listenerIntf := range listeners {
+		listener := listenerIntf.(map[string]interface{})
+		port := int64(listener[isLBListenerPort].(int))
+		protocol := listener[isLBListenerProtocol].(string)
+		acceptProxyProtocol := listener[isLBListenerAcceptProxyProtocol].(bool)
+		prototype := vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
+			Port:                &port,
+			Protocol:            &protocol,
+			AcceptProxyProtocol: &acceptProxyProtocol,
+		}
+		if defaultPool := listener[isLBListenerDefaultPool].(string); defaultPool != "" {
+			prototype.DefaultPool = &vpcv1.LoadBalancerPoolIdentityByName{
+				Name: &defaultPool,
+			}
+		}
+		if certificateCRN := listener[isLBListenerCertificateInstance].(string); certificateCRN != "" {
+			prototype.CertificateInstance = &vpcv1.CertificateInstanceIdentity{
+				CRN: &certificateCRN,
+			}
+		}
+		if connLimit := int64(listener[isLBListenerConnectionLimit].(int)); connLimit > 0 {
+			prototype.ConnectionLimit = &connLimit
+		}
+		if idleConnectionTimeout := int64(listener[isLBListenerIdleConnectionTimeout].(int)); idleConnectionTimeout > 0 {
+			prototype.IdleConnectionTimeout = &idleConnectionTimeout
+		}
+		prototypes = append(prototypes, prototype)
+	}
+	return prototypes
+}
+
+func flattenLBCompositePool(pool vpcv1.LoadBalancerPool, members []vpcv1.LoadBalancerPoolMember, prior map[string]interface{}) map[string]interface{} {
+	poolMap := map[string]interface{}{
+		"id":                                 *pool.ID,
+		isLBPoolName:                         *pool.Name,
+		isLBPoolAlgorithm:                    *pool.Algorithm,
+		isLBPoolProtocol:                     *pool.Protocol,
+		isLBPoolProxyProtocol:                flex.StringValue(pool.ProxyProtocol),
+		isLBPoolSessPersistenceType:          "",
+		isLBPoolSessPersistenceAppCookieName: "",
+	}
+	if healthMonitor, ok := pool.HealthMonitor.(*vpcv1.LoadBalancerPoolHealthMonitor); ok && healthMonitor != nil {
+		poolMap[isLBPoolHealthDelay] = flex.IntValue(healthMonitor.Delay)
+		poolMap[isLBPoolHealthRetries] = flex.IntValue(healthMonitor.MaxRetries)
+		poolMap[isLBPoolHealthTimeout] = flex.IntValue(healthMonitor.Timeout)
+		poolMap[isLBPoolHealthType] = flex.StringValue(healthMonitor.Type)
+		poolMap[isLBPoolHealthMonitorURL] = flex.StringValue(healthMonitor.URLPath)
+		poolMap[isLBPoolHealthMonitorPort] = flex.IntValue(healthMonitor.Port)
+	}
+	if pool.SessionPersistence != nil {
+		poolMap[isLBPoolSessPersistenceType] = flex.StringValue(pool.SessionPersistence.Type)
+		if flex.StringValue(pool.SessionPersistence.Type) == "app_cookie" {
+			poolMap[isLBPoolSessPersistenceAppCookieName] = flex.StringValue(pool.SessionPersistence.CookieName)
+		}
+	}
+	memberList := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		memberMap := map[string]interface{}{
+			"id":                        *member.ID,
+			isLBPoolMemberPort:          flex.IntValue(member.Port),
+			isLBPoolMemberWeight:        flex.IntValue(member.Weight),
+			isLBPoolMemberTargetAddress: "",
+			isLBPoolMemberTargetID:      "",
+		}
+		if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok && target != nil {
+			if target.Address != nil {
+				memberMap[isLBPoolMemberTargetAddress] = *target.Address
+			} else if target.ID != nil {
+				memberMap[isLBPoolMemberTargetID] = *target.ID
+			}
+		}
+		memberList = append(memberList, memberMap)
+	}
+	var priorMembers []interface{}
+	if prior != nil {
+		priorMembers, _ = prior[isLBCompositeMember].([]interface{})
+	}
+	poolMap[isLBCompositeMember] = orderLBCompositeItems(memberList, priorMembers, lbCompositeMemberKey)
+	return poolMap
+}
+
+func flattenLBCompositeListener(listener vpcv1.LoadBalancerListener) map[string]interface{} {
+	listenerMap := map[string]interface{}{
+		"id":                              *listener.ID,
+		isLBListenerPort:                  flex.IntValue(listener.Port),
+		isLBListenerProtocol:              *listener.Protocol,
+		isLBListenerDefaultPool:           "",
+		isLBListenerCertificateInstance:   "",
+		isLBListenerConnectionLimit:       flex.IntValue(listener.ConnectionLimit),
+		isLBListenerIdleConnectionTimeout: flex.IntValue(listener.IdleConnectionTimeout),
+		isLBListenerAcceptProxyProtocol:   listener.AcceptProxyProtocol != nil && *listener.AcceptProxyProtocol,
+	}
+	if listener.DefaultPool != nil {
+		listenerMap[isLBListenerDefaultPool] = flex.StringValue(listener.DefaultPool.Name)
+	}
+	if listener.CertificateInstance != nil {
+		listenerMap[isLBListenerCertificateInstance] = flex.StringValue(listener.CertificateInstance.CRN)
+	}
+	return listenerMap
+}
+
+func lbCompositePoolKey(pool map[string]interface{}) string {
+	return pool[isLBPoolName].(string)
+}
+
+func lbCompositeListenerKey(listener map[string]interface{}) string {
+	return strconv.Itoa(listener[isLBListenerPort].(int))
+}
+
+// orderLBCompositeItems orders the items read from the load balancer like the
+// prior items with the same key, so that a read does not reorder the blocks.
+// The items that are not in prior follow, in the order of the API.
+func orderLBCompositeItems(items []map[string]interface{}, prior []interface{}, key func(map[string]interface{}) string) []interface{} {
+	index := map[string]int{}
+	for i, item := range prior {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			index[key(itemMap)] = i
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		ii, iok := index[key(items[i])]
+		ji, jok := index[key(items[j])]
+		if iok && jok {
+			return ii < ji
+		}
+		return iok && !jok
+	})
+	ordered := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		ordered = append(ordered, item)
+	}
+	return ordered
+}
+
+// lbCompositeGet sets the pool and listener blocks that are set from the
+// pools, members and listeners of the load balancer.
+func lbCompositeGet(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, lbID string, blocks lbCompositeBlocks) error {
+	if blocks.pools {
+		priorPools := map[string]map[string]interface{}{}
+		for _, pool := range d.Get(isLBCompositePool).([]interface{}) {
+			if poolMap, ok := pool.(map[string]interface{}); ok {
+				priorPools[lbCompositePoolKey(poolMap)] = poolMap
+			}
+		}
+		poolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, &vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: &lbID})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the pools of load balancer %s: %s\n%s", lbID, err, response)
+		}
+		pools := make([]map[string]interface{}, 0, len(poolCollection.Pools))
+		for _, pool := range poolCollection.Pools {
+			memberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, &vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: &lbID, PoolID: pool.ID})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error listing the members of pool %s: %s\n%s", *pool.ID, err, response)
+			}
+			pools = append(pools, flattenLBCompositePool(pool, memberCollection.Members, priorPools[*pool.Name]))
+		}
+		if err = d.Set(isLBCompositePool, orderLBCompositeItems(pools, d.Get(isLBCompositePool).([]interface{}), lbCompositePoolKey)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting pool: %s", err)
+		}
+	}
+
+	if blocks.listeners {
+		listenerCollection, response, err := sess.ListLoadBalancerListenersWithContext(context, &vpcv1.ListLoadBalancerListenersOptions{LoadBalancerID: &lbID})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the listeners of load balancer %s: %s\n%s", lbID, err, response)
+		}
+		listeners := make([]map[string]interface{}, 0, len(listenerCollection.Listeners))
+		for _, listener := range listenerCollection.Listeners {
+			if listener.Port == nil {
+				// Listeners with a port range cannot be listener blocks
+				continue
+			}
+			listeners = append(listeners, flattenLBCompositeListener(listener))
+		}
+		if err = d.Set(isLBCompositeListener, orderLBCompositeItems(listeners, d.Get(isLBCompositeListener).([]interface{}), lbCompositeListenerKey)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting listener: %s", err)
+		}
+	}
+	return nil
+}
+
+// lbCompositePoolChanged returns true if the settings of the pool differ from
+// its pool block. The health check URL and port are only compared when they
+// are set in the block.
+func lbCompositePoolChanged(current, desired map[string]interface{}) bool {
+	for _, key := range []string{isLBPoolAlgorithm, isLBPoolProtocol, isLBPoolProxyProtocol, isLBPoolHealthDelay, isLBPoolHealthRetries, isLBPoolHealthTimeout, isLBPoolHealthType, isLBPoolSessPersistenceType, isLBPoolSessPersistenceAppCookieName} {
+		if current[key] != desired[key] {
+			return true
+		}
+	}
+	if url := desired[isLBPoolHealthMonitorURL].(string); url != "" && current[isLBPoolHealthMonitorURL] != url {
+		return true
+	}
+	if port := desired[isLBPoolHealthMonitorPort].(int); port > 0 && current[isLBPoolHealthMonitorPort] != port {
+		return true
+	}
+	return false
+}
+
+// lbCompositeMembersChanged returns true if the members of the pool differ
+// from the member blocks, in which case all the members are replaced at once.
+func lbCompositeMembersChanged(current []interface{}, desired []interface{}) bool {
+	if len(current) != len(desired) {
+		return true
+	}
+	weights := map[string]int{}
+	for _, member := range current {
+		memberMap := member.(map[string]interface{})
+		weights[lbCompositeMemberKey(memberMap)] = memberMap[isLBPoolMemberWeight].(int)
+	}
+	for _, member := range desired {
+		memberMap := member.(map[string]interface{})
+		weight, ok := weights[lbCompositeMemberKey(memberMap)]
+		if !ok || weight != memberMap[isLBPoolMemberWeight].(int) {
+			return true
+		}
+	}
+	return false
+}
+
+// lbCompositeListenerChanged returns true if the settings of the listener
+// differ from its listener block. The connection limit and idle connection
+// timeout are only compared when they are set in the block.
+func lbCompositeListenerChanged(current, desired map[string]interface{}) bool {
+	for _, key := range []string{isLBListenerProtocol, isLBListenerDefaultPool, isLBListenerCertificateInstance, isLBListenerAcceptProxyProtocol} {
+		if current[key] != desired[key] {
+			return true
+		}
+	}
+	for _, key := range []string{isLBListenerConnectionLimit, isLBListenerIdleConnectionTimeout} {
+		if value := desired[key].(int); value > 0 && current[key] != value {
+			return true
+		}
+	}
+	return false
+}
+
+// lbCompositeChange is one call of the changes that reconcileLBComposite makes
+// to a load balancer.
+type lbCompositeChange struct {
+	description string
+	apply       func() (*core.DetailedResponse, error)
+}
+
+// reconcileLBComposite updates the pools, members and listeners of the load
+// balancer to match its pool and listener blocks. The load balancer rejects a
+// change with a conflict while it applies the previous one, so each change is
+// retried on conflict and the load balancer is waited for once, after the
+// last change.
+func reconcileLBComposite(context context.Context, sess *vpcv1.VpcV1, lbID string, blocks lbCompositeBlocks, pools, listeners []interface{}, timeout time.Duration) error {
+	changes, err := planLBComposite(context, sess, lbID, blocks, pools, listeners)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, change := range changes {
+		log.Printf("[INFO] Load balancer %s: %s", lbID, change.description)
+		err = resource.RetryContext(context, timeout, func() *resource.RetryError {
+			response, err := change.apply()
+			if err != nil {
+				if response != nil && response.StatusCode == 409 {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error %s of load balancer %s: %s", change.description, lbID, err)
+		}
+	}
+	_, err = isWaitForLBAvailable(context, sess, lbID, timeout)
+	return err
+}
+
+// planLBComposite returns the changes that make the pools, members and
+// listeners of the load balancer match its pool and listener blocks, in the
+// order that keeps the load balancer valid: listeners that are no longer
+// listed are deleted, pools are created with their members or updated with
+// all their members replaced at once, listeners are created or updated, and
+// the pools that are no longer listed are deleted last, when no listener uses
+// them.
+func planLBComposite(context context.Context, sess *vpcv1.VpcV1, lbID string, blocks lbCompositeBlocks, pools, listeners []interface{}) ([]lbCompositeChange, error) {
+	poolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, &vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: &lbID})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the pools of load balancer %s: %s\n%s", lbID, err, response)
+	}
+	poolIDs := map[string]string{}
+	existingPools := map[string]vpcv1.LoadBalancerPool{}
+	for _, pool := range poolCollection.Pools {
+		poolIDs[*pool.Name] = *pool.ID
+		existingPools[*pool.Name] = pool
+	}
+	listenerCollection, response, err := sess.ListLoadBalancerListenersWithContext(context, &vpcv1.ListLoadBalancerListenersOptions{LoadBalancerID: &lbID})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the listeners of load balancer %s: %s\n%s", lbID, err, response)
+	}
+	existingListeners := map[int]vpcv1.LoadBalancerListener{}
+	for _, listener := range listenerCollection.Listeners {
+		if listener.Port != nil {
+			existingListeners[flex.IntValue(listener.Port)] = listener
+		}
+	}
+
+	changes := []lbCompositeChange{}
+
+	if blocks.listeners {
+		ports := map[int]bool{}
+		for _, listener := range listeners {
+			ports[listener.(map[string]interface{})[isLBListenerPort].(int)] = true
+		}
+		for _, listener := range listenerCollection.Listeners {
+			if listener.Port != nil && ports[flex.IntValue(listener.Port)] {
+				continue
+			}
+			listenerID := *listener.ID
+			changes = append(changes, lbCompositeChange{
+				description: fmt.Sprintf("deleting listener %s", listenerID),
+				apply: func() (*core.DetailedResponse, error) {
+					return sess.DeleteLoadBalancerListenerWithContext(context, &vpcv1.DeleteLoadBalancerListenerOptions{LoadBalancerID: &lbID, ID: &listenerID})
+				},
+			})
+		}
+	}
+
+	if blocks.pools {
+		prototypes := expandLBCompositePoolPrototypes(pools)
+		for i, poolIntf := range pools {
+			pool := poolIntf.(map[string]interface{})
+			name := pool[isLBPoolName].(string)
+			existing, ok := existingPools[name]
+			if !ok {
+				prototype := prototypes[i]
+				options := &vpcv1.CreateLoadBalancerPoolOptions{
+					LoadBalancerID:     &lbID,
+					Name:               prototype.Name,
+					Algorithm:          prototype.Algorithm,
+					Protocol:           prototype.Protocol,
+					ProxyProtocol:      prototype.ProxyProtocol,
+					HealthMonitor:      prototype.HealthMonitor,
+					Members:            prototype.Members,
+					SessionPersistence: prototype.SessionPersistence,
+				}
+				// the ID of the pool is only known once it is created, so the
+				// listeners look it up when they are applied
+				poolIDs[name] = ""
+				changes = append(changes, lbCompositeChange{
+					description: fmt.Sprintf("creating pool %s with %d members", name, len(prototype.Members)),
+					apply: func() (*core.DetailedResponse, error) {
+						created, response, err := sess.CreateLoadBalancerPoolWithContext(context, options)
+						if err == nil {
+							poolIDs[name] = *created.ID
+						}
+						return response, err
+					},
+				})
+				continue
+			}
+
+			memberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, &vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: &lbID, PoolID: existing.ID})
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error listing the members of pool %s: %s\n%s", *existing.ID, err, response)
+			}
+			current := flattenLBCompositePool(existing, memberCollection.Members, nil)
+			if lbCompositePoolChanged(current, pool) {
+				options, err := updateLBCompositePoolOptions(lbID, *existing.ID, current, pool)
+				if err != nil {
+					return nil, err
+				}
+				changes = append(changes, lbCompositeChange{
+					description: fmt.Sprintf("updating pool %s", *existing.ID),
+					apply: func() (*core.DetailedResponse, error) {
+						_, response, err := sess.UpdateLoadBalancerPoolWithContext(context, options)
+						return response, err
+					},
+				})
+			}
+			members := pool[isLBCompositeMember].([]interface{})
+			if lbCompositeMembersChanged(current[isLBCompositeMember].([]interface{}), members) {
+				options := &vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+					LoadBalancerID: &lbID,
+					PoolID:         existing.ID,
+					Members:        expandLBCompositeMembers(members),
+				}
+				changes = append(changes, lbCompositeChange{
+					description: fmt.Sprintf("replacing the members of pool %s with %d members", *existing.ID, len(members)),
+					apply: func() (*core.DetailedResponse, error) {
+						_, response, err := sess.ReplaceLoadBalancerPoolMembersWithContext(context, options)
+						return response, err
+					},
+				})
+			}
+		}
+	}
+
+	if blocks.listeners {
+		for _, listenerIntf := range listeners {
+			listener := listenerIntf.(map[string]interface{})
+			port := listener[isLBListenerPort].(int)
+			defaultPool := listener[isLBListenerDefaultPool].(string)
+			if _, ok := poolIDs[defaultPool]; defaultPool != "" && !ok {
+				return nil, fmt.Errorf("[ERROR] default_pool %q of the listener on port %d is not a pool of load balancer %s", defaultPool, port, lbID)
+			}
+			if existing, ok := existingListeners[port]; ok {
+				current := flattenLBCompositeListener(existing)
+				if !lbCompositeListenerChanged(current, listener) {
+					continue
+				}
+				changes = append(changes, lbCompositeChange{
+					description: fmt.Sprintf("updating listener %s", *existing.ID),
+					apply: func() (*core.DetailedResponse, error) {
+						options, err := updateLBCompositeListenerOptions(lbID, *existing.ID, poolIDs[defaultPool], current, listener)
+						if err != nil {
+							return nil, err
+						}
+						_, response, err := sess.UpdateLoadBalancerListenerWithContext(context, options)
+						return response, err
+					},
+				})
+			} else {
+				changes = append(changes, lbCompositeChange{
+					description: fmt.Sprintf("creating listener on port %d", port),
+					apply: func() (*core.DetailedResponse, error) {
+						options := createLBCompositeListenerOptions(lbID, poolIDs[defaultPool], listener)
+						_, response, err := sess.CreateLoadBalancerListenerWithContext(context, options)
+						return response, err
+					},
+				})
+			}
+		}
+	}
+
+	if blocks.pools {
+		names := lbCompositePoolNames(pools)
+		for _, pool := range poolCollection.Pools {
+			if names[*pool.Name] {
+				continue
+			}
+			poolID := *pool.ID
+			changes = append(changes, lbCompositeChange{
+				description: fmt.Sprintf("deleting pool %s", poolID),
+				apply: func() (*core.DetailedResponse, error) {
+					return sess.DeleteLoadBalancerPoolWithContext(context, &vpcv1.DeleteLoadBalancerPoolOptions{LoadBalancerID: &lbID, ID: &poolID})
+				},
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func updateLBCompositePoolOptions(lbID, poolID string, current, pool map[string]interface{}) (*vpcv1.UpdateLoadBalancerPoolOptions, error) {
+	algorithm := pool[isLBPoolAlgorithm].(string)
+	protocol := pool[isLBPoolProtocol].(string)
+	proxyProtocol := pool[isLBPoolProxyProtocol].(string)
+	healthMonitor := expandLBCompositeHealthMonitor(pool)
+	poolPatchModel := &vpcv1.LoadBalancerPoolPatch{
+		Algorithm:     &algorithm,
+		Protocol:      &protocol,
+		ProxyProtocol: &proxyProtocol,
+		HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPatch{
+			Delay:      healthMonitor.Delay,
+			MaxRetries: healthMonitor.MaxRetries,
+			Timeout:    healthMonitor.Timeout,
+			Type:       healthMonitor.Type,
+			URLPath:    healthMonitor.URLPath,
+			Port:       healthMonitor.Port,
+		},
+	}
+	sessionPersistence := expandLBCompositeSessionPersistence(pool)
+	if sessionPersistence != nil {
+		poolPatchModel.SessionPersistence = &vpcv1.LoadBalancerPoolSessionPersistencePatch{
+			Type:       sessionPersistence.Type,
+			CookieName: sessionPersistence.CookieName,
+		}
+	}
+	poolPatch, err := poolPatchModel.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for LoadBalancerPoolPatch: %s", err)
+	}
+	if sessionPersistence == nil && current[isLBPoolSessPersistenceType] != "" {
+		poolPatch["session_persistence"] = nil
+	}
+	return &vpcv1.UpdateLoadBalancerPoolOptions{
+		LoadBalancerID:        &lbID,
+		ID:                    &poolID,
+		LoadBalancerPoolPatch: poolPatch,
+	}, nil
+}
+
+func createLBCompositeListenerOptions(lbID, defaultPoolID string, listener map[string]interface{}) *vpcv1.CreateLoadBalancerListenerOptions {
+	prototype := expandLBCompositeListenerPrototypes([]interface{}{listener})[0]
+	options := &vpcv1.CreateLoadBalancerListenerOptions{
+		LoadBalancerID:        &lbID,
+		Port:                  prototype.Port,
+		Protocol:              prototype.Protocol,
+		AcceptProxyProtocol:   prototype.AcceptProxyProtocol,
+		CertificateInstance:   prototype.CertificateInstance,
+		ConnectionLimit:       prototype.ConnectionLimit,
+		IdleConnectionTimeout: prototype.IdleConnectionTimeout,
+	}
+	if defaultPoolID != "" {
+		options.DefaultPool = &vpcv1.LoadBalancerPoolIdentity{
+			ID: &defaultPoolID,
+		}
+	}
+	return options
+}
+
+func updateLBCompositeListenerOptions(lbID, listenerID, defaultPoolID string, current, listener map[string]interface{}) (*vpcv1.UpdateLoadBalancerListenerOptions, error) {
+	prototype := expandLBCompositeListenerPrototypes([]interface{}{listener})[0]
+	listenerPatchModel := &vpcv1.LoadBalancerListenerPatch{
+		Protocol:              prototype.Protocol,
+		AcceptProxyProtocol:   prototype.AcceptProxyProtocol,
+		ConnectionLimit:       prototype.ConnectionLimit,
+		IdleConnectionTimeout: prototype.IdleConnectionTimeout,
+	}
+	if certificateCRN := listener[isLBListenerCertificateInstance].(string); certificateCRN != "" {
+		listenerPatchModel.CertificateInstance = &vpcv1.CertificateInstanceIdentity{
+			CRN: &certificateCRN,
+		}
+	}
+	if defaultPoolID != "" {
+		listenerPatchModel.DefaultPool = &vpcv1.LoadBalancerListenerDefaultPoolPatch{
+			ID: &defaultPoolID,
+		}
+	}
+	listenerPatch, err := listenerPatchModel.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for LoadBalancerListenerPatch: %s", err)
+	}
+	if defaultPoolID == "" && current[isLBListenerDefaultPool] != "" {
+		listenerPatch["default_pool"] = nil
+	}
+	return &vpcv1.UpdateLoadBalancerListenerOptions{
+		LoadBalancerID:            &lbID,
+		ID:                        &listenerID,
+		LoadBalancerListenerPatch: listenerPatch,
+	}, nil
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	testLBCompositePools = `{"pools": [
+		{"id": "pool-id", "name": "web", "algorithm": "round_robin", "protocol": "http", "proxy_protocol": "disabled",
+		 "health_monitor": {"delay": 5, "max_retries": 2, "timeout": 2, "type": "http", "url_path": "/"}}
+	]}`
+	testLBCompositeMembers = `{"members": [
+		{"id": "member-id", "port": 8080, "weight": 50, "target": {"address": "10.240.0.4"}}
+	]}`
+	testLBCompositeListeners = `{"listeners": [
+		{"id": "listener-id", "port": 80, "protocol": "http", "default_pool": {"id": "pool-id", "name": "web"},
+		 "connection_limit": 2000, "idle_connection_timeout": 50, "accept_proxy_protocol": false}
+	]}`
+)
+
+// testLBCompositeSession returns a client that is served the pools, members
+// and listeners of load balancer lb-id, and that fails any other request.
+func testLBCompositeSession(t *testing.T, pools, listeners string) *vpcv1.VpcV1 {
+	t.Helper()
+	responses := map[string]string{
+		"/load_balancers/lb-id/pools":                 pools,
+		"/load_balancers/lb-id/pools/pool-id/members": testLBCompositeMembers,
+		"/load_balancers/lb-id/listeners":             listeners,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return sess
+}
+
+// testLBCompositeBlocks returns the pool and listener blocks of the raw
+// configuration, with the defaults of the schema.
+func testLBCompositeBlocks(t *testing.T, raw map[string]interface{}) ([]interface{}, []interface{}) {
+	d := schema.TestResourceDataRaw(t, ResourceIBMISLB().Schema, raw)
+	return d.Get(isLBCompositePool).([]interface{}), d.Get(isLBCompositeListener).([]interface{})
+}
+
+func testLBCompositePool(algorithm string, members ...map[string]interface{}) map[string]interface{} {
+	memberList := []interface{}{}
+	for _, member := range members {
+		memberList = append(memberList, member)
+	}
+	return map[string]interface{}{
+		isLBPoolName:          "web",
+		isLBPoolAlgorithm:     algorithm,
+		isLBPoolProtocol:      "http",
+		isLBPoolHealthDelay:   5,
+		isLBPoolHealthRetries: 2,
+		isLBPoolHealthTimeout: 2,
+		isLBPoolHealthType:    "http",
+		isLBCompositeMember:   memberList,
+	}
+}
+
+func testLBCompositeDescriptions(t *testing.T, sess *vpcv1.VpcV1, blocks lbCompositeBlocks, pools, listeners []interface{}) string {
+	t.Helper()
+	changes, err := planLBComposite(context.Background(), sess, "lb-id", blocks, pools, listeners)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	descriptions := []string{}
+	for _, change := range changes {
+		descriptions = append(descriptions, change.description)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func TestPlanLBCompositeCreate(t *testing.T) {
+	sess := testLBCompositeSession(t, `{"pools": []}`, `{"listeners": []}`)
+	pools, listeners := testLBCompositeBlocks(t, map[string]interface{}{
+		isLBCompositePool: []interface{}{testLBCompositePool("round_robin",
+			map[string]interface{}{isLBPoolMemberPort: 8080, isLBPoolMemberTargetAddress: "10.240.0.4"},
+			map[string]interface{}{isLBPoolMemberPort: 8080, isLBPoolMemberTargetAddress: "10.240.0.5"},
+		)},
+		isLBCompositeListener: []interface{}{
+			map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web"},
+		},
+	})
+
+	descriptions := testLBCompositeDescriptions(t, sess, lbCompositeBlocks{pools: true, listeners: true}, pools, listeners)
+	expected := "creating pool web with 2 members, creating listener on port 80"
+	if descriptions != expected {
+		t.Errorf("expected %q, got %q", expected, descriptions)
+	}
+}
+
+func TestPlanLBCompositeUpdate(t *testing.T) {
+	sess := testLBCompositeSession(t, testLBCompositePools, testLBCompositeListeners)
+	member := map[string]interface{}{isLBPoolMemberPort: 8080, isLBPoolMemberTargetAddress: "10.240.0.4"}
+	cases := []struct {
+		name      string
+		pool      map[string]interface{}
+		listener  map[string]interface{}
+		expected  string
+		listeners bool
+	}{
+		{
+			name:     "unchanged",
+			pool:     testLBCompositePool("round_robin", member),
+			listener: map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web"},
+			expected: "",
+		},
+		{
+			name:     "pool settings",
+			pool:     testLBCompositePool("least_connections", member),
+			listener: map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web"},
+			expected: "updating pool pool-id",
+		},
+		{
+			name: "members",
+			pool: testLBCompositePool("round_robin", member,
+				map[string]interface{}{isLBPoolMemberPort: 8080, isLBPoolMemberTargetAddress: "10.240.0.5"},
+			),
+			listener: map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web"},
+			expected: "replacing the members of pool pool-id with 2 members",
+		},
+		{
+			name:     "member weight",
+			pool:     testLBCompositePool("round_robin", map[string]interface{}{isLBPoolMemberPort: 8080, isLBPoolMemberTargetAddress: "10.240.0.4", isLBPoolMemberWeight: 80}),
+			listener: map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web"},
+			expected: "replacing the members of pool pool-id with 1 members",
+		},
+		{
+			name:     "listener settings",
+			pool:     testLBCompositePool("round_robin", member),
+			listener: map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web", isLBListenerConnectionLimit: 100},
+			expected: "updating listener listener-id",
+		},
+		{
+			name:     "listener port",
+			pool:     testLBCompositePool("round_robin", member),
+			listener: map[string]interface{}{isLBListenerPort: 8080, isLBListenerProtocol: "http", isLBListenerDefaultPool: "web"},
+			expected: "deleting listener listener-id, creating listener on port 8080",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pools, listeners := testLBCompositeBlocks(t, map[string]interface{}{
+				isLBCompositePool:     []interface{}{c.pool},
+				isLBCompositeListener: []interface{}{c.listener},
+			})
+			descriptions := testLBCompositeDescriptions(t, sess, lbCompositeBlocks{pools: true, listeners: true}, pools, listeners)
+			if descriptions != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, descriptions)
+			}
+		})
+	}
+}
+
+func TestPlanLBCompositeDelete(t *testing.T) {
+	sess := testLBCompositeSession(t, testLBCompositePools, testLBCompositeListeners)
+	cases := []struct {
+		name     string
+		blocks   lbCompositeBlocks
+		expected string
+	}{
+		{"empty blocks", lbCompositeBlocks{pools: true, listeners: true}, "deleting listener listener-id, deleting pool pool-id"},
+		{"empty listener blocks", lbCompositeBlocks{listeners: true}, "deleting listener listener-id"},
+		{"no blocks", lbCompositeBlocks{}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			descriptions := testLBCompositeDescriptions(t, sess, c.blocks, []interface{}{}, []interface{}{})
+			if descriptions != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, descriptions)
+			}
+		})
+	}
+}
+
+func TestPlanLBCompositeUnknownDefaultPool(t *testing.T) {
+	sess := testLBCompositeSession(t, testLBCompositePools, testLBCompositeListeners)
+	_, listeners := testLBCompositeBlocks(t, map[string]interface{}{
+		isLBCompositeListener: []interface{}{
+			map[string]interface{}{isLBListenerPort: 80, isLBListenerProtocol: "http", isLBListenerDefaultPool: "api"},
+		},
+	})
+	_, err := planLBComposite(context.Background(), sess, "lb-id", lbCompositeBlocks{listeners: true}, nil, listeners)
+	if err == nil || !strings.Contains(err.Error(), `default_pool "api"`) {
+		t.Errorf("expected an error for the unknown default pool, got %v", err)
+	}
+}
+
+func TestLBUserTags(t *testing.T) {
+	tags := flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev"})
+
+	managed := lbUserTags(tags, lbCompositeBlocks{pools: true})
+	if managed.Len() != 2 || !managed.Contains("env:dev") || !managed.Contains(isLBCompositePoolTag) {
+		t.Errorf("expected the tags with %s, got %v", isLBCompositePoolTag, managed.List())
+	}
+	if blocks := lbCompositeBlocksFromTags(managed); blocks != (lbCompositeBlocks{pools: true}) {
+		t.Errorf("expected the pool blocks to be set, got %+v", blocks)
+	}
+	if tags.Contains(isLBCompositePoolTag) {
+		t.Errorf("expected the tags of the configuration to be unchanged, got %v", tags.List())
+	}
+
+	if unmanaged := lbUserTags(tags, lbCompositeBlocks{}); !unmanaged.Equal(tags) {
+		t.Errorf("expected %v, got %v", tags.List(), unmanaged.List())
+	}
+	if empty := lbUserTags(nil, lbCompositeBlocks{listeners: true}); empty.Len() != 1 || !empty.Contains(isLBCompositeListenerTag) {
+		t.Errorf("expected the tag of the listener blocks, got %v", empty.List())
+	}
+}
+
+func TestLBCompositeConflict(t *testing.T) {
+	poolTags := flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev", isLBCompositePoolTag})
+	listenerTags := flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev", isLBCompositeListenerTag})
+	cases := []struct {
+		resourceType string
+		tags         *schema.Set
+		block        string
+	}{
+		{"ibm_is_lb_pool", poolTags, "pool blocks"},
+		{"ibm_is_lb_pool_member", poolTags, "pool blocks"},
+		{"ibm_is_lb_listener", listenerTags, "listener blocks"},
+	}
+	for _, c := range cases {
+		t.Run(c.resourceType, func(t *testing.T) {
+			if err := lbCompositeConflict(c.resourceType, "r006-lb", flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev"})); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			err := lbCompositeConflict(c.resourceType, "r006-lb", c.tags)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, name := range []string{c.resourceType + " ", c.block, "ibm_is_lb ", "r006-lb"} {
+				if !strings.Contains(err.Error(), name) {
+					t.Errorf("expected the error to name %q, got %q", name, err)
+				}
+			}
+		})
+	}
+
+	if err := lbCompositeConflict("ibm_is_lb_listener", "r006-lb", poolTags); err != nil {
+		t.Errorf("expected the listeners not to conflict with the pool blocks, got %v", err)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -57,6 +58,12 @@ func ResourceIBMISLBListener() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBCompositeConflictCustomizeDiff(diff, v, "ibm_is_lb_listener", isLBListenerLBID)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isLBListenerLBID: {
@@ -438,7 +445,7 @@ func lbListenerCreate(context context.Context, d *schema.ResourceData, meta inte
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -452,13 +459,13 @@ func lbListenerCreate(context context.Context, d *schema.ResourceData, meta inte
 		return tfErr.GetDiag()
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBListenerAvailable(context, sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBListenerAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -469,7 +476,7 @@ func lbListenerCreate(context context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
-func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -481,7 +488,7 @@ func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -815,7 +822,7 @@ func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta inte
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -828,14 +835,14 @@ func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta inte
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBListenerAvailable(context, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBListenerAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -889,7 +896,7 @@ func lbListenerDelete(context context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -905,13 +912,13 @@ func lbListenerDelete(context context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBListenerDeleted(context, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBListenerDeleted failed: %s", err.Error()), "ibm_is_lb_listener", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_listener", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -922,7 +929,7 @@ func lbListenerDelete(context context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
-func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -934,7 +941,7 @@ func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
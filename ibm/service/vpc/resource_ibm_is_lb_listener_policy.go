@@ -611,7 +611,7 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 	conns.IbmMutexKV.Lock(isLBKey)
 	defer conns.IbmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -627,7 +627,7 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -636,7 +636,7 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 	return nil
 }
 
-func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyPending},
@@ -647,7 +647,7 @@ func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (i
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -670,7 +670,7 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -681,7 +681,7 @@ func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -941,7 +941,7 @@ func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, met
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -954,7 +954,7 @@ func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, met
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1020,7 +1020,7 @@ func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, met
 		ID:             &ID,
 	}
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1033,7 +1033,7 @@ func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, met
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListnerPolicyDeleted failed: %s", err.Error()), "ibm_is_lb_listener_policy", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1041,7 +1041,7 @@ func lbListenerPolicyDelete(context context.Context, d *schema.ResourceData, met
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
@@ -1052,7 +1052,7 @@ func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -271,7 +271,7 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 	conns.IbmMutexKV.Lock(isLBKey)
 	defer conns.IbmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLoadbalancerAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLoadbalancerAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -287,7 +287,7 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyRuleAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyRuleAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -297,7 +297,7 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadbalancerAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRulePending},
@@ -308,7 +308,7 @@ func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -331,7 +331,7 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -342,7 +342,7 @@ func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -520,7 +520,7 @@ func lbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData,
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLoadbalancerAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLoadbalancerAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -534,7 +534,7 @@ func lbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData,
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyRuleAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListenerPolicyRuleAvailable failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -612,7 +612,7 @@ func lbListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData,
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyRuleDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbListnerPolicyRuleDeleted failed: %s", err.Error()), "ibm_is_lb_listener_policy_rule", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -620,7 +620,7 @@ func lbListenerPolicyRuleDelete(context context.Context, d *schema.ResourceData,
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyRuleDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
@@ -631,7 +631,7 @@ func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
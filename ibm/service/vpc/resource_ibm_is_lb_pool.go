@@ -65,6 +65,9 @@ func ResourceIBMISLBPool() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceIBMISLBPoolCookieValidate(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISLBCompositeConflictCustomizeDiff(diff, v, "ibm_is_lb_pool", isLBID)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -362,7 +365,7 @@ func lbPoolCreate(context context.Context, d *schema.ResourceData, meta interfac
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateLoadBalancerPoolWithContext failed: %s", err.Error()), "ibm_is_lb_pool", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -415,14 +418,14 @@ func lbPoolCreate(context context.Context, d *schema.ResourceData, meta interfac
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -737,14 +740,14 @@ func lbPoolUpdate(context context.Context, d *schema.ResourceData, meta interfac
 		isLBKey := "load_balancer_key_" + lbID
 		conns.IbmMutexKV.Lock(isLBKey)
 		defer conns.IbmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err := isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -776,14 +779,14 @@ func lbPoolUpdate(context context.Context, d *schema.ResourceData, meta interfac
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -836,14 +839,14 @@ func lbPoolDelete(context context.Context, d *schema.ResourceData, meta interfac
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolActive failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -860,14 +863,14 @@ func lbPoolDelete(context context.Context, d *schema.ResourceData, meta interfac
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolDeleted(context, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolDeleted failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(context, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -920,7 +923,7 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return true, nil
 }
 
-func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolActive(ctx context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -932,7 +935,7 @@ func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -955,7 +958,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 	}
 }
 
-func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -967,7 +970,7 @@ func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -114,6 +114,51 @@ func TestAccIBMISLB_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISLB_composite(t *testing.T) {
+	var lb string
+	vpcname := fmt.Sprintf("tflb-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflb-subnet-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBCompositeConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, []string{"10.0.0.10", "10.0.0.11"}, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBExists("ibm_is_lb.testacc_LB", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "pool.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "pool.0.member.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_lb.testacc_LB", "pool.0.id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "listener.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "listener.0.default_pool", "tf-pool"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBCompositeConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, []string{"10.0.0.10", "10.0.0.12", "10.0.0.13"}, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBExists("ibm_is_lb.testacc_LB", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "pool.0.member.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "pool.0.member.1.target_address", "10.0.0.12"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "listener.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb.testacc_LB", "listener.0.port", "8080"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISLB_failsafe_policy_actions(t *testing.T) {
 	var lb string
 	vpcname := fmt.Sprintf("tflb-vpc-%d", acctest.RandIntRange(10, 100))
@@ -588,6 +633,47 @@ func testAccCheckIBMISLBConfig(vpcname, subnetname, zone, cidr, name string) str
 
 }
 
+func testAccCheckIBMISLBCompositeConfig(vpcname, subnetname, zone, cidr, name string, members []string, port int) string {
+	memberBlocks := ""
+	for _, member := range members {
+		memberBlocks += fmt.Sprintf(`
+			member {
+				port           = 8080
+				target_address = "%s"
+			}`, member)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+		pool {
+			name           = "tf-pool"
+			algorithm      = "round_robin"
+			protocol       = "http"
+			health_delay   = 5
+			health_retries = 2
+			health_timeout = 2
+			health_type    = "http"
+			%s
+		}
+		listener {
+			port         = %d
+			protocol     = "http"
+			default_pool = "tf-pool"
+		}
+}`, vpcname, subnetname, zone, cidr, name, memberBlocks, port)
+}
+
 func testAccCheckIBMISPPNLB(vpcname, subnetname, zone, cidr, name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
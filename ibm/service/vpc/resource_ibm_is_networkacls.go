@@ -484,7 +484,7 @@ func nwaclCreate(context context.Context, d *schema.ResourceData, meta interface
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
 		oldList, newList := d.GetChange(isNetworkACLTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource network acl (%s) tags: %s", d.Id(), err)
@@ -492,7 +492,7 @@ func nwaclCreate(context context.Context, d *schema.ResourceData, meta interface
 	}
 	if _, ok := d.GetOk(isNetworkACLAccessTags); ok {
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *nwacl.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource network acl (%s) access tags: %s", d.Id(), err)
@@ -728,7 +728,7 @@ func nwaclUpdate(context context.Context, d *schema.ResourceData, meta interface
 	}
 	if d.HasChange(isNetworkACLTags) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isUserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource network acl (%s) tags: %s", d.Id(), err)
@@ -736,7 +736,7 @@ func nwaclUpdate(context context.Context, d *schema.ResourceData, meta interface
 	}
 	if d.HasChange(isNetworkACLAccessTags) {
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource network acl (%s) access tags: %s", d.Id(), err)
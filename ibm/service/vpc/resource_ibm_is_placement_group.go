@@ -193,7 +193,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 
 	d.SetId(*placementGroup.ID)
 
-	_, err = isWaitForPlacementGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForPlacementGroupAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPlacementGroupAvailable failed: %s", err.Error()), "ibm_is_placement_group", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -201,7 +201,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	}
 	if _, ok := d.GetOk(isPlacementGroupTags); ok {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating placement group (%s) tags: %s", d.Id(), err)
@@ -211,7 +211,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 
 	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating placement group (%s) access tags: %s", d.Id(), err)
@@ -340,7 +340,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 	}
 	if d.HasChange(isPlacementGroupTags) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
@@ -349,7 +349,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 
 	if d.HasChange(isPlacementGroupAccessTags) {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) access tags: %s", d.Id(), err)
@@ -373,7 +373,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	response, err := vpcClient.DeletePlacementGroupWithContext(context, deletePlacementGroupOptions)
 	if err != nil {
 		if response.StatusCode == 409 {
-			_, err = isWaitForPlacementGroupDeleteRetry(vpcClient, d, d.Id())
+			_, err = isWaitForPlacementGroupDeleteRetry(context, vpcClient, d, d.Id())
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPlacementGroupDeleteRetry failed: %s", err.Error()), "ibm_is_placement_group", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -385,7 +385,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
+	_, err = isWaitForPlacementGroupDelete(context, vpcClient, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPlacementGroupDelete failed: %s", err.Error()), "ibm_is_placement_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -396,7 +396,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	return nil
 }
 
-func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isPlacementGroupDeleting, isPlacementGroupStable, isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
@@ -425,10 +425,10 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isPlacementGroupResourcesAttached},
@@ -454,10 +454,10 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForPlacementGroupAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for placement group (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -469,7 +469,7 @@ func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -247,7 +247,7 @@ func resourceIBMIsPrivatePathServiceGatewayCreate(context context.Context, d *sc
 	}
 
 	d.SetId(*privatePathServiceGateway.ID)
-	_, err = isWaitForPPSGAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err = isWaitForPPSGAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPPSGAvailable failed: %s", err.Error()), "ibm_is_private_path_service_gateway", "create").GetDiag()
 
@@ -412,7 +412,7 @@ func resourceIBMIsPrivatePathServiceGatewayDelete(context context.Context, d *sc
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForPPSGDeleted(vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPPSGDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForPPSGDeleted failed: %s", err.Error()), "ibm_is_private_path_service_gateway", "delete").GetDiag()
 
@@ -422,7 +422,7 @@ func resourceIBMIsPrivatePathServiceGatewayDelete(context context.Context, d *sc
 	return nil
 }
 
-func isWaitForPPSGDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPPSGDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying PPSG (%s) delete", id)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"ppsg_in_use"},
@@ -446,9 +446,9 @@ func isWaitForPPSGDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Du
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
-func isWaitForPPSGDeleted(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPPSGDeleted(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for ppsg (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -460,7 +460,7 @@ func isWaitForPPSGDeleted(vpcClient *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPPSGDeleteRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -479,7 +479,7 @@ func isPPSGDeleteRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRe
 		return ppsg, "deleting", err
 	}
 }
-func isWaitForPPSGAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPPSGAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for ppsg (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -491,7 +491,7 @@ func isWaitForPPSGAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPPSGRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -254,7 +255,7 @@ func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.Resourc
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for PublicGateway available failed: %s", err.Error()), "ibm_is_public_gateway", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -264,7 +265,7 @@ func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.Resourc
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc public gateway (%s) tags: %s", d.Id(), err)
@@ -273,7 +274,7 @@ func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.Resourc
 
 	if _, ok := d.GetOk(isPublicGatewayAccessTags); ok {
 		oldList, newList := d.GetChange(isPublicGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc public gateway (%s) access tags: %s", d.Id(), err)
@@ -283,7 +284,7 @@ func resourceIBMISPublicGatewayCreate(context context.Context, d *schema.Resourc
 	return resourceIBMISPublicGatewayRead(context, d, meta)
 }
 
-func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayAvailable(ctx context.Context, publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -295,7 +296,7 @@ func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -450,7 +451,7 @@ func resourceIBMISPublicGatewayUpdate(context context.Context, d *schema.Resourc
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource Public Gateway (%s) tags: %s", id, err)
@@ -468,7 +469,7 @@ func resourceIBMISPublicGatewayUpdate(context context.Context, d *schema.Resourc
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource Public Gateway (%s) access tags: %s", d.Id(), err)
@@ -537,7 +538,7 @@ func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.Resourc
 					}
 					res, errSub := sess.UnsetSubnetPublicGatewayWithContext(context, unsetSubnetPublicGatewayOptions)
 					if res.StatusCode == 204 {
-						_, err = isWaitForSubnetPublicGatewayUnset(sess, *s.ID, d.Timeout(schema.TimeoutDelete))
+						_, err = isWaitForSubnetPublicGatewayUnset(context, sess, *s.ID, d.Timeout(schema.TimeoutDelete))
 						if err != nil {
 							tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UnsetSubnetPublicGatewayWithContext failed: %s", err.Error()), "ibm_is_public_gateway", "delete")
 							log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -562,7 +563,7 @@ func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.Resourc
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicGatewayDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for PublicGateway deleted failed: %s", err.Error()), "ibm_is_public_gateway", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -572,7 +573,7 @@ func resourceIBMISPublicGatewayDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
-func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayDeleted(ctx context.Context, pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -584,7 +585,7 @@ func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -623,7 +624,7 @@ func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func isWaitForSubnetPublicGatewayUnset(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetPublicGatewayUnset(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be unset.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -635,7 +636,7 @@ func isWaitForSubnetPublicGatewayUnset(subnetC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSubnetPublicGatewayUnsetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
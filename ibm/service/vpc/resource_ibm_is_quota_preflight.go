@@ -0,0 +1,513 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// The modes of the preflight, set by the vpc_quota_preflight argument of
+	// the provider, which is error when it is not set.
+	isQuotaPreflightOff   = "off"
+	isQuotaPreflightWarn  = "warn"
+	isQuotaPreflightError = "error"
+
+	// isQuotaPreflightStateName is the name of the preflight in the state
+	// of the provider configuration.
+	isQuotaPreflightStateName = "vpc_quota_preflight"
+
+	isQuotaFloatingIPs       = "floating_ips"
+	isQuotaInstances         = "instances"
+	isQuotaVCPU              = "vcpu"
+	isQuotaVolumes           = "volumes"
+	isQuotaBareMetalServers  = "bare_metal_servers"
+	isQuotaScopeRegion       = "region"
+	isQuotaVCPUClassPrefix   = isQuotaVCPU + "_"
+	isQuotaUnknownFamilyName = "unknown"
+
+	// isQuotaResourceIDPrefix prefixes the class of the VPC quotas in the
+	// resource quotas of the quota definition of the account, such as
+	// is.floating_ips or is.vcpu_balanced.
+	isQuotaResourceIDPrefix = "is."
+)
+
+// isQuotaDemand is an amount of a quota class that a planned create uses in a
+// scope, which is a zone or the region.
+type isQuotaDemand struct {
+	class  string
+	scope  string
+	amount int64
+}
+
+type isQuotaKey struct {
+	class string
+	scope string
+}
+
+// isQuotaPreflight totals the demands of the creates planned with a provider
+// configuration, and caches the limits and the usage read from the API. The
+// demands are keyed by the planned resource instance, see isQuotaPlannedKey,
+// so that a resource instance that is planned again replaces its demands.
+// Terraform runs the plan and the apply in separate provider processes, so
+// that each process totals the whole plan once.
+type isQuotaPreflight struct {
+	mu       sync.Mutex
+	planned  map[isQuotaKey]map[string]int64
+	limits   map[string]int64
+	usage    map[isQuotaKey]int64
+	read     map[string]bool
+	profiles map[string]*vpcv1.InstanceProfile
+	// warnings are the exceeded quotas found in warn mode, by resource type,
+	// until a create of the type returns them as diagnostics.
+	warnings map[string][]string
+	// unnamed numbers the planned resource instances without a known name.
+	unnamed int
+}
+
+func newISQuotaPreflight() *isQuotaPreflight {
+	return &isQuotaPreflight{
+		planned:  map[isQuotaKey]map[string]int64{},
+		usage:    map[isQuotaKey]int64{},
+		read:     map[string]bool{},
+		warnings: map[string][]string{},
+	}
+}
+
+// isQuotaPreflightOf returns the preflight of a provider configuration.
+func isQuotaPreflightOf(meta interface{}) *isQuotaPreflight {
+	return meta.(conns.ClientSession).ProviderState(isQuotaPreflightStateName, func() interface{} {
+		return newISQuotaPreflight()
+	}).(*isQuotaPreflight)
+}
+
+func isQuotaPreflightMode(meta interface{}) string {
+	return meta.(conns.ClientSession).VPCQuotaPreflight()
+}
+
+// isQuotaPlannedKey returns the key of a planned resource instance: its
+// resource type and name, and the values of scopeKeys, such as the VPC of
+// the resource types whose names are only unique in a VPC. The instances of
+// a count or for_each have different names, so that they are each counted.
+// The key is empty when the name is not known at plan time.
+func isQuotaPlannedKey(diff *schema.ResourceDiff, resourceType string, scopeKeys ...string) string {
+	name, _ := diff.Get("name").(string)
+	if name == "" || !diff.NewValueKnown("name") {
+		return ""
+	}
+	key := []string{resourceType, name}
+	for _, scopeKey := range scopeKeys {
+		scope, _ := diff.Get(scopeKey).(string)
+		key = append(key, scope)
+	}
+	return strings.Join(key, "/")
+}
+
+// isQuotaLimits returns the limits of the VPC quota classes in a quota
+// definition. The vsi_limit of the definition is the instances quota, and the
+// resource quotas prefixed with is. set the quota of the class that follows.
+func isQuotaLimits(definition *resourcemanagerv2.QuotaDefinition) map[string]int64 {
+	limits := map[string]int64{}
+	if definition.VsiLimit != nil && *definition.VsiLimit > 0 {
+		limits[isQuotaInstances] = int64(*definition.VsiLimit)
+	}
+	for _, quota := range definition.ResourceQuotas {
+		resourceID := flex.StringValue(quota.ResourceID)
+		if !strings.HasPrefix(resourceID, isQuotaResourceIDPrefix) || quota.Limit == nil || *quota.Limit <= 0 {
+			continue
+		}
+		limits[strings.TrimPrefix(resourceID, isQuotaResourceIDPrefix)] = int64(*quota.Limit)
+	}
+	return limits
+}
+
+// limit returns the quota of a class, which is 0 when the class is not
+// checked. The vcpu quota applies to each profile family that has no quota
+// of its own.
+func (p *isQuotaPreflight) limit(class string) int64 {
+	if limit, ok := p.limits[class]; ok {
+		return limit
+	}
+	if strings.HasPrefix(class, isQuotaVCPUClassPrefix) {
+		return p.limits[isQuotaVCPU]
+	}
+	return 0
+}
+
+// record records the demands of a planned resource instance, replacing the
+// demands that it recorded before. The demands of a resource instance without
+// a key are recorded apart, each time it is planned.
+func (p *isQuotaPreflight) record(plannedKey string, demands []isQuotaDemand) {
+	if plannedKey == "" {
+		p.unnamed++
+		plannedKey = fmt.Sprintf("unnamed/%d", p.unnamed)
+	}
+	for _, demand := range demands {
+		if demand.amount <= 0 {
+			continue
+		}
+		key := isQuotaKey{class: demand.class, scope: demand.scope}
+		if p.planned[key] == nil {
+			p.planned[key] = map[string]int64{}
+		}
+		p.planned[key][plannedKey] = demand.amount
+	}
+}
+
+// violations returns the quotas that the totals of the plan exceed, in the
+// classes and scopes of the demands of a planned resource instance.
+func (p *isQuotaPreflight) violations(demands []isQuotaDemand) []string {
+	violations := []string{}
+	for _, demand := range demands {
+		limit := p.limit(demand.class)
+		if limit <= 0 || demand.amount <= 0 {
+			continue
+		}
+		key := isQuotaKey{class: demand.class, scope: demand.scope}
+		var planned int64
+		for _, amount := range p.planned[key] {
+			planned += amount
+		}
+		if used := p.usage[key]; used+planned > limit {
+			violations = append(violations, fmt.Sprintf("the plan creates %d %s in %s %s, which has %d of its quota of %d in use", planned, demand.class, isQuotaScopeName(demand.scope), demand.scope, used, limit))
+		}
+	}
+	sort.Strings(violations)
+	return violations
+}
+
+// checkISQuotas records the demands of a planned create, keyed by
+// plannedKey, and compares the totals of the plan with the quotas of the
+// account and the usage read from the API. The exceeded quotas fail the plan
+// in error mode, the default. In warn mode, the SDK cannot return warnings
+// from a plan, so that they are logged and then returned as warnings by the
+// create of the resource.
+func checkISQuotas(context context.Context, meta interface{}, resourceType string, diff *schema.ResourceDiff, plannedKey string, demands []isQuotaDemand) error {
+	mode := isQuotaPreflightMode(meta)
+	if mode == isQuotaPreflightOff || diff.Id() != "" || len(demands) == 0 {
+		return nil
+	}
+	p := isQuotaPreflightOf(meta)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.readLimits(context, meta); err != nil {
+		// The preflight never blocks a plan because the quotas cannot be
+		// read, such as when the API key cannot read the resource groups, and
+		// it checks no quota for the rest of the plan
+		log.Printf("[WARN] Skipping the quota preflight: %s", err)
+		p.limits = map[string]int64{}
+		return nil
+	}
+	checked := []isQuotaDemand{}
+	for _, demand := range demands {
+		if p.limit(demand.class) <= 0 {
+			continue
+		}
+		if err := p.readUsage(context, meta, demand.class); err != nil {
+			log.Printf("[WARN] Skipping the %s quota preflight: %s", demand.class, err)
+			continue
+		}
+		checked = append(checked, demand)
+	}
+	p.record(plannedKey, checked)
+	violations := p.violations(checked)
+	if len(violations) == 0 {
+		return nil
+	}
+	message := fmt.Sprintf("%s would exceed the VPC quotas of the account: %s. Set vpc_quota_preflight to warn or off in the provider configuration to not fail the plan", resourceType, strings.Join(violations, "; "))
+	if mode == isQuotaPreflightError {
+		return fmt.Errorf("[ERROR] %s", message)
+	}
+	log.Printf("[WARN] %s", message)
+	p.warnings[resourceType] = append(p.warnings[resourceType], message)
+	return nil
+}
+
+// isQuotaPreflightWarnings returns the exceeded quotas found in warn mode by
+// the plans of a resource type as warnings, once.
+func isQuotaPreflightWarnings(meta interface{}, resourceType string) diag.Diagnostics {
+	p := isQuotaPreflightOf(meta)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var diags diag.Diagnostics
+	for _, message := range p.warnings[resourceType] {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "VPC quota preflight",
+			Detail:   message,
+		})
+	}
+	delete(p.warnings, resourceType)
+	return diags
+}
+
+func isQuotaScopeName(scope string) string {
+	if scope == isQuotaScopeRegion {
+		return "the"
+	}
+	return "zone"
+}
+
+// readLimits reads the quotas of the account once, from the quota definition
+// of its default resource group.
+func (p *isQuotaPreflight) readLimits(context context.Context, meta interface{}) error {
+	if p.limits != nil {
+		return nil
+	}
+	client, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	groups, response, err := client.ListResourceGroupsWithContext(context, &resourcemanagerv2.ListResourceGroupsOptions{
+		AccountID: &userDetails.UserAccount,
+		Default:   core.BoolPtr(true),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the default resource group: %s\n%s", err, response)
+	}
+	if len(groups.Resources) == 0 || groups.Resources[0].QuotaID == nil {
+		return fmt.Errorf("[ERROR] The default resource group of account %s has no quota definition", userDetails.UserAccount)
+	}
+	definition, response, err := client.GetQuotaDefinitionWithContext(context, &resourcemanagerv2.GetQuotaDefinitionOptions{ID: groups.Resources[0].QuotaID})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting quota definition %s: %s\n%s", *groups.Resources[0].QuotaID, err, response)
+	}
+	p.limits = isQuotaLimits(definition)
+	return nil
+}
+
+// readUsage reads the usage of a quota class once per provider configuration.
+// The usage of all the classes of a collection is read together.
+func (p *isQuotaPreflight) readUsage(context context.Context, meta interface{}, class string) error {
+	if strings.HasPrefix(class, isQuotaVCPUClassPrefix) {
+		class = isQuotaInstances
+	}
+	if p.read[class] {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	switch class {
+	case isQuotaFloatingIPs:
+		err = p.readFloatingIPUsage(context, sess)
+	case isQuotaInstances:
+		err = p.readInstanceUsage(context, sess)
+	case isQuotaVolumes:
+		err = p.readVolumeUsage(context, sess)
+	case isQuotaBareMetalServers:
+		err = p.readBareMetalServerUsage(context, sess)
+	}
+	if err != nil {
+		return err
+	}
+	p.read[class] = true
+	return nil
+}
+
+func (p *isQuotaPreflight) readFloatingIPUsage(context context.Context, sess *vpcv1.VpcV1) error {
+	start := ""
+	for {
+		options := &vpcv1.ListFloatingIpsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		floatingIPs, response, err := sess.ListFloatingIpsWithContext(context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing floating IPs: %s\n%s", err, response)
+		}
+		for _, floatingIP := range floatingIPs.FloatingIps {
+			if floatingIP.Zone != nil && floatingIP.Zone.Name != nil {
+				p.usage[isQuotaKey{class: isQuotaFloatingIPs, scope: *floatingIP.Zone.Name}]++
+			}
+		}
+		start = flex.GetNext(floatingIPs.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func (p *isQuotaPreflight) readInstanceUsage(context context.Context, sess *vpcv1.VpcV1) error {
+	start := ""
+	for {
+		options := &vpcv1.ListInstancesOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		instances, response, err := sess.ListInstancesWithContext(context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing instances: %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			if instance.Zone != nil && instance.Zone.Name != nil {
+				p.usage[isQuotaKey{class: isQuotaInstances, scope: *instance.Zone.Name}]++
+			}
+			if instance.Vcpu == nil || instance.Vcpu.Count == nil || instance.Profile == nil {
+				continue
+			}
+			family := isQuotaUnknownFamilyName
+			if profile, err := p.instanceProfile(context, sess, flex.StringValue(instance.Profile.Name)); err == nil && profile.Family != nil {
+				family = *profile.Family
+			}
+			p.usage[isQuotaKey{class: isQuotaVCPUClassPrefix + family, scope: isQuotaScopeRegion}] += *instance.Vcpu.Count
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func (p *isQuotaPreflight) readVolumeUsage(context context.Context, sess *vpcv1.VpcV1) error {
+	volumes, response, err := sess.ListVolumesWithContext(context, &vpcv1.ListVolumesOptions{Limit: core.Int64Ptr(1)})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing volumes: %s\n%s", err, response)
+	}
+	if volumes.TotalCount != nil {
+		p.usage[isQuotaKey{class: isQuotaVolumes, scope: isQuotaScopeRegion}] = *volumes.TotalCount
+	}
+	return nil
+}
+
+func (p *isQuotaPreflight) readBareMetalServerUsage(context context.Context, sess *vpcv1.VpcV1) error {
+	start := ""
+	for {
+		options := &vpcv1.ListBareMetalServersOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		servers, response, err := sess.ListBareMetalServersWithContext(context, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing bare metal servers: %s\n%s", err, response)
+		}
+		for _, server := range servers.BareMetalServers {
+			if server.Zone != nil && server.Zone.Name != nil {
+				p.usage[isQuotaKey{class: isQuotaBareMetalServers, scope: *server.Zone.Name}]++
+			}
+		}
+		start = flex.GetNext(servers.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+// instanceProfile returns an instance profile of the region, the profiles
+// are listed once per provider configuration.
+func (p *isQuotaPreflight) instanceProfile(context context.Context, sess *vpcv1.VpcV1, name string) (*vpcv1.InstanceProfile, error) {
+	if p.profiles == nil {
+		profiles, response, err := sess.ListInstanceProfilesWithContext(context, &vpcv1.ListInstanceProfilesOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing instance profiles: %s\n%s", err, response)
+		}
+		p.profiles = map[string]*vpcv1.InstanceProfile{}
+		for i := range profiles.Profiles {
+			p.profiles[flex.StringValue(profiles.Profiles[i].Name)] = &profiles.Profiles[i]
+		}
+	}
+	profile, ok := p.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] Instance profile %s not found", name)
+	}
+	return profile, nil
+}
+
+// isQuotaInstanceProfileVCPU returns the number of vCPUs of an instance
+// profile, which is the default of the profiles with a range of vCPUs.
+func isQuotaInstanceProfileVCPU(profile *vpcv1.InstanceProfile) int64 {
+	vcpu, ok := profile.VcpuCount.(*vpcv1.InstanceProfileVcpu)
+	if !ok || vcpu == nil {
+		return 0
+	}
+	if vcpu.Value != nil {
+		return *vcpu.Value
+	}
+	if vcpu.Default != nil {
+		return *vcpu.Default
+	}
+	return 0
+}
+
+// resourceIBMISInstanceQuotaPreflight checks that the account has the quota
+// for the instance in its zone, its vCPUs and its volumes.
+func resourceIBMISInstanceQuotaPreflight(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || isQuotaPreflightMode(meta) == isQuotaPreflightOff {
+		return nil
+	}
+	demands := []isQuotaDemand{}
+	volumes := int64(1)
+	if diff.NewValueKnown("volume_prototypes") {
+		volumes += int64(len(diff.Get("volume_prototypes").([]interface{})))
+	}
+	demands = append(demands, isQuotaDemand{class: isQuotaVolumes, scope: isQuotaScopeRegion, amount: volumes})
+	if zone := diff.Get(isInstanceZone).(string); zone != "" && diff.NewValueKnown(isInstanceZone) {
+		demands = append(demands, isQuotaDemand{class: isQuotaInstances, scope: zone, amount: 1})
+	}
+	if profileName := diff.Get(isInstanceProfile).(string); profileName != "" && diff.NewValueKnown(isInstanceProfile) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		p := isQuotaPreflightOf(meta)
+		p.mu.Lock()
+		profile, err := p.instanceProfile(context, sess, profileName)
+		p.mu.Unlock()
+		if err != nil {
+			log.Printf("[WARN] Skipping the vcpu quota preflight: %s", err)
+		} else if vcpu := isQuotaInstanceProfileVCPU(profile); vcpu > 0 && profile.Family != nil {
+			demands = append(demands, isQuotaDemand{class: isQuotaVCPUClassPrefix + *profile.Family, scope: isQuotaScopeRegion, amount: vcpu})
+		}
+	}
+	return checkISQuotas(context, meta, "ibm_is_instance", diff, isQuotaPlannedKey(diff, "ibm_is_instance", isInstanceVPC), demands)
+}
+
+// resourceIBMISFloatingIPQuotaPreflight checks that the account has the
+// quota for the floating IP in its zone. The floating IPs that are bound to
+// a target are checked only when their zone is set.
+func resourceIBMISFloatingIPQuotaPreflight(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	zone := diff.Get(isFloatingIPZone).(string)
+	if zone == "" || !diff.NewValueKnown(isFloatingIPZone) {
+		return nil
+	}
+	return checkISQuotas(context, meta, "ibm_is_floating_ip", diff, isQuotaPlannedKey(diff, "ibm_is_floating_ip"), []isQuotaDemand{{class: isQuotaFloatingIPs, scope: zone, amount: 1}})
+}
+
+// resourceIBMISVolumeQuotaPreflight checks that the account has the quota
+// for the volume.
+func resourceIBMISVolumeQuotaPreflight(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return checkISQuotas(context, meta, "ibm_is_volume", diff, isQuotaPlannedKey(diff, "ibm_is_volume"), []isQuotaDemand{{class: isQuotaVolumes, scope: isQuotaScopeRegion, amount: 1}})
+}
+
+// resourceIBMISBareMetalServerQuotaPreflight checks that the account has the
+// quota for the bare metal server in its zone.
+func resourceIBMISBareMetalServerQuotaPreflight(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	zone := diff.Get(isBareMetalServerZone).(string)
+	if zone == "" || !diff.NewValueKnown(isBareMetalServerZone) {
+		return nil
+	}
+	return checkISQuotas(context, meta, "ibm_is_bare_metal_server", diff, isQuotaPlannedKey(diff, "ibm_is_bare_metal_server", isBareMetalServerVPC), []isQuotaDemand{{class: isQuotaBareMetalServers, scope: zone, amount: 1}})
+}
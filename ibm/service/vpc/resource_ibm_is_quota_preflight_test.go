@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestISQuotaLimits(t *testing.T) {
+	definition := &resourcemanagerv2.QuotaDefinition{
+		VsiLimit: core.Float64Ptr(100),
+		ResourceQuotas: []resourcemanagerv2.ResourceQuota{
+			{ResourceID: core.StringPtr("is.floating_ips"), Limit: core.Float64Ptr(40)},
+			{ResourceID: core.StringPtr("is.vcpu_balanced"), Limit: core.Float64Ptr(200)},
+			{ResourceID: core.StringPtr("is.volumes"), Limit: core.Float64Ptr(0)},
+			{ResourceID: core.StringPtr("cloudantnosqldb"), Limit: core.Float64Ptr(10)},
+			{ResourceID: core.StringPtr("is.vcpu")},
+		},
+	}
+	expected := map[string]int64{
+		isQuotaInstances:   100,
+		isQuotaFloatingIPs: 40,
+		"vcpu_balanced":    200,
+	}
+	if limits := isQuotaLimits(definition); !reflect.DeepEqual(limits, expected) {
+		t.Errorf("expected %v, got %v", expected, limits)
+	}
+}
+
+func TestISQuotaPreflightLimit(t *testing.T) {
+	p := newISQuotaPreflight()
+	p.limits = map[string]int64{isQuotaVCPU: 200, "vcpu_gpu": 16, isQuotaFloatingIPs: 40}
+	cases := []struct {
+		class    string
+		expected int64
+	}{
+		{isQuotaFloatingIPs, 40},
+		{"vcpu_gpu", 16},
+		{"vcpu_balanced", 200},
+		{isQuotaVolumes, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.class, func(t *testing.T) {
+			if limit := p.limit(c.class); limit != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, limit)
+			}
+		})
+	}
+}
+
+func TestISQuotaPreflightViolations(t *testing.T) {
+	floatingIP := func(zone string) []isQuotaDemand {
+		return []isQuotaDemand{{class: isQuotaFloatingIPs, scope: zone, amount: 1}}
+	}
+	cases := []struct {
+		name       string
+		limits     map[string]int64
+		usage      map[isQuotaKey]int64
+		record     func(p *isQuotaPreflight)
+		demands    []isQuotaDemand
+		violations int
+	}{
+		{
+			name:   "planned resources are each counted",
+			limits: map[string]int64{isQuotaFloatingIPs: 3},
+			usage:  map[isQuotaKey]int64{{class: isQuotaFloatingIPs, scope: "us-south-1"}: 1},
+			record: func(p *isQuotaPreflight) {
+				p.record("ibm_is_floating_ip/fip-0", floatingIP("us-south-1"))
+				p.record("ibm_is_floating_ip/fip-1", floatingIP("us-south-1"))
+				p.record("ibm_is_floating_ip/fip-2", floatingIP("us-south-1"))
+			},
+			demands:    floatingIP("us-south-1"),
+			violations: 1,
+		},
+		{
+			name:   "a planned resource checked twice is counted once",
+			limits: map[string]int64{isQuotaFloatingIPs: 3},
+			usage:  map[isQuotaKey]int64{{class: isQuotaFloatingIPs, scope: "us-south-1"}: 1},
+			record: func(p *isQuotaPreflight) {
+				p.record("ibm_is_floating_ip/fip-0", floatingIP("us-south-1"))
+				p.record("ibm_is_floating_ip/fip-0", floatingIP("us-south-1"))
+				p.record("ibm_is_floating_ip/fip-1", floatingIP("us-south-1"))
+			},
+			demands: floatingIP("us-south-1"),
+		},
+		{
+			name:   "planned resources without a name are each counted",
+			limits: map[string]int64{isQuotaFloatingIPs: 2},
+			record: func(p *isQuotaPreflight) {
+				p.record("", floatingIP("us-south-1"))
+				p.record("", floatingIP("us-south-1"))
+				p.record("", floatingIP("us-south-1"))
+			},
+			demands:    floatingIP("us-south-1"),
+			violations: 1,
+		},
+		{
+			name:   "zones are counted apart",
+			limits: map[string]int64{isQuotaFloatingIPs: 2},
+			usage:  map[isQuotaKey]int64{{class: isQuotaFloatingIPs, scope: "us-south-1"}: 1},
+			record: func(p *isQuotaPreflight) {
+				p.record("ibm_is_floating_ip/fip-0", floatingIP("us-south-1"))
+				p.record("ibm_is_floating_ip/fip-1", floatingIP("us-south-2"))
+				p.record("ibm_is_floating_ip/fip-2", floatingIP("us-south-2"))
+			},
+			demands: floatingIP("us-south-2"),
+		},
+		{
+			name:   "the vcpu quota applies to the families without a quota",
+			limits: map[string]int64{isQuotaVCPU: 16, "vcpu_gpu": 64},
+			record: func(p *isQuotaPreflight) {
+				p.record("ibm_is_instance/web/vpc-id", []isQuotaDemand{{class: "vcpu_balanced", scope: isQuotaScopeRegion, amount: 16}})
+				p.record("ibm_is_instance/gpu/vpc-id", []isQuotaDemand{{class: "vcpu_gpu", scope: isQuotaScopeRegion, amount: 32}})
+				p.record("ibm_is_instance/app/vpc-id", []isQuotaDemand{{class: "vcpu_balanced", scope: isQuotaScopeRegion, amount: 8}})
+			},
+			demands:    []isQuotaDemand{{class: "vcpu_balanced", scope: isQuotaScopeRegion, amount: 8}, {class: "vcpu_gpu", scope: isQuotaScopeRegion, amount: 32}},
+			violations: 1,
+		},
+		{
+			name:   "classes without a quota are not checked",
+			limits: map[string]int64{isQuotaFloatingIPs: 1},
+			usage:  map[isQuotaKey]int64{{class: isQuotaVolumes, scope: isQuotaScopeRegion}: 500},
+			record: func(p *isQuotaPreflight) {
+				p.record("ibm_is_volume/data", []isQuotaDemand{{class: isQuotaVolumes, scope: isQuotaScopeRegion, amount: 2}})
+			},
+			demands: []isQuotaDemand{{class: isQuotaVolumes, scope: isQuotaScopeRegion, amount: 2}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := newISQuotaPreflight()
+			p.limits = c.limits
+			for key, used := range c.usage {
+				p.usage[key] = used
+			}
+			c.record(p)
+			if violations := p.violations(c.demands); len(violations) != c.violations {
+				t.Errorf("expected %d violations, got %v", c.violations, violations)
+			}
+		})
+	}
+}
+
+func TestISQuotaPreflightViolationMessage(t *testing.T) {
+	p := newISQuotaPreflight()
+	p.limits = map[string]int64{isQuotaInstances: 10}
+	p.usage[isQuotaKey{class: isQuotaInstances, scope: "us-south-1"}] = 9
+	p.usage[isQuotaKey{class: isQuotaInstances, scope: "us-south-2"}] = 9
+	demands := []isQuotaDemand{{class: isQuotaInstances, scope: "us-south-1", amount: 1}}
+	p.record("ibm_is_instance/web-0/vpc-id", demands)
+	p.record("ibm_is_instance/web-1/vpc-id", demands)
+
+	violations := p.violations(demands)
+	expected := "the plan creates 2 instances in zone us-south-1, which has 9 of its quota of 10 in use"
+	if len(violations) != 1 || violations[0] != expected {
+		t.Errorf("expected %q, got %v", expected, violations)
+	}
+}
+
+// testISQuotaPreflightMeta is a client session whose provider state holds a
+// preflight.
+type testISQuotaPreflightMeta struct {
+	conns.ClientSession
+	p    *isQuotaPreflight
+	mode string
+}
+
+func (m *testISQuotaPreflightMeta) VPCQuotaPreflight() string {
+	return m.mode
+}
+
+func (m *testISQuotaPreflightMeta) ProviderState(name string, init func() interface{}) interface{} {
+	return m.p
+}
+
+func TestISQuotaPreflightWarnings(t *testing.T) {
+	p := newISQuotaPreflight()
+	p.warnings["ibm_is_volume"] = []string{"ibm_is_volume would exceed the VPC quotas of the account"}
+	p.warnings["ibm_is_instance"] = []string{"ibm_is_instance would exceed the VPC quotas of the account"}
+	meta := &testISQuotaPreflightMeta{p: p}
+
+	diags := isQuotaPreflightWarnings(meta, "ibm_is_volume")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "ibm_is_volume") {
+		t.Errorf("expected the warning of the volume, got %v", diags)
+	}
+	if diags := isQuotaPreflightWarnings(meta, "ibm_is_volume"); len(diags) != 0 {
+		t.Errorf("expected the warning to be returned once, got %v", diags)
+	}
+	if len(p.warnings["ibm_is_instance"]) != 1 {
+		t.Errorf("expected the warning of the instance to be kept, got %v", p.warnings)
+	}
+}
+
+func TestISQuotaPlannedKey(t *testing.T) {
+	resource := ResourceIBMISInstance()
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{"named", map[string]interface{}{isInstanceName: "web-0", isInstanceVPC: "vpc-id"}, "ibm_is_instance/web-0/vpc-id"},
+		{"VPC not known", map[string]interface{}{isInstanceName: "web-0"}, "ibm_is_instance/web-0/"},
+		{"unnamed", map[string]interface{}{isInstanceVPC: "vpc-id"}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var key string
+			resource.CustomizeDiff = func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				key = isQuotaPlannedKey(diff, "ibm_is_instance", isInstanceVPC)
+				return nil
+			}
+			config := terraform.NewResourceConfigRaw(c.raw)
+			if _, err := resource.SimpleDiff(context.Background(), nil, config, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, key)
+			}
+		})
+	}
+}
+
+func TestCheckISQuotasMode(t *testing.T) {
+	cases := []struct {
+		mode     string
+		err      bool
+		warnings int
+	}{
+		{isQuotaPreflightError, true, 0},
+		{isQuotaPreflightWarn, false, 1},
+		{isQuotaPreflightOff, false, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.mode, func(t *testing.T) {
+			p := newISQuotaPreflight()
+			p.limits = map[string]int64{isQuotaVolumes: 10}
+			p.usage[isQuotaKey{class: isQuotaVolumes, scope: isQuotaScopeRegion}] = 10
+			p.read[isQuotaVolumes] = true
+			meta := &testISQuotaPreflightMeta{p: p, mode: c.mode}
+
+			resource := ResourceIBMISVolume()
+			var err error
+			resource.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				err = resourceIBMISVolumeQuotaPreflight(ctx, diff, meta)
+				return nil
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{isVolumeName: "data"})
+			if _, diffErr := resource.SimpleDiff(context.Background(), nil, config, nil); diffErr != nil {
+				t.Fatalf("unexpected error: %v", diffErr)
+			}
+			if (err != nil) != c.err {
+				t.Errorf("expected an error %t, got %v", c.err, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "vpc_quota_preflight") {
+				t.Errorf("expected the error to name vpc_quota_preflight, got %v", err)
+			}
+			if warnings := isQuotaPreflightWarnings(meta, "ibm_is_volume"); len(warnings) != c.warnings {
+				t.Errorf("expected %d warnings, got %v", c.warnings, warnings)
+			}
+		})
+	}
+}
@@ -11,10 +11,12 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -25,10 +27,16 @@ const (
 	isSecurityGroupName          = "name"
 	isSecurityGroupVPC           = "vpc"
 	isSecurityGroupRules         = "rules"
+	isSecurityGroupRule          = "rule"
 	isSecurityGroupResourceGroup = "resource_group"
 	isSecurityGroupTags          = "tags"
 	isSecurityGroupAccessTags    = "access_tags"
 	isSecurityGroupCRN           = "crn"
+
+	// isSecurityGroupInlineRulesTag is the user tag of the security groups
+	// whose rules are managed by rule blocks. It is read at plan time by
+	// ibm_is_security_group_rule, and it is not reported in tags.
+	isSecurityGroupInlineRulesTag = "terraform:ibm_is_security_group:rule"
 )
 
 func ResourceIBMISSecurityGroup() *schema.Resource {
@@ -51,6 +59,10 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSecurityGroupInlineRulesTagCustomizeDiff(diff, v)
+				}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -107,6 +119,17 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				},
 			},
 
+			isSecurityGroupRule: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "The rules of the security group. When set, rules that are not listed are removed from the security group",
+				Elem: &schema.Resource{
+					Schema: makeIBMISSecurityGroupInlineRuleSchema(),
+				},
+			},
+
 			isSecurityGroupResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -204,6 +227,15 @@ func resourceIBMISSecurityGroupCreate(context context.Context, d *schema.Resourc
 		name = nm.(string)
 		createSecurityGroupOptions.Name = &name
 	}
+	manageRules := !d.GetRawConfig().GetAttr(isSecurityGroupRule).IsNull()
+	rules, err := securityGroupInlineRulesFromConfig(d.GetRawConfig())
+	if manageRules {
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("securityGroupInlineRulesFromConfig failed: %s", err.Error()), "ibm_is_security_group", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	sg, _, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecurityGroupWithContext failed: %s", err.Error()), "ibm_is_security_group", "create")
@@ -212,9 +244,10 @@ func resourceIBMISSecurityGroupCreate(context context.Context, d *schema.Resourc
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" || manageRules {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isUserTagType)
+		newList = securityGroupUserTags(newList, manageRules)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *sg.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
@@ -222,12 +255,20 @@ func resourceIBMISSecurityGroupCreate(context context.Context, d *schema.Resourc
 	}
 	if _, ok := d.GetOk(isSecurityGroupAccessTags); ok {
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *sg.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	if manageRules {
+		err = reconcileSecurityGroupInlineRules(context, sess, d.Id(), rules)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("reconcileSecurityGroupInlineRules failed: %s", err.Error()), "ibm_is_security_group", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMISSecurityGroupRead(context, d, meta)
 }
 
@@ -257,6 +298,8 @@ func resourceIBMISSecurityGroupRead(context context.Context, d *schema.ResourceD
 	if err != nil {
 		log.Printf(
 			"Error getting Security Group tags : %s\n%s", d.Id(), err)
+	} else {
+		tags.Remove(isSecurityGroupInlineRulesTag)
 	}
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *securityGroup.CRN, "", isAccessTagType)
 	if err != nil {
@@ -409,6 +452,16 @@ func resourceIBMISSecurityGroupRead(context context.Context, d *schema.ResourceD
 		err = fmt.Errorf("Error setting rules: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-rules").GetDiag()
 	}
+	inlineRules := make([]map[string]interface{}, 0, len(securityGroup.Rules))
+	for _, rule := range securityGroup.Rules {
+		if _, r := flattenSecurityGroupInlineRule(rule); r != nil {
+			inlineRules = append(inlineRules, r)
+		}
+	}
+	if err = d.Set(isSecurityGroupRule, inlineRules); err != nil {
+		err = fmt.Errorf("Error setting rule: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-rule").GetDiag()
+	}
 
 	d.SetId(*securityGroup.ID)
 	if securityGroup.ResourceGroup != nil {
@@ -454,9 +507,17 @@ func resourceIBMISSecurityGroupUpdate(context context.Context, d *schema.Resourc
 	name := ""
 	hasChanged := false
 
+	manageRules := !d.GetRawConfig().GetAttr(isSecurityGroupRule).IsNull()
+	err = updateSecurityGroupInlineRulesTag(context, meta, d.Get(isSecurityGroupCRN).(string), manageRules)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("updateSecurityGroupInlineRulesTag failed: %s", err.Error()), "ibm_is_security_group", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	if d.HasChange(isSecurityGroupTags) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isUserTagType)
+		oldList, newList = securityGroupUserTags(oldList, manageRules), securityGroupUserTags(newList, manageRules)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error Updating Security Group tags: %s\n%s", d.Id(), err)
@@ -464,12 +525,26 @@ func resourceIBMISSecurityGroupUpdate(context context.Context, d *schema.Resourc
 	}
 	if d.HasChange(isSecurityGroupAccessTags) {
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	if d.HasChange(isSecurityGroupRule) && manageRules {
+		rules, err := securityGroupInlineRulesFromConfig(d.GetRawConfig())
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("securityGroupInlineRulesFromConfig failed: %s", err.Error()), "ibm_is_security_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		err = reconcileSecurityGroupInlineRules(context, sess, id, rules)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("reconcileSecurityGroupInlineRules failed: %s", err.Error()), "ibm_is_security_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
@@ -562,13 +637,13 @@ func resourceIBMISSecurityGroupDelete(context context.Context, d *schema.Resourc
 							log.Printf("[DEBUG] Security group target(%s) binding is already deleted", *securityGroupTargetReference.ID)
 						} else if response.StatusCode == 409 {
 							log.Printf("[DEBUG] Security group target(%s) binding is in deleting status, waiting till target is removed", *securityGroupTargetReference.ID)
-							_, err = isWaitForTargetDeleted(sess, id, *securityGroupTargetReference.ID, securityGroupTargetReferenceIntf, d.Timeout(schema.TimeoutDelete))
+							_, err = isWaitForTargetDeleted(context, sess, id, *securityGroupTargetReference.ID, securityGroupTargetReferenceIntf, d.Timeout(schema.TimeoutDelete))
 							if err != nil {
 								tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForTargetDeleted failed: %s", err.Error()), "ibm_is_security_group", "delete")
 								log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 								return tfErr.GetDiag()
 							}
-							_, err := isWaitForSecurityGroupTargetDeleteRetry(sess, deleteSecurityGroupTargetBindingOptions, d.Timeout(schema.TimeoutDelete))
+							_, err := isWaitForSecurityGroupTargetDeleteRetry(context, sess, deleteSecurityGroupTargetBindingOptions, d.Timeout(schema.TimeoutDelete))
 							if err != nil {
 								tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSecurityGroupTargetDeleteRetry failed: %s", err.Error()), "ibm_is_security_group", "delete")
 								log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -597,13 +672,13 @@ func resourceIBMISSecurityGroupDelete(context context.Context, d *schema.Resourc
 				log.Printf("[DEBUG] Security group(%s) target bindings are already deleted", id)
 			} else if response.StatusCode == 409 {
 				log.Printf("[DEBUG] Security group(%s) has target bindings is in deleting, will wait till target is removed", id)
-				_, err = isWaitForSgCleanup(sess, id, allrecs, d.Timeout(schema.TimeoutDelete))
+				_, err = isWaitForSgCleanup(context, sess, id, allrecs, d.Timeout(schema.TimeoutDelete))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSgCleanup failed: %s", err.Error()), "ibm_is_security_group", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err := isWaitForSecurityGroupDeleteRetry(sess, deleteSecurityGroupOptions, d.Timeout(schema.TimeoutDelete))
+				_, err := isWaitForSecurityGroupDeleteRetry(context, sess, deleteSecurityGroupOptions, d.Timeout(schema.TimeoutDelete))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSecurityGroupDeleteRetry failed: %s", err.Error()), "ibm_is_security_group", "delete")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -698,7 +773,552 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	}
 }
 
-func isWaitForTargetDeleted(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
+func makeIBMISSecurityGroupInlineRuleSchema() map[string]*schema.Schema {
+	ports := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				isSecurityGroupRulePortMin: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+				},
+				isSecurityGroupRulePortMax: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      65535,
+					ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+				},
+			},
+		}
+	}
+	return map[string]*schema.Schema{
+
+		isSecurityGroupRuleDirection: {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+		},
+
+		isSecurityGroupRuleIPVersion: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      isSecurityGroupRuleIPVersionDefault,
+			Description:  "IP version: ipv4",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+		},
+
+		isSecurityGroupRuleRemote: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0.0.0.0/0",
+			Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+		},
+
+		isSecurityGroupRuleLocal: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0.0.0.0/0",
+			Description: "Security group local ip: an IP address, a CIDR block",
+		},
+
+		isSecurityGroupRuleProtocolICMP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			ConfigMode:  schema.SchemaConfigModeAttr,
+			Description: "protocol=icmp",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isSecurityGroupRuleType: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+					},
+					isSecurityGroupRuleCode: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+					},
+				},
+			},
+		},
+
+		isSecurityGroupRuleProtocolTCP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			ConfigMode:  schema.SchemaConfigModeAttr,
+			Description: "protocol=tcp",
+			Elem:        ports(),
+		},
+
+		isSecurityGroupRuleProtocolUDP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			ConfigMode:  schema.SchemaConfigModeAttr,
+			Description: "protocol=udp",
+			Elem:        ports(),
+		},
+	}
+}
+
+// securityGroupInlineRule is a rule block of ibm_is_security_group. Like
+// parsedIBMISSecurityGroupRuleDictionary, unused int64 fields are set to -1.
+type securityGroupInlineRule struct {
+	direction string
+	ipVersion string
+	remote    string
+	local     string
+	protocol  string
+	icmpType  int64
+	icmpCode  int64
+	portMin   int64
+	portMax   int64
+}
+
+// parseSecurityGroupInlineRule parses a rule block flattened from a rule of the
+// API, which only holds the ICMP type and code that are set.
+func parseSecurityGroupInlineRule(rulex map[string]interface{}) securityGroupInlineRule {
+	rule := securityGroupInlineRule{
+		direction: rulex[isSecurityGroupRuleDirection].(string),
+		ipVersion: rulex[isSecurityGroupRuleIPVersion].(string),
+		remote:    rulex[isSecurityGroupRuleRemote].(string),
+		local:     rulex[isSecurityGroupRuleLocal].(string),
+		protocol:  "all",
+		icmpType:  -1,
+		icmpCode:  -1,
+		portMin:   -1,
+		portMax:   -1,
+	}
+	if icmp := rulex[isSecurityGroupRuleProtocolICMP].([]interface{}); len(icmp) > 0 {
+		rule.protocol = isSecurityGroupRuleProtocolICMP
+		if icmp[0] != nil {
+			icmpval := icmp[0].(map[string]interface{})
+			if val, ok := icmpval[isSecurityGroupRuleType]; ok {
+				rule.icmpType = int64(val.(int))
+			}
+			if val, ok := icmpval[isSecurityGroupRuleCode]; ok {
+				rule.icmpCode = int64(val.(int))
+			}
+		}
+	}
+	for _, protocol := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		if ports := rulex[protocol].([]interface{}); len(ports) > 0 {
+			rule.protocol = protocol
+			rule.portMin, rule.portMax = 1, 65535
+			if ports[0] != nil {
+				portval := ports[0].(map[string]interface{})
+				rule.portMin = int64(portval[isSecurityGroupRulePortMin].(int))
+				rule.portMax = int64(portval[isSecurityGroupRulePortMax].(int))
+			}
+		}
+	}
+	return rule
+}
+
+// securityGroupInlineRulesFromConfig parses and validates the rule blocks of
+// the configuration. They are read from the raw configuration rather than
+// from the state, where an ICMP type or code of 0 cannot be told apart from
+// an unset one, so the defaults of the rule block schema are applied here.
+func securityGroupInlineRulesFromConfig(config cty.Value) ([]securityGroupInlineRule, error) {
+	rules := []securityGroupInlineRule{}
+	rulesVal := config.GetAttr(isSecurityGroupRule)
+	if rulesVal.IsNull() {
+		return rules, nil
+	}
+	for it := rulesVal.ElementIterator(); it.Next(); {
+		_, rulex := it.Element()
+		rule := securityGroupInlineRule{
+			direction: rulex.GetAttr(isSecurityGroupRuleDirection).AsString(),
+			ipVersion: ctyStringOrDefault(rulex.GetAttr(isSecurityGroupRuleIPVersion), isSecurityGroupRuleIPVersionDefault),
+			remote:    ctyStringOrDefault(rulex.GetAttr(isSecurityGroupRuleRemote), "0.0.0.0/0"),
+			local:     ctyStringOrDefault(rulex.GetAttr(isSecurityGroupRuleLocal), "0.0.0.0/0"),
+			protocol:  "all",
+			icmpType:  -1,
+			icmpCode:  -1,
+			portMin:   -1,
+			portMax:   -1,
+		}
+		protocols := 0
+		if icmp, ok := ctyFirstElement(rulex.GetAttr(isSecurityGroupRuleProtocolICMP)); ok {
+			protocols++
+			rule.protocol = isSecurityGroupRuleProtocolICMP
+			if !icmp.IsNull() {
+				rule.icmpType = ctyIntOrDefault(icmp.GetAttr(isSecurityGroupRuleType), -1)
+				rule.icmpCode = ctyIntOrDefault(icmp.GetAttr(isSecurityGroupRuleCode), -1)
+			}
+		}
+		for _, protocol := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+			if ports, ok := ctyFirstElement(rulex.GetAttr(protocol)); ok {
+				protocols++
+				rule.protocol = protocol
+				rule.portMin, rule.portMax = 1, 65535
+				if !ports.IsNull() {
+					rule.portMin = ctyIntOrDefault(ports.GetAttr(isSecurityGroupRulePortMin), 1)
+					rule.portMax = ctyIntOrDefault(ports.GetAttr(isSecurityGroupRulePortMax), 65535)
+				}
+			}
+		}
+
+		if protocols > 1 {
+			return nil, fmt.Errorf("Only one of icmp|tcp|udp can be defined per rule")
+		}
+		if rule.icmpCode != -1 && rule.icmpType == -1 {
+			return nil, fmt.Errorf("icmp code requires icmp type")
+		}
+		if rule.portMin > rule.portMax {
+			return nil, fmt.Errorf("port_min (%d) must not be greater than port_max (%d)", rule.portMin, rule.portMax)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func ctyStringOrDefault(v cty.Value, def string) string {
+	if v.IsNull() {
+		return def
+	}
+	return v.AsString()
+}
+
+func ctyIntOrDefault(v cty.Value, def int64) int64 {
+	if v.IsNull() {
+		return def
+	}
+	i, _ := v.AsBigFloat().Int64()
+	return i
+}
+
+// ctyFirstElement returns the first element of a list of at most one block.
+func ctyFirstElement(v cty.Value) (cty.Value, bool) {
+	if v.IsNull() || v.LengthInt() == 0 {
+		return cty.NilVal, false
+	}
+	return v.Index(cty.NumberIntVal(0)), true
+}
+
+// key identifies the rule among the rules of a security group.
+func (rule securityGroupInlineRule) key() string {
+	return fmt.Sprintf("%+v", rule)
+}
+
+func (rule securityGroupInlineRule) prototype() *vpcv1.SecurityGroupRulePrototype {
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &rule.direction,
+		IPVersion: &rule.ipVersion,
+		Protocol:  &rule.protocol,
+	}
+	address, cidr, id, _ := inferRemoteSecurityGroup(rule.remote)
+	remote := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if address != "" {
+		remote.Address = &address
+	} else if cidr != "" {
+		remote.CIDRBlock = &cidr
+	} else {
+		remote.ID = &id
+	}
+	prototype.Remote = remote
+	localAddress, localCIDR, _ := inferLocalSecurityGroup(rule.local)
+	if localAddress != "" {
+		prototype.Local = &vpcv1.SecurityGroupRuleLocalPrototype{Address: &localAddress}
+	} else if localCIDR != "" {
+		prototype.Local = &vpcv1.SecurityGroupRuleLocalPrototype{CIDRBlock: &localCIDR}
+	}
+	if rule.icmpType != -1 {
+		prototype.Type = &rule.icmpType
+	}
+	if rule.icmpCode != -1 {
+		prototype.Code = &rule.icmpCode
+	}
+	if rule.portMin != -1 {
+		prototype.PortMin = &rule.portMin
+		prototype.PortMax = &rule.portMax
+	}
+	return prototype
+}
+
+func (rule securityGroupInlineRule) patch() (map[string]interface{}, error) {
+	patchModel := &vpcv1.SecurityGroupRulePatch{
+		Direction: &rule.direction,
+		IPVersion: &rule.ipVersion,
+	}
+	address, cidr, id, _ := inferRemoteSecurityGroup(rule.remote)
+	remote := &vpcv1.SecurityGroupRuleRemotePatch{}
+	if address != "" {
+		remote.Address = &address
+	} else if cidr != "" {
+		remote.CIDRBlock = &cidr
+	} else {
+		remote.ID = &id
+	}
+	patchModel.Remote = remote
+	localAddress, localCIDR, _ := inferLocalSecurityGroup(rule.local)
+	if localAddress != "" {
+		patchModel.Local = &vpcv1.SecurityGroupRuleLocalPatch{Address: &localAddress}
+	} else if localCIDR != "" {
+		patchModel.Local = &vpcv1.SecurityGroupRuleLocalPatch{CIDRBlock: &localCIDR}
+	}
+	if rule.portMin != -1 {
+		patchModel.PortMin = &rule.portMin
+		patchModel.PortMax = &rule.portMax
+	}
+	if rule.icmpType != -1 {
+		patchModel.Type = &rule.icmpType
+	}
+	if rule.icmpCode != -1 {
+		patchModel.Code = &rule.icmpCode
+	}
+	patch, err := patchModel.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupRulePatch: %s", err)
+	}
+	if rule.protocol == isSecurityGroupRuleProtocolICMP {
+		if rule.icmpType == -1 {
+			patch["type"] = nil
+		}
+		if rule.icmpCode == -1 {
+			patch["code"] = nil
+		}
+	}
+	return patch, nil
+}
+
+// flattenSecurityGroupInlineRule returns the ID of a rule of a security group
+// and its rule block.
+func flattenSecurityGroupInlineRule(rule vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}) {
+	r := make(map[string]interface{})
+	var id string
+	var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+	var localIntf vpcv1.SecurityGroupRuleLocalIntf
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id = *rule.ID
+		r[isSecurityGroupRuleDirection] = *rule.Direction
+		r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+		icmp := map[string]interface{}{}
+		if rule.Type != nil {
+			icmp[isSecurityGroupRuleType] = int(*rule.Type)
+		}
+		if rule.Code != nil {
+			icmp[isSecurityGroupRuleCode] = int(*rule.Code)
+		}
+		r[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+		remoteIntf, localIntf = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id = *rule.ID
+		r[isSecurityGroupRuleDirection] = *rule.Direction
+		r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+		remoteIntf, localIntf = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id = *rule.ID
+		r[isSecurityGroupRuleDirection] = *rule.Direction
+		r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+		ports := map[string]interface{}{}
+		if rule.PortMin != nil {
+			ports[isSecurityGroupRulePortMin] = int(*rule.PortMin)
+		}
+		if rule.PortMax != nil {
+			ports[isSecurityGroupRulePortMax] = int(*rule.PortMax)
+		}
+		r[*rule.Protocol] = []interface{}{ports}
+		remoteIntf, localIntf = rule.Remote, rule.Local
+	default:
+		return "", nil
+	}
+	for _, protocol := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		if _, ok := r[protocol]; !ok {
+			r[protocol] = []interface{}{}
+		}
+	}
+	r[isSecurityGroupRuleRemote] = ""
+	if remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			r[isSecurityGroupRuleRemote] = *remote.ID
+		} else if remote.Address != nil {
+			r[isSecurityGroupRuleRemote] = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+		}
+	}
+	r[isSecurityGroupRuleLocal] = ""
+	if local, ok := localIntf.(*vpcv1.SecurityGroupRuleLocal); ok && local != nil {
+		if local.Address != nil {
+			r[isSecurityGroupRuleLocal] = *local.Address
+		} else if local.CIDRBlock != nil {
+			r[isSecurityGroupRuleLocal] = *local.CIDRBlock
+		}
+	}
+	return id, r
+}
+
+// securityGroupUserTags returns the user tags of a security group, with
+// isSecurityGroupInlineRulesTag when its rules are managed by rule blocks so
+// that the tag is kept when the tags are updated.
+func securityGroupUserTags(tags interface{}, manageRules bool) *schema.Set {
+	userTags := schema.NewSet(flex.ResourceIBMVPCHash, nil)
+	if tags != nil {
+		userTags = schema.CopySet(tags.(*schema.Set))
+	}
+	if manageRules {
+		userTags.Add(isSecurityGroupInlineRulesTag)
+	}
+	return userTags
+}
+
+// updateSecurityGroupInlineRulesTag attaches isSecurityGroupInlineRulesTag to
+// a security group whose rules are managed by rule blocks, and detaches it
+// from one whose rules are not.
+func updateSecurityGroupInlineRulesTag(context context.Context, meta interface{}, crn string, manageRules bool) error {
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, crn, "", isUserTagType)
+	if err != nil {
+		return err
+	}
+	if tags.Contains(isSecurityGroupInlineRulesTag) == manageRules {
+		return nil
+	}
+	newTags := schema.CopySet(tags)
+	if manageRules {
+		newTags.Add(isSecurityGroupInlineRulesTag)
+	} else {
+		newTags.Remove(isSecurityGroupInlineRulesTag)
+	}
+	return flex.UpdateGlobalTagsUsingCRN(context, tags, newTags, meta, crn, "", isUserTagType)
+}
+
+// resourceIBMISSecurityGroupInlineRulesTagCustomizeDiff plans an update of a
+// security group whose rule blocks were added or removed while its rules did
+// not change, so that isSecurityGroupInlineRulesTag is updated.
+func resourceIBMISSecurityGroupInlineRulesTagCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	manageRules := !diff.GetRawConfig().GetAttr(isSecurityGroupRule).IsNull()
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, diff.Get(isSecurityGroupCRN).(string), "", isUserTagType)
+	if err != nil {
+		log.Printf("[WARN] Error getting Security Group (%s) tags: %s", diff.Id(), err)
+		return nil
+	}
+	if tags.Contains(isSecurityGroupInlineRulesTag) != manageRules && !diff.HasChange(isSecurityGroupRule) {
+		return diff.SetNewComputed(isSecurityGroupRule)
+	}
+	return nil
+}
+
+// securityGroupInlineRulesConflict returns an error naming both resources when
+// the user tags of a security group show that its rules are managed by the
+// rule blocks of ibm_is_security_group, which delete the rules of
+// ibm_is_security_group_rule.
+func securityGroupInlineRulesConflict(secgrpID string, tags *schema.Set) error {
+	if tags.Contains(isSecurityGroupInlineRulesTag) {
+		return fmt.Errorf("ibm_is_security_group_rule conflicts with the rule blocks of the ibm_is_security_group of security group %s: the rule blocks are authoritative and delete the rules that they do not describe. Add the rule to the rule blocks of ibm_is_security_group, or remove the rule blocks", secgrpID)
+	}
+	return nil
+}
+
+// reconcileSecurityGroupInlineRules makes the rules of a security group match
+// its rule blocks. Rules that changed are patched, missing rules are created,
+// and the other rules, including those added outside of Terraform, are
+// deleted.
+func reconcileSecurityGroupInlineRules(context context.Context, sess *vpcv1.VpcV1, secgrpID string, rules []securityGroupInlineRule) error {
+	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &secgrpID,
+	}
+	securityGroup, response, err := sess.GetSecurityGroupWithContext(context, getSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Security Group (%s): %s\n%s", secgrpID, err, response)
+	}
+
+	type existingRule struct {
+		id   string
+		rule securityGroupInlineRule
+	}
+	existing := map[string]existingRule{}
+	unmanaged := []existingRule{}
+	for _, rule := range securityGroup.Rules {
+		id, r := flattenSecurityGroupInlineRule(rule)
+		if r == nil {
+			continue
+		}
+		parsed := parseSecurityGroupInlineRule(r)
+		if _, ok := existing[parsed.key()]; ok {
+			unmanaged = append(unmanaged, existingRule{id, parsed})
+			continue
+		}
+		existing[parsed.key()] = existingRule{id, parsed}
+	}
+
+	missing := []securityGroupInlineRule{}
+	for _, parsed := range rules {
+		if _, ok := existing[parsed.key()]; ok {
+			delete(existing, parsed.key())
+			continue
+		}
+		missing = append(missing, parsed)
+	}
+	for _, rule := range existing {
+		unmanaged = append(unmanaged, rule)
+	}
+
+	for _, rule := range missing {
+		// patch an unmanaged rule of the same protocol, which cannot be changed
+		patched := false
+		for i, old := range unmanaged {
+			if old.rule.protocol != rule.protocol {
+				continue
+			}
+			patch, err := rule.patch()
+			if err != nil {
+				return err
+			}
+			updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+				SecurityGroupID:        &secgrpID,
+				ID:                     &old.id,
+				SecurityGroupRulePatch: patch,
+			}
+			_, response, err := sess.UpdateSecurityGroupRuleWithContext(context, updateSecurityGroupRuleOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error updating Security Group (%s) rule (%s): %s\n%s", secgrpID, old.id, err, response)
+			}
+			log.Printf("[DEBUG] Updated Security Group (%s) rule (%s)", secgrpID, old.id)
+			unmanaged = append(unmanaged[:i], unmanaged[i+1:]...)
+			patched = true
+			break
+		}
+		if patched {
+			continue
+		}
+		createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &secgrpID,
+			SecurityGroupRulePrototype: rule.prototype(),
+		}
+		_, response, err := sess.CreateSecurityGroupRuleWithContext(context, createSecurityGroupRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating Security Group (%s) rule: %s\n%s", secgrpID, err, response)
+		}
+	}
+
+	for _, rule := range unmanaged {
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &secgrpID,
+			ID:              &rule.id,
+		}
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting Security Group (%s) rule (%s): %s\n%s", secgrpID, rule.id, err, response)
+		}
+		log.Printf("[DEBUG] Deleted Security Group (%s) rule (%s) that is not in its rule blocks", secgrpID, rule.id)
+	}
+	return nil
+}
+
+func isWaitForTargetDeleted(ctx context.Context, client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targetId)
 
 	stateConf := &resource.StateChangeConf{
@@ -710,7 +1330,7 @@ func isWaitForTargetDeleted(client *vpcv1.VpcV1, sgId, targetId string, target v
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf) resource.StateRefreshFunc {
@@ -729,7 +1349,7 @@ func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv
 		return sgTarget, "deleting", nil
 	}
 }
-func isWaitForSgCleanup(client *vpcv1.VpcV1, sgId string, targets []vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
+func isWaitForSgCleanup(ctx context.Context, client *vpcv1.VpcV1, sgId string, targets []vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targets)
 
 	stateConf := &resource.StateChangeConf{
@@ -741,7 +1361,7 @@ func isWaitForSgCleanup(client *vpcv1.VpcV1, sgId string, targets []vpcv1.Securi
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGroupTargetReferenceIntf) resource.StateRefreshFunc {
@@ -770,7 +1390,7 @@ func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGr
 	}
 }
 
-func isWaitForSecurityGroupDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecurityGroupOptions *vpcv1.DeleteSecurityGroupOptions, timeout time.Duration) (interface{}, error) {
+func isWaitForSecurityGroupDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, deleteSecurityGroupOptions *vpcv1.DeleteSecurityGroupOptions, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying security group (%s) delete", *deleteSecurityGroupOptions.ID)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"security-group-in-use"},
@@ -792,10 +1412,10 @@ func isWaitForSecurityGroupDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecurityGro
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForSecurityGroupTargetDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecurityGroupTargetBindingOptions *vpcv1.DeleteSecurityGroupTargetBindingOptions, timeout time.Duration) (interface{}, error) {
+func isWaitForSecurityGroupTargetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, deleteSecurityGroupTargetBindingOptions *vpcv1.DeleteSecurityGroupTargetBindingOptions, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying security group target (%s) delete", *deleteSecurityGroupTargetBindingOptions.ID)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"security-group-target-in-use"},
@@ -817,5 +1437,5 @@ func isWaitForSecurityGroupTargetDeleteRetry(vpcClient *vpcv1.VpcV1, deleteSecur
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
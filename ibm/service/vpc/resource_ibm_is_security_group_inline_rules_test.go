@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// testSecurityGroupRulesConfig returns the raw configuration of a security
+// group with the given rule blocks, in which the unset attributes are null.
+func testSecurityGroupRulesConfig(rules ...map[string]cty.Value) cty.Value {
+	configType := ResourceIBMISSecurityGroup().CoreConfigSchema().ImpliedType()
+	ruleType := configType.AttributeType(isSecurityGroupRule).ElementType()
+	ruleVals := []cty.Value{}
+	for _, rule := range rules {
+		attrs := map[string]cty.Value{}
+		for name, attrType := range ruleType.AttributeTypes() {
+			attrs[name] = cty.NullVal(attrType)
+			if v, ok := rule[name]; ok {
+				attrs[name] = v
+			}
+		}
+		ruleVals = append(ruleVals, cty.ObjectVal(attrs))
+	}
+	attrs := map[string]cty.Value{}
+	for name, attrType := range configType.AttributeTypes() {
+		attrs[name] = cty.NullVal(attrType)
+	}
+	attrs[isSecurityGroupRule] = cty.SetValEmpty(configType.AttributeType(isSecurityGroupRule).ElementType())
+	if len(ruleVals) > 0 {
+		attrs[isSecurityGroupRule] = cty.SetVal(ruleVals)
+	}
+	return cty.ObjectVal(attrs)
+}
+
+func testSecurityGroupICMP(icmpType, icmpCode cty.Value) cty.Value {
+	return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		isSecurityGroupRuleType: icmpType,
+		isSecurityGroupRuleCode: icmpCode,
+	})})
+}
+
+func testSecurityGroupPorts(portMin, portMax cty.Value) cty.Value {
+	return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		isSecurityGroupRulePortMin: portMin,
+		isSecurityGroupRulePortMax: portMax,
+	})})
+}
+
+func TestSecurityGroupInlineRulesFromConfig(t *testing.T) {
+	null := cty.NullVal(cty.Number)
+	cases := []struct {
+		name     string
+		rule     map[string]cty.Value
+		expected securityGroupInlineRule
+	}{
+		{
+			name: "all protocols with defaults",
+			rule: map[string]cty.Value{
+				isSecurityGroupRuleDirection: cty.StringVal("outbound"),
+			},
+			expected: securityGroupInlineRule{direction: "outbound", ipVersion: "ipv4", remote: "0.0.0.0/0", local: "0.0.0.0/0", protocol: "all", icmpType: -1, icmpCode: -1, portMin: -1, portMax: -1},
+		},
+		{
+			name: "icmp type and code 0",
+			rule: map[string]cty.Value{
+				isSecurityGroupRuleDirection:    cty.StringVal("inbound"),
+				isSecurityGroupRuleProtocolICMP: testSecurityGroupICMP(cty.NumberIntVal(0), cty.NumberIntVal(0)),
+			},
+			expected: securityGroupInlineRule{direction: "inbound", ipVersion: "ipv4", remote: "0.0.0.0/0", local: "0.0.0.0/0", protocol: "icmp", icmpType: 0, icmpCode: 0, portMin: -1, portMax: -1},
+		},
+		{
+			name: "icmp without type and code",
+			rule: map[string]cty.Value{
+				isSecurityGroupRuleDirection:    cty.StringVal("inbound"),
+				isSecurityGroupRuleProtocolICMP: testSecurityGroupICMP(null, null),
+			},
+			expected: securityGroupInlineRule{direction: "inbound", ipVersion: "ipv4", remote: "0.0.0.0/0", local: "0.0.0.0/0", protocol: "icmp", icmpType: -1, icmpCode: -1, portMin: -1, portMax: -1},
+		},
+		{
+			name: "tcp with default ports",
+			rule: map[string]cty.Value{
+				isSecurityGroupRuleDirection:   cty.StringVal("inbound"),
+				isSecurityGroupRuleRemote:      cty.StringVal("10.0.0.0/8"),
+				isSecurityGroupRuleProtocolTCP: testSecurityGroupPorts(null, null),
+			},
+			expected: securityGroupInlineRule{direction: "inbound", ipVersion: "ipv4", remote: "10.0.0.0/8", local: "0.0.0.0/0", protocol: "tcp", icmpType: -1, icmpCode: -1, portMin: 1, portMax: 65535},
+		},
+		{
+			name: "udp with ports",
+			rule: map[string]cty.Value{
+				isSecurityGroupRuleDirection:   cty.StringVal("inbound"),
+				isSecurityGroupRuleProtocolUDP: testSecurityGroupPorts(cty.NumberIntVal(53), cty.NumberIntVal(53)),
+			},
+			expected: securityGroupInlineRule{direction: "inbound", ipVersion: "ipv4", remote: "0.0.0.0/0", local: "0.0.0.0/0", protocol: "udp", icmpType: -1, icmpCode: -1, portMin: 53, portMax: 53},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rules, err := securityGroupInlineRulesFromConfig(testSecurityGroupRulesConfig(c.rule))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rules) != 1 || rules[0] != c.expected {
+				t.Errorf("expected [%+v], got %+v", c.expected, rules)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupInlineRulesFromConfigErrors(t *testing.T) {
+	null := cty.NullVal(cty.Number)
+	cases := map[string]map[string]cty.Value{
+		"two protocols": {
+			isSecurityGroupRuleDirection:    cty.StringVal("inbound"),
+			isSecurityGroupRuleProtocolICMP: testSecurityGroupICMP(null, null),
+			isSecurityGroupRuleProtocolTCP:  testSecurityGroupPorts(null, null),
+		},
+		"icmp code without type": {
+			isSecurityGroupRuleDirection:    cty.StringVal("inbound"),
+			isSecurityGroupRuleProtocolICMP: testSecurityGroupICMP(null, cty.NumberIntVal(0)),
+		},
+		"port_min greater than port_max": {
+			isSecurityGroupRuleDirection:   cty.StringVal("inbound"),
+			isSecurityGroupRuleProtocolTCP: testSecurityGroupPorts(cty.NumberIntVal(443), cty.NumberIntVal(80)),
+		},
+	}
+	for name, rule := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := securityGroupInlineRulesFromConfig(testSecurityGroupRulesConfig(rule)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestSecurityGroupInlineRulesFromConfigUnset(t *testing.T) {
+	configType := ResourceIBMISSecurityGroup().CoreConfigSchema().ImpliedType()
+	attrs := map[string]cty.Value{}
+	for name, attrType := range configType.AttributeTypes() {
+		attrs[name] = cty.NullVal(attrType)
+	}
+	rules, err := securityGroupInlineRulesFromConfig(cty.ObjectVal(attrs))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rules) != 0 {
+		t.Errorf("expected no rules, got %+v", rules)
+	}
+
+	rules, err = securityGroupInlineRulesFromConfig(testSecurityGroupRulesConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rules) != 0 {
+		t.Errorf("expected no rules, got %+v", rules)
+	}
+}
+
+func TestParseSecurityGroupInlineRuleICMPZero(t *testing.T) {
+	_, flattened := flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+		ID:        core.StringPtr("rule-id"),
+		Direction: core.StringPtr("inbound"),
+		IPVersion: core.StringPtr("ipv4"),
+		Protocol:  core.StringPtr("icmp"),
+		Type:      core.Int64Ptr(0),
+		Code:      core.Int64Ptr(0),
+		Remote:    &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+	})
+	rule := parseSecurityGroupInlineRule(flattened)
+	if rule.icmpType != 0 || rule.icmpCode != 0 {
+		t.Errorf("expected icmp type and code 0, got %d and %d", rule.icmpType, rule.icmpCode)
+	}
+}
+
+func TestSecurityGroupUserTags(t *testing.T) {
+	tags := flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev"})
+
+	managed := securityGroupUserTags(tags, true)
+	if managed.Len() != 2 || !managed.Contains("env:dev") || !managed.Contains(isSecurityGroupInlineRulesTag) {
+		t.Errorf("expected the tags with %s, got %v", isSecurityGroupInlineRulesTag, managed.List())
+	}
+	if tags.Contains(isSecurityGroupInlineRulesTag) {
+		t.Errorf("expected the tags of the configuration to be unchanged, got %v", tags.List())
+	}
+
+	if unmanaged := securityGroupUserTags(tags, false); !unmanaged.Equal(tags) {
+		t.Errorf("expected %v, got %v", tags.List(), unmanaged.List())
+	}
+	if empty := securityGroupUserTags(nil, false); empty.Len() != 0 {
+		t.Errorf("expected no tags, got %v", empty.List())
+	}
+}
+
+func TestSecurityGroupInlineRulesConflict(t *testing.T) {
+	if err := securityGroupInlineRulesConflict("r006-sg", flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev"})); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := securityGroupInlineRulesConflict("r006-sg", flex.NewStringSet(flex.ResourceIBMVPCHash, []string{"env:dev", isSecurityGroupInlineRulesTag}))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, name := range []string{"ibm_is_security_group_rule", "ibm_is_security_group ", "r006-sg"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("expected the error to name %q, got %q", name, err)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,6 +48,12 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 		Exists:        resourceIBMISSecurityGroupRuleExists,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISSecurityGroupRuleInlineRulesCustomizeDiff(diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isSecurityGroupID: {
@@ -646,6 +653,36 @@ func resourceIBMISSecurityGroupRuleExists(d *schema.ResourceData, meta interface
 	return true, nil
 }
 
+// resourceIBMISSecurityGroupRuleInlineRulesCustomizeDiff fails the plan of a
+// rule of a security group whose rules are managed by the rule blocks of
+// ibm_is_security_group. When the security group is created in the same
+// apply, its ID is only known, and the check made, when the rule is applied.
+func resourceIBMISSecurityGroupRuleInlineRulesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(isSecurityGroupID) {
+		return nil
+	}
+	secgrpID := diff.Get(isSecurityGroupID).(string)
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &secgrpID,
+	}
+	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Security Group (%s): %s\n%s", secgrpID, err, response)
+	}
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, *sg.CRN, "", isUserTagType)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Security Group (%s) tags: %s", secgrpID, err)
+	}
+	return securityGroupInlineRulesConflict(secgrpID, tags)
+}
+
 func parseISTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, ".")
 	if len(segments) != 2 {
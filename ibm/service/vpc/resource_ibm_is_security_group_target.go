@@ -126,7 +126,7 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 			log.Printf("[INFO] Load balancer with ID '%s' is in UPDATE_PENDING state. Waiting for it to become available before retrying...", targetID)
 
 			// Wait for the load balancer to become available
-			_, waitErr := isWaitForSGTargetLBAvailable(sess, targetID, d.Timeout(schema.TimeoutCreate))
+			_, waitErr := isWaitForSGTargetLBAvailable(context, sess, targetID, d.Timeout(schema.TimeoutCreate))
 			if waitErr != nil {
 				err = fmt.Errorf("isWaitForSGTargetLBAvailable failed: waiting for load balancer to become available while creating Security Group Target Binding: %s", waitErr)
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbSgTargetCreateAvailable failed: %s", err.Error()), "ibm_is_security_group_target", "create")
@@ -152,7 +152,7 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 	crn := sgtarget.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := sgtarget.ID
-		_, errsgt := isWaitForLbSgTargetCreateAvailable(sess, *lbid, d.Timeout(schema.TimeoutCreate))
+		_, errsgt := isWaitForLbSgTargetCreateAvailable(context, sess, *lbid, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLbSgTargetCreateAvailable failed: %s", errsgt.Error()), "ibm_is_security_group_target", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -160,7 +160,7 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 		}
 	} else if crn != nil && *crn != "" && (strings.Contains(*crn, "virtual-network-interface") || strings.Contains(*crn, "virtual-network-interfaces")) {
 		vniId := sgtarget.ID
-		_, errsgt := isWaitForVNISgTargetCreateAvailable(sess, *vniId, d.Timeout(schema.TimeoutCreate))
+		_, errsgt := isWaitForVNISgTargetCreateAvailable(context, sess, *vniId, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVNISgTargetCreateAvailable failed: %s", err.Error()), "ibm_is_security_group_target", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -280,7 +280,7 @@ func resourceIBMISSecurityGroupTargetDelete(context context.Context, d *schema.R
 	crn := securityGroupTargetReference.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := securityGroupTargetReference.ID
-		_, errsgt := isWaitForLBRemoveAvailable(sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
+		_, errsgt := isWaitForLBRemoveAvailable(context, sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
 		if errsgt != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBRemoveAvailable failed: %s", err.Error()), "ibm_is_security_group_target", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -326,7 +326,7 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 
 }
 
-func isWaitForLBRemoveAvailable(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBRemoveAvailable(ctx context.Context, sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[INFO] Waiting for load balancer binding (%s) to be removed.", lbId)
 
 	stateConf := &resource.StateChangeConf{
@@ -339,7 +339,7 @@ func isWaitForLBRemoveAvailable(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTarget
 		NotFoundChecks: 1,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetReferenceIntf, lbId, securityGroupID, securityGroupTargetID string) resource.StateRefreshFunc {
@@ -373,7 +373,7 @@ func isLBRemoveRefreshFunc(sess *vpcv1.VpcV1, sgt vpcv1.SecurityGroupTargetRefer
 	}
 }
 
-func isWaitForLbSgTargetCreateAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbSgTargetCreateAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available.", lbId)
 
 	stateConf := &resource.StateChangeConf{
@@ -385,7 +385,7 @@ func isWaitForLbSgTargetCreateAvailable(sess *vpcv1.VpcV1, lbId string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
@@ -407,7 +407,7 @@ func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefre
 	}
 }
 
-func isWaitForVNISgTargetCreateAvailable(sess *vpcv1.VpcV1, vniId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVNISgTargetCreateAvailable(ctx context.Context, sess *vpcv1.VpcV1, vniId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual network interface (%s) to be available.", vniId)
 
 	stateConf := &resource.StateChangeConf{
@@ -419,7 +419,7 @@ func isWaitForVNISgTargetCreateAvailable(sess *vpcv1.VpcV1, vniId string, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVNISgTargetRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string) resource.StateRefreshFunc {
@@ -441,7 +441,7 @@ func isVNISgTargetRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string) resource.Sta
 }
 
 // New function to wait for a load balancer to become available before attaching a security group
-func isWaitForSGTargetLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
+func isWaitForSGTargetLBAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer (%s) to be available before attaching security group.", lbId)
 
 	stateConf := &resource.StateChangeConf{
@@ -453,7 +453,7 @@ func isWaitForSGTargetLBAvailable(sess *vpcv1.VpcV1, lbId string, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 // Refresh function for checking load balancer status before security group attachment
@@ -53,6 +53,68 @@ func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMISSecurityGroup_inlineRules(t *testing.T) {
+	var securityGroup string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-inline-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_security_group.testacc_security_group", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group.testacc_security_group", "rules.*", map[string]string{
+							"direction": "inbound",
+							"protocol":  "icmp",
+							"type":      "0",
+							"code":      "0",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group.testacc_security_group", "rule.*", map[string]string{
+							"direction":      "inbound",
+							"remote":         "10.0.0.0/8",
+							"tcp.0.port_min": "22",
+							"tcp.0.port_max": "22",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 443),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group.testacc_security_group", "rule.*", map[string]string{
+							"direction":      "inbound",
+							"tcp.0.port_min": "443",
+							"tcp.0.port_max": "443",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesEmptyConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "0"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISSecurityGroup_wait(t *testing.T) {
 	var securityGroup string
 
@@ -227,3 +289,51 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name string, port int) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc = ibm_is_vpc.testacc_vpc.id
+
+	rule {
+		direction = "inbound"
+		remote    = "10.0.0.0/8"
+		tcp {
+			port_min = %d
+			port_max = %d
+		}
+	}
+
+	rule {
+		direction = "outbound"
+	}
+
+	rule {
+		direction = "inbound"
+		icmp {
+			type = 0
+			code = 0
+		}
+	}
+}`, vpcname, name, port, port)
+
+}
+
+func testAccCheckIBMISsecurityGroupInlineRulesEmptyConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	rule = []
+}`, vpcname, name)
+
+}
@@ -1276,7 +1276,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 		replicaShareAccessTagsSchema := "replica_share.0.access_tags"
 		if _, ok := d.GetOk(replicaShareAccessTagsSchema); ok {
 			oldList, newList := d.GetChange(replicaShareAccessTagsSchema)
-			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.ReplicaShare.CRN, "", isAccessTagType)
+			err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *share.ReplicaShare.CRN, "", isAccessTagType)
 			if err != nil {
 				log.Printf(
 					"Error creating replica file share (%s) access tags: %s", d.Id(), err)
@@ -1287,7 +1287,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 
 	if _, ok := d.GetOk(isFileShareAccessTags); ok {
 		oldList, newList := d.GetChange(isFileShareAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *share.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error creating file share (%s) access tags: %s", d.Id(), err)
@@ -1640,7 +1640,7 @@ func resourceIbmIsShareUpdate(context context.Context, d *schema.ResourceData, m
 	}
 	if d.HasChange(isFileShareAccessTags) {
 		oldList, newList := d.GetChange(isFileShareAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error updating shares (%s) access tags: %s", d.Id(), err)
@@ -1813,7 +1813,7 @@ func isWaitForShareAvailable(context context.Context, vpcClient *vpcv1.VpcV1, sh
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func isShareRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -1863,7 +1863,7 @@ func isWaitForShareDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *sc
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func suppressCronSpecDiff(k, old, new string, d *schema.ResourceData) bool {
@@ -2179,7 +2179,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 					log.Printf("[DEBUG] UpdateShareTargetWithContext failed %s\n%s", err, response)
 					return err
 				}
-				_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+				_, err = WaitForVNIAvailable(context, vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					return err
 				}
@@ -2203,7 +2203,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface of share mount target %s\n%s: %q", add[i], d.Id(), err, response)
 						}
-						_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+						_, err = WaitForVNIAvailable(context, vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							return err
 						}
@@ -2225,7 +2225,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface of share mount target %s\n%s: %q", remove[i], d.Id(), err, response)
 						}
-						_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+						_, err = WaitForVNIAvailable(context, vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
 							return err
 						}
@@ -2267,7 +2267,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
 				}
-				_, err = isWaitForReservedIpAvailable(sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForReservedIpAvailable(context, sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error waiting for the reserved IP to be available: %s", err)
 				}
@@ -2276,7 +2276,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 	}
 	if d.HasChange(accessTagsSchema) {
 		oldList, newList := d.GetChange(accessTagsSchema)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(shareCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(shareCRN).(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error updating shares (%s) access tags: %s", d.Id(), err)
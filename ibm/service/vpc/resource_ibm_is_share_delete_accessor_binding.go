@@ -83,7 +83,7 @@ func isWaitForShareAccessorBindingDeleted(context context.Context, vpcClient *vp
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func isShareAccessorBindingRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, bindingId string, d *schema.ResourceData) resource.StateRefreshFunc {
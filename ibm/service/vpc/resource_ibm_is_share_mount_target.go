@@ -357,7 +357,7 @@ func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.Reso
 	}
 	d.SetId(fmt.Sprintf("%s/%s", *createShareMountTargetOptions.ShareID, *shareTarget.ID))
 	if shareTarget.VirtualNetworkInterface != nil {
-		_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+		_, err = WaitForVNIAvailable(context, vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "create").GetDiag()
 		}
@@ -516,7 +516,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+		_, err = WaitForVNIAvailable(context, vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 		}
@@ -542,7 +542,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForVNIAvailable(context, vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 				}
@@ -566,7 +566,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
+				_, err = WaitForVNIAvailable(context, vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("WaitForVNIAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 				}
@@ -612,7 +612,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForReservedIpAvailable(sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForReservedIpAvailable(context, sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservedIpAvailable failed: %s", err.Error()), "ibm_is_share_mount_target", "update").GetDiag()
 		}
@@ -686,7 +686,7 @@ func WaitForMountTargetAvailable(context context.Context, vpcClient *vpcv1.VpcV1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func mountTargetRefresh(context context.Context, vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -738,7 +738,7 @@ func isWaitForMountTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func ShareMountTargetVNIReservedIPInterfaceToMap(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, ripRef *vpcv1.ReservedIPReference, subnetId string) (map[string]interface{}, error) {
@@ -932,10 +932,10 @@ func isWaitForTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *s
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
-func WaitForVNIAvailable(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func WaitForVNIAvailable(ctx context.Context, vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VNI (%s) to be available.", vniId)
 
 	stateConf := &resource.StateChangeConf{
@@ -947,7 +947,7 @@ func WaitForVNIAvailable(vpcClient *vpcv1.VpcV1, vniId string, d *schema.Resourc
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func VNIRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -979,7 +979,7 @@ func WaitForTargetAvailable(context context.Context, vpcClient *vpcv1.VpcV1, sha
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func mountTargetRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid, targetid string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -148,7 +148,7 @@ func isWaitForShareReplicationJobDone(context context.Context, vpcClient *vpcv1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -196,7 +196,7 @@ func isWaitForShareSplit(context context.Context, vpcClient *vpcv1.VpcV1, sharei
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func isShareSplitRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid string, d *schema.ResourceData) resource.StateRefreshFunc {
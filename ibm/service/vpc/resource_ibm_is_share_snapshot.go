@@ -361,7 +361,7 @@ func resourceIBMIsShareSnapshotCreate(context context.Context, d *schema.Resourc
 	}
 	if _, ok := d.GetOk("access_tags"); ok {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *shareSnapshot.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *shareSnapshot.CRN, "", isAccessTagType)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateGlobalTagsUsingCRN failed: %s", err.Error()), "ibm_is_share_snapshot", "create")
 			log.Printf(
@@ -585,7 +585,7 @@ func resourceIBMIsShareSnapshotUpdate(context context.Context, d *schema.Resourc
 	}
 	if d.HasChange("access_tags") {
 		oldList, newList := d.GetChange("access_tags")
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSnapshotCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isSnapshotCRN).(string), "", isAccessTagType)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateGlobalTagsUsingCRN failed: %s", err.Error()), "ibm_is_share_snapshot", "update")
 			log.Printf(
@@ -710,7 +710,7 @@ func isWaitForShareSnapshotAvailable(context context.Context, vpcClient *vpcv1.V
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func isShareSnapshotRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid, shareSnapshotId string, d *schema.ResourceData) resource.StateRefreshFunc {
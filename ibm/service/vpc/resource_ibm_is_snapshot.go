@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -630,7 +631,7 @@ func resourceIBMISSnapshotCreate(context context.Context, d *schema.ResourceData
 	d.SetId(*snapshot.ID)
 	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
 
-	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot available failed: %s", err.Error()), "ibm_is_snapshot", "create")
@@ -640,7 +641,7 @@ func resourceIBMISSnapshotCreate(context context.Context, d *schema.ResourceData
 
 	if _, ok := d.GetOk(isSnapshotAccessTags); ok {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on create of resource snapshot (%s) access tags: %s", d.Id(), err)
@@ -649,7 +650,7 @@ func resourceIBMISSnapshotCreate(context context.Context, d *schema.ResourceData
 	return resourceIBMISSnapshotRead(context, d, meta)
 }
 
-func isWaitForSnapshotAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -661,7 +662,7 @@ func isWaitForSnapshotAvailable(sess *vpcv1.VpcV1, id string, timeout time.Durat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1004,7 +1005,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForSnapshotUpdate(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot update failed: %s", err.Error()), "ibm_is_snapshot", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1034,7 +1035,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotUpdate(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot update failed: %s", err.Error()), "ibm_is_snapshot", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1062,7 +1063,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForCloneAvailable(sess, d, id, add[i])
+				_, err = isWaitForCloneAvailable(context, sess, d, id, add[i])
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot clone available failed: %s", err.Error()), "ibm_is_snapshot", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1083,7 +1084,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForCloneDeleted(sess, d, d.Id(), remove[i])
+				_, err = isWaitForCloneDeleted(context, sess, d, d.Id(), remove[i])
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot clone deleted failed: %s", err.Error()), "ibm_is_snapshot", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1095,7 +1096,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 
 	if d.HasChange(isSnapshotAccessTags) {
 		oldList, newList := d.GetChange(isSnapshotAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSnapshotCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isSnapshotCRN).(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"[ERROR] Error on update of resource snapshot (%s) access tags: %s", d.Id(), err)
@@ -1104,7 +1105,7 @@ func snapshotUpdate(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func isWaitForSnapshotUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotUpdate(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1115,7 +1116,7 @@ func isWaitForSnapshotUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1137,7 +1138,7 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		return snapshot, isSnapshotUpdating, nil
 	}
 }
-func isWaitForCloneAvailable(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
+func isWaitForCloneAvailable(ctx context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) clone (%s) to be available.", id, zoneName)
 
 	stateConf := &resource.StateChangeConf{
@@ -1148,7 +1149,7 @@ func isWaitForCloneAvailable(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zone
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotCloneRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) resource.StateRefreshFunc {
@@ -1191,7 +1192,7 @@ func isSnapshotCloneDeleteRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) re
 	}
 }
 
-func isWaitForCloneDeleted(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
+func isWaitForCloneDeleted(ctx context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) clone (%s) to be deleted.", id, zoneName)
 
 	stateConf := &resource.StateChangeConf{
@@ -1202,7 +1203,7 @@ func isWaitForCloneDeleted(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneNa
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIBMISSnapshotDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1246,7 +1247,7 @@ func snapshotDelete(context context.Context, d *schema.ResourceData, meta interf
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for Snapshot deleted failed: %s", err.Error()), "ibm_is_snapshot", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1256,7 +1257,7 @@ func snapshotDelete(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func isWaitForSnapshotDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1268,7 +1269,7 @@ func isWaitForSnapshotDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duratio
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -362,7 +362,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 
 	d.SetId(*snapshotConsistencyGroup.ID)
 
-	_, err = isWaitForSnapshotConsistencyGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSnapshotConsistencyGroupAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for SnapshotConsistencyGroup available failed: %s", err.Error()), "ibm_is_snapshot_consistency_group", "create")
@@ -373,7 +373,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
 		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
@@ -382,7 +382,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 
 	if _, ok := d.GetOk("access_tags"); ok {
 		oldList, newList := d.GetChange("access_tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource snapshot consistency group (%s) access tags: %s", d.Id(), err)
@@ -392,7 +392,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 	return resourceIBMIsSnapshotConsistencyGroupRead(context, d, meta)
 }
 
-func isWaitForSnapshotConsistencyGroupAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotConsistencyGroupAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot Consistency Group(%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -404,7 +404,7 @@ func isWaitForSnapshotConsistencyGroupAvailable(sess *vpcv1.VpcV1, id string, ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -430,7 +430,7 @@ func isSnapshotConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) re
 	}
 }
 
-func isWaitForSnapshotConsistencyGroupUpdate(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotConsistencyGroupUpdate(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot Consistency Group (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -441,7 +441,7 @@ func isWaitForSnapshotConsistencyGroupUpdate(sess *vpcv1.VpcV1, id string, timeo
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotUpdateConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -465,7 +465,7 @@ func isSnapshotUpdateConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id stri
 	}
 }
 
-func isWaitForSnapshotConsistencyGroupDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSnapshotConsistencyGroupDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Snapshot Consistency Group (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -477,7 +477,7 @@ func isWaitForSnapshotConsistencyGroupDeleted(sess *vpcv1.VpcV1, id string, time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSnapshotDeleteConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -636,7 +636,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 		}
 
 		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
@@ -658,7 +658,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 		}
 
 		oldList, newList := d.GetChange("access_tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource VPC snapshot consistency group  (%s) access tags: %s", d.Id(), err)
@@ -700,7 +700,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSnapshotConsistencyGroupUpdate(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotConsistencyGroupUpdate(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for SnapshotConsistencyGroup update failed: %s", err.Error()), "ibm_is_snapshot_consistency_group", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -730,7 +730,7 @@ func resourceIBMIsSnapshotConsistencyGroupDelete(context context.Context, d *sch
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForSnapshotConsistencyGroupDeleted(vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSnapshotConsistencyGroupDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Wait for SnapshotConsistencyGroup deleted failed: %s", err.Error()), "ibm_is_snapshot_consistency_group", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -0,0 +1,506 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshotCGRestoreConsistencyGroup = "consistency_group"
+	isSnapshotCGRestoreSnapshots        = "snapshots"
+	isSnapshotCGRestoreName             = "name"
+	isSnapshotCGRestoreProfile          = "profile"
+	isSnapshotCGRestoreZone             = "zone"
+	isSnapshotCGRestoreSubnet           = "subnet"
+	isSnapshotCGRestoreSecurityGroups   = "security_groups"
+	isSnapshotCGRestoreResourceGroup    = "resource_group"
+	isSnapshotCGRestoreKeys             = "keys"
+	isSnapshotCGRestoreVolumeProfile    = "volume_profile"
+	isSnapshotCGRestoreEncryptionKey    = "encryption_key"
+	isSnapshotCGRestoreBootVolume       = "boot_volume"
+	isSnapshotCGRestoreVolumes          = "volumes"
+	isSnapshotCGRestoreVNI              = "virtual_network_interface"
+	isSnapshotCGRestorePrimaryIP        = "primary_ip"
+	isSnapshotCGRestoreStatus           = "status"
+	isSnapshotCGRestoreVPC              = "vpc"
+)
+
+func ResourceIBMISSnapshotConsistencyGroupRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSnapshotConsistencyGroupRestoreCreate,
+		ReadContext:   resourceIBMISSnapshotConsistencyGroupRestoreRead,
+		DeleteContext: resourceIBMISSnapshotConsistencyGroupRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotCGRestoreConsistencyGroup: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isSnapshotCGRestoreConsistencyGroup, isSnapshotCGRestoreSnapshots},
+				Description:  "The ID of the snapshot consistency group to restore the instance from",
+			},
+			isSnapshotCGRestoreSnapshots: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				ExactlyOneOf: []string{isSnapshotCGRestoreConsistencyGroup, isSnapshotCGRestoreSnapshots},
+				Description:  "The IDs of the snapshots to restore the instance from, such as the copies of the snapshots of a consistency group in another region",
+			},
+			isSnapshotCGRestoreName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the restored instance",
+			},
+			isSnapshotCGRestoreProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the profile of the restored instance",
+			},
+			isSnapshotCGRestoreZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone of the restored instance",
+			},
+			isSnapshotCGRestoreSubnet: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the subnet of the virtual network interface of the restored instance",
+			},
+			isSnapshotCGRestoreSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the security groups of the virtual network interface, the default security group of the VPC is used if not set",
+			},
+			isSnapshotCGRestoreResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The ID of the resource group of the restored instance, its volumes and its virtual network interface",
+			},
+			isSnapshotCGRestoreKeys: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the SSH keys of the restored instance",
+			},
+			isSnapshotCGRestoreVolumeProfile: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "general-purpose",
+				Description: "The name of the profile of the restored volumes",
+			},
+			isSnapshotCGRestoreEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the key to encrypt the restored volumes with, the encryption of the snapshots is used if not set",
+			},
+			isSnapshotCGRestoreVPC: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the VPC of the restored instance",
+			},
+			isSnapshotCGRestoreBootVolume: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the boot volume of the restored instance",
+			},
+			isSnapshotCGRestoreVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The volumes of the restored instance, including the boot volume",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the volume",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the volume",
+						},
+						"source_snapshot": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the snapshot the volume was restored from",
+						},
+						"bootable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if the volume is the boot volume of the instance",
+						},
+					},
+				},
+			},
+			isSnapshotCGRestoreVNI: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the virtual network interface of the restored instance",
+			},
+			isSnapshotCGRestorePrimaryIP: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary IP address of the restored instance",
+			},
+			isSnapshotCGRestoreStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the restored instance",
+			},
+		},
+	}
+}
+
+func resourceIBMISSnapshotConsistencyGroupRestoreCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_consistency_group_restore", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	bootSnapshot, dataSnapshots, err := snapshotCGRestoreSnapshots(context, sess, d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("snapshotCGRestoreSnapshots failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	subnetID := d.Get(isSnapshotCGRestoreSubnet).(string)
+	subnet, _, err := sess.GetSubnetWithContext(context, &vpcv1.GetSubnetOptions{ID: &subnetID})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSubnetWithContext failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	name := d.Get(isSnapshotCGRestoreName).(string)
+	profile := d.Get(isSnapshotCGRestoreProfile).(string)
+	zone := d.Get(isSnapshotCGRestoreZone).(string)
+	volumeProfile := d.Get(isSnapshotCGRestoreVolumeProfile).(string)
+	var encryptionKey vpcv1.EncryptionKeyIdentityIntf
+	if key, ok := d.GetOk(isSnapshotCGRestoreEncryptionKey); ok {
+		keyCRN := key.(string)
+		encryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: &keyCRN,
+		}
+	}
+	var resourceGroup vpcv1.ResourceGroupIdentityIntf
+	if rg, ok := d.GetOk(isSnapshotCGRestoreResourceGroup); ok {
+		rgID := rg.(string)
+		resourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rgID,
+		}
+	}
+
+	vni := &vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterface{
+		Subnet: &vpcv1.SubnetIdentityByID{
+			ID: &subnetID,
+		},
+		AutoDelete:    flex.PtrToBool(true),
+		ResourceGroup: resourceGroup,
+	}
+	if sgs, ok := d.GetOk(isSnapshotCGRestoreSecurityGroups); ok {
+		for _, sg := range sgs.(*schema.Set).List() {
+			sgID := sg.(string)
+			vni.SecurityGroups = append(vni.SecurityGroups, &vpcv1.SecurityGroupIdentity{
+				ID: &sgID,
+			})
+		}
+	}
+
+	instanceproto := &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
+		Name: &name,
+		Zone: &vpcv1.ZoneIdentity{
+			Name: &zone,
+		},
+		Profile: &vpcv1.InstanceProfileIdentity{
+			Name: &profile,
+		},
+		VPC: &vpcv1.VPCIdentity{
+			ID: subnet.VPC.ID,
+		},
+		ResourceGroup: resourceGroup,
+		BootVolumeAttachment: &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
+			DeleteVolumeOnInstanceDelete: flex.PtrToBool(true),
+			Volume: &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{
+				Profile: &vpcv1.VolumeProfileIdentity{
+					Name: &volumeProfile,
+				},
+				SourceSnapshot: &vpcv1.SnapshotIdentity{
+					ID: bootSnapshot.ID,
+				},
+				EncryptionKey: encryptionKey,
+				ResourceGroup: resourceGroup,
+			},
+		},
+		PrimaryNetworkAttachment: &vpcv1.InstanceNetworkAttachmentPrototype{
+			VirtualNetworkInterface: vni,
+		},
+	}
+	for _, snapshot := range dataSnapshots {
+		instanceproto.VolumeAttachments = append(instanceproto.VolumeAttachments, vpcv1.VolumeAttachmentPrototype{
+			DeleteVolumeOnInstanceDelete: flex.PtrToBool(true),
+			Volume: &vpcv1.VolumeAttachmentPrototypeVolume{
+				Profile: &vpcv1.VolumeProfileIdentity{
+					Name: &volumeProfile,
+				},
+				SourceSnapshot: &vpcv1.SnapshotIdentity{
+					ID: snapshot.ID,
+				},
+				EncryptionKey: encryptionKey,
+				ResourceGroup: resourceGroup,
+			},
+		})
+	}
+	if keys, ok := d.GetOk(isSnapshotCGRestoreKeys); ok {
+		for _, key := range keys.(*schema.Set).List() {
+			keyID := key.(string)
+			instanceproto.Keys = append(instanceproto.Keys, &vpcv1.KeyIdentity{
+				ID: &keyID,
+			})
+		}
+	}
+
+	instance, _, err := sess.CreateInstanceWithContext(context, &vpcv1.CreateInstanceOptions{InstancePrototype: instanceproto})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateInstanceWithContext failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId(*instance.ID)
+	log.Printf("[INFO] Instance restored from snapshots : %s", *instance.ID)
+
+	_, err = isWaitForRestoredInstanceRunning(context, sess, *instance.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForRestoredInstanceRunning failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	return resourceIBMISSnapshotConsistencyGroupRestoreRead(context, d, meta)
+}
+
+func resourceIBMISSnapshotConsistencyGroupRestoreRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_consistency_group_restore", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	id := d.Id()
+	instance, response, err := sess.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetInstanceWithContext failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	volumes := []map[string]interface{}{}
+	attachments := []vpcv1.VolumeAttachmentReferenceInstanceContext{}
+	if instance.BootVolumeAttachment != nil {
+		attachments = append(attachments, *instance.BootVolumeAttachment)
+	}
+	for _, attachment := range instance.VolumeAttachments {
+		if instance.BootVolumeAttachment != nil && *attachment.ID == *instance.BootVolumeAttachment.ID {
+			continue
+		}
+		attachments = append(attachments, attachment)
+	}
+	for i, attachment := range attachments {
+		if attachment.Volume == nil {
+			continue
+		}
+		volume, _, err := sess.GetVolumeWithContext(context, &vpcv1.GetVolumeOptions{ID: attachment.Volume.ID})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVolumeWithContext failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		item := map[string]interface{}{
+			"id":       *volume.ID,
+			"name":     *volume.Name,
+			"bootable": i == 0 && instance.BootVolumeAttachment != nil,
+		}
+		if volume.SourceSnapshot != nil {
+			item["source_snapshot"] = *volume.SourceSnapshot.ID
+		}
+		volumes = append(volumes, item)
+	}
+
+	if err = d.Set(isSnapshotCGRestoreName, instance.Name); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting name: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-name").GetDiag()
+	}
+	if err = d.Set(isSnapshotCGRestoreProfile, instance.Profile.Name); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting profile: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-profile").GetDiag()
+	}
+	if err = d.Set(isSnapshotCGRestoreZone, instance.Zone.Name); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting zone: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-zone").GetDiag()
+	}
+	if err = d.Set(isSnapshotCGRestoreVPC, instance.VPC.ID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpc: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-vpc").GetDiag()
+	}
+	if err = d.Set(isSnapshotCGRestoreResourceGroup, instance.ResourceGroup.ID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting resource_group: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-resource_group").GetDiag()
+	}
+	if instance.BootVolumeAttachment != nil && instance.BootVolumeAttachment.Volume != nil {
+		if err = d.Set(isSnapshotCGRestoreBootVolume, instance.BootVolumeAttachment.Volume.ID); err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting boot_volume: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-boot_volume").GetDiag()
+		}
+	}
+	if err = d.Set(isSnapshotCGRestoreVolumes, volumes); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting volumes: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-volumes").GetDiag()
+	}
+	if attachment := instance.PrimaryNetworkAttachment; attachment != nil {
+		if attachment.VirtualNetworkInterface != nil {
+			if err = d.Set(isSnapshotCGRestoreVNI, attachment.VirtualNetworkInterface.ID); err != nil {
+				return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting virtual_network_interface: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-virtual_network_interface").GetDiag()
+			}
+		}
+		if attachment.PrimaryIP != nil {
+			if err = d.Set(isSnapshotCGRestorePrimaryIP, attachment.PrimaryIP.Address); err != nil {
+				return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting primary_ip: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-primary_ip").GetDiag()
+			}
+		}
+	}
+	if err = d.Set(isSnapshotCGRestoreStatus, instance.Status); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting status: %s", err), "ibm_is_snapshot_consistency_group_restore", "read", "set-status").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISSnapshotConsistencyGroupRestoreDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_consistency_group_restore", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// The volumes and the virtual network interface are deleted with the
+	// instance
+	id := d.Id()
+	response, err := sess.DeleteInstanceWithContext(context, &vpcv1.DeleteInstanceOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteInstanceWithContext failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	_, err = isWaitForInstanceDelete(context, sess, d, id)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceDelete failed: %s", err.Error()), "ibm_is_snapshot_consistency_group_restore", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.SetId("")
+	return nil
+}
+
+// snapshotCGRestoreSnapshots returns the snapshot of the boot volume and the
+// snapshots of the data volumes of the instance to restore. Exactly one of
+// the snapshots must be bootable.
+func snapshotCGRestoreSnapshots(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData) (*vpcv1.Snapshot, []*vpcv1.Snapshot, error) {
+	ids := []string{}
+	if cgID, ok := d.GetOk(isSnapshotCGRestoreConsistencyGroup); ok {
+		id := cgID.(string)
+		cg, response, err := sess.GetSnapshotConsistencyGroupWithContext(context, &vpcv1.GetSnapshotConsistencyGroupOptions{ID: &id})
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error getting snapshot consistency group %s: %s\n%s", id, err, response)
+		}
+		for _, snapshot := range cg.Snapshots {
+			ids = append(ids, *snapshot.ID)
+		}
+	} else {
+		ids = flex.ExpandStringList(d.Get(isSnapshotCGRestoreSnapshots).(*schema.Set).List())
+	}
+
+	var boot *vpcv1.Snapshot
+	data := []*vpcv1.Snapshot{}
+	for _, id := range ids {
+		snapshot, response, err := sess.GetSnapshotWithContext(context, &vpcv1.GetSnapshotOptions{ID: &id})
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error getting snapshot %s: %s\n%s", id, err, response)
+		}
+		if *snapshot.LifecycleState != isSnapshotAvailable {
+			return nil, nil, fmt.Errorf("[ERROR] Snapshot %s is %s, it must be %s to be restored", id, *snapshot.LifecycleState, isSnapshotAvailable)
+		}
+		if !*snapshot.Bootable {
+			data = append(data, snapshot)
+			continue
+		}
+		if boot != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Snapshots %s and %s are both bootable, exactly one of the snapshots must be bootable", *boot.ID, id)
+		}
+		boot = snapshot
+	}
+	if boot == nil {
+		return nil, nil, fmt.Errorf("[ERROR] None of the snapshots %v is bootable, exactly one of the snapshots must be bootable", ids)
+	}
+	return boot, data, nil
+}
+
+func isWaitForRestoredInstanceRunning(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for instance (%s) to be running.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isInstanceStatusPending, isInstanceStatusStarting},
+		Target:  []string{isInstanceStatusRunning},
+		Refresh: func() (interface{}, string, error) {
+			instance, response, err := sess.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &id})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting instance %s: %s\n%s", id, err, response)
+			}
+			if *instance.Status == isInstanceStatusFailed {
+				return instance, *instance.Status, fmt.Errorf("[ERROR] Instance %s went into failed state during the restore", id)
+			}
+			return instance, *instance.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return conns.WaitForStateContext(context, stateConf)
+}
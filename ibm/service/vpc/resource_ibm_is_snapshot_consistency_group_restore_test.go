@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMISSnapshotConsistencyGroupRestore_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	scgname := fmt.Sprintf("tf-snap-cons-grp-name-%d", acctest.RandIntRange(10, 100))
+	snapname := fmt.Sprintf("tf-snap-name-%d", acctest.RandIntRange(10, 100))
+	restorename := fmt.Sprintf("tf-restored-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotConsistencyGroupRestoreConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname, restorename),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_snapshot_consistency_group_restore.testacc_restore", "name", restorename),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_consistency_group_restore.testacc_restore", "status", "running"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_consistency_group_restore.testacc_restore", "volumes.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_consistency_group_restore.testacc_restore", "volumes.0.bootable", "true"),
+					resource.TestCheckResourceAttrPair("ibm_is_snapshot_consistency_group_restore.testacc_restore", "volumes.0.source_snapshot", "ibm_is_snapshot_consistency_group.testacc_scg", "snapshot_reference.0.id"),
+					resource.TestCheckResourceAttrPair("ibm_is_snapshot_consistency_group_restore.testacc_restore", "vpc", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_consistency_group_restore.testacc_restore", "boot_volume"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_consistency_group_restore.testacc_restore", "virtual_network_interface"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_consistency_group_restore.testacc_restore", "primary_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotConsistencyGroupRestoreConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname, restorename string) string {
+	return testAccCheckIBMISSnapshotConsistencyGroupSourceConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname) + fmt.Sprintf(`
+	resource "ibm_is_snapshot_consistency_group_restore" "testacc_restore" {
+		consistency_group = ibm_is_snapshot_consistency_group.testacc_scg.id
+		name              = "%s"
+		profile           = "%s"
+		zone              = "%s"
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		keys              = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+	`, restorename, acc.InstanceProfileName, acc.ISZoneName)
+}
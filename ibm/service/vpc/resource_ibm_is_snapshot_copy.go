@@ -0,0 +1,401 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshotCopySourceSnapshots        = "source_snapshots"
+	isSnapshotCopySourceConsistencyGroup = "source_consistency_group"
+	isSnapshotCopyTargetRegion           = "target_region"
+	isSnapshotCopyNamePrefix             = "name_prefix"
+	isSnapshotCopyEncryptionKey          = "encryption_key"
+	isSnapshotCopyResourceGroup          = "resource_group"
+	isSnapshotCopyClones                 = "clones"
+	isSnapshotCopySnapshots              = "snapshots"
+	isSnapshotCopySourceSnapshotCRN      = "source_snapshot_crn"
+)
+
+func ResourceIBMISSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSnapshotCopyCreate,
+		ReadContext:   resourceIBMISSnapshotCopyRead,
+		DeleteContext: resourceIBMISSnapshotCopyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotCopySourceSnapshots: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				ExactlyOneOf: []string{isSnapshotCopySourceSnapshots, isSnapshotCopySourceConsistencyGroup},
+				Description:  "The IDs or CRNs of the snapshots to copy, IDs are snapshots of the region of the provider",
+			},
+			isSnapshotCopySourceConsistencyGroup: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isSnapshotCopySourceSnapshots, isSnapshotCopySourceConsistencyGroup},
+				Description:  "The ID of a snapshot consistency group of the region of the provider, whose snapshots are copied",
+			},
+			isSnapshotCopyTargetRegion: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the region to copy the snapshots to",
+			},
+			isSnapshotCopyNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix of the names of the copies, which otherwise have the names of the source snapshots",
+			},
+			isSnapshotCopyEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of a key of the target region to encrypt the copies with, the copies are provider-managed encrypted if not set",
+			},
+			isSnapshotCopyResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group of the copies",
+			},
+			isSnapshotCopyClones: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The zones of the target region to create clones of the copies in",
+			},
+			isSnapshotCopySnapshots: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The copies of the snapshots in the target region",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSnapshotCopySourceSnapshotCRN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the source snapshot",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the copy",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the copy",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the copy",
+						},
+						"bootable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates if a boot volume attachment can be created with the copy",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the copy in gigabytes",
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the copy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMISSnapshotCopyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_copy", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	region := d.Get(isSnapshotCopyTargetRegion).(string)
+	targetSess, err := vpcClientForRegion(meta, region)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_copy", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	sources, err := snapshotCopySources(context, sess, d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("snapshotCopySources failed: %s", err.Error()), "ibm_is_snapshot_copy", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	ids := make([]string, 0, len(sources))
+	for _, source := range sources {
+		prototype := &vpcv1.SnapshotPrototypeSnapshotBySourceSnapshot{
+			SourceSnapshot: &vpcv1.SnapshotIdentityByCRN{
+				CRN: source.CRN,
+			},
+		}
+		if source.Name != nil {
+			name := d.Get(isSnapshotCopyNamePrefix).(string) + *source.Name
+			prototype.Name = &name
+		}
+		if key, ok := d.GetOk(isSnapshotCopyEncryptionKey); ok {
+			keyCRN := key.(string)
+			prototype.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+				CRN: &keyCRN,
+			}
+		}
+		if rg, ok := d.GetOk(isSnapshotCopyResourceGroup); ok {
+			rgID := rg.(string)
+			prototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: &rgID,
+			}
+		}
+		if clones, ok := d.GetOk(isSnapshotCopyClones); ok {
+			for _, zone := range clones.(*schema.Set).List() {
+				zoneName := zone.(string)
+				prototype.Clones = append(prototype.Clones, vpcv1.SnapshotClonePrototype{
+					Zone: &vpcv1.ZoneIdentity{
+						Name: &zoneName,
+					},
+				})
+			}
+		}
+		snapshot, _, err := targetSess.CreateSnapshotWithContext(context, &vpcv1.CreateSnapshotOptions{SnapshotPrototype: prototype})
+		if err != nil {
+			// The copies created so far are kept in the state so that they
+			// are deleted with the resource
+			if len(ids) > 0 {
+				d.SetId(snapshotCopyID(region, ids))
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSnapshotWithContext failed: %s", err.Error()), "ibm_is_snapshot_copy", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		log.Printf("[INFO] Snapshot copy of %s : %s", *source.CRN, *snapshot.ID)
+		ids = append(ids, *snapshot.ID)
+	}
+	d.SetId(snapshotCopyID(region, ids))
+
+	// The copies are created in parallel by the service, so they are only
+	// waited for once all of them are requested
+	for _, id := range ids {
+		_, err = isWaitForSnapshotAvailable(context, targetSess, id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSnapshotAvailable failed: %s", err.Error()), "ibm_is_snapshot_copy", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMISSnapshotCopyRead(context, d, meta)
+}
+
+func resourceIBMISSnapshotCopyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, ids, err := parseSnapshotCopyID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_snapshot_copy", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	targetSess, err := vpcClientForRegion(meta, region)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_copy", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	snapshots := make([]map[string]interface{}, 0, len(ids))
+	remaining := make([]string, 0, len(ids))
+	for _, id := range ids {
+		snapshot, response, err := targetSess.GetSnapshotWithContext(context, &vpcv1.GetSnapshotOptions{ID: &id})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSnapshotWithContext failed: %s", err.Error()), "ibm_is_snapshot_copy", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		item := map[string]interface{}{
+			"id":              *snapshot.ID,
+			"crn":             *snapshot.CRN,
+			"name":            *snapshot.Name,
+			"bootable":        *snapshot.Bootable,
+			"size":            *snapshot.Size,
+			"lifecycle_state": *snapshot.LifecycleState,
+		}
+		if snapshot.SourceSnapshot != nil && snapshot.SourceSnapshot.CRN != nil {
+			item[isSnapshotCopySourceSnapshotCRN] = *snapshot.SourceSnapshot.CRN
+		}
+		snapshots = append(snapshots, item)
+		remaining = append(remaining, id)
+	}
+	if len(remaining) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.SetId(snapshotCopyID(region, remaining))
+
+	if err = d.Set(isSnapshotCopyTargetRegion, region); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting target_region: %s", err), "ibm_is_snapshot_copy", "read", "set-target_region").GetDiag()
+	}
+	if err = d.Set(isSnapshotCopySnapshots, snapshots); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting snapshots: %s", err), "ibm_is_snapshot_copy", "read", "set-snapshots").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISSnapshotCopyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, ids, err := parseSnapshotCopyID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_snapshot_copy", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	targetSess, err := vpcClientForRegion(meta, region)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_copy", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	deleting := make([]string, 0, len(ids))
+	for _, id := range ids {
+		response, err := targetSess.DeleteSnapshotWithContext(context, &vpcv1.DeleteSnapshotOptions{ID: &id})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSnapshotWithContext failed: %s", err.Error()), "ibm_is_snapshot_copy", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		deleting = append(deleting, id)
+	}
+	for _, id := range deleting {
+		_, err = isWaitForSnapshotDeleted(context, targetSess, id, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSnapshotDeleted failed: %s", err.Error()), "ibm_is_snapshot_copy", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// snapshotCopySources returns the snapshots to copy, which are either the
+// configured snapshots or the snapshots of the consistency group. The
+// snapshots given by ID are looked up in the region of the provider for
+// their CRN and name.
+func snapshotCopySources(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData) ([]vpcv1.SnapshotReference, error) {
+	sources := []vpcv1.SnapshotReference{}
+	if cgID, ok := d.GetOk(isSnapshotCopySourceConsistencyGroup); ok {
+		id := cgID.(string)
+		cg, response, err := sess.GetSnapshotConsistencyGroupWithContext(context, &vpcv1.GetSnapshotConsistencyGroupOptions{ID: &id})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting snapshot consistency group %s: %s\n%s", id, err, response)
+		}
+		for _, snapshot := range cg.Snapshots {
+			sources = append(sources, vpcv1.SnapshotReference{CRN: snapshot.CRN, Name: snapshot.Name})
+		}
+		if len(sources) == 0 {
+			return nil, fmt.Errorf("[ERROR] Snapshot consistency group %s has no snapshots", id)
+		}
+		return sources, nil
+	}
+
+	for _, source := range d.Get(isSnapshotCopySourceSnapshots).(*schema.Set).List() {
+		id := source.(string)
+		if strings.HasPrefix(id, "crn:") {
+			// The source region is not reachable with the client of the
+			// provider region, the copy keeps the name of the CRN's snapshot
+			// only when it is in the provider region
+			sources = append(sources, vpcv1.SnapshotReference{CRN: &id})
+			continue
+		}
+		snapshot, response, err := sess.GetSnapshotWithContext(context, &vpcv1.GetSnapshotOptions{ID: &id})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting snapshot %s: %s\n%s", id, err, response)
+		}
+		sources = append(sources, vpcv1.SnapshotReference{CRN: snapshot.CRN, Name: snapshot.Name})
+	}
+	return sources, nil
+}
+
+// snapshotCopyID returns the ID of a snapshot copy, which is the target
+// region followed by the IDs of the copies.
+func snapshotCopyID(region string, ids []string) string {
+	return fmt.Sprintf("%s/%s", region, strings.Join(ids, ","))
+}
+
+func parseSnapshotCopyID(id string) (string, []string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("[ERROR] Unexpected format of ID (%s), expected region/snapshot1,snapshot2", id)
+	}
+	return parts[0], strings.Split(parts[1], ","), nil
+}
+
+// vpcClientForRegion returns a copy of the VPC client of the provider that
+// targets another region. The region is the first label of the host of the
+// endpoint, so public, private and custom regional endpoints are supported.
+func vpcClientForRegion(meta interface{}, region string) (*vpcv1.VpcV1, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := url.Parse(sess.GetServiceURL())
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the VPC endpoint %s: %s", sess.GetServiceURL(), err)
+	}
+	labels := strings.SplitN(endpoint.Host, ".", 2)
+	if len(labels) != 2 {
+		return nil, fmt.Errorf("[ERROR] The VPC endpoint %s is not a regional endpoint", sess.GetServiceURL())
+	}
+	if labels[0] == region {
+		return sess, nil
+	}
+	endpoint.Host = region + "." + labels[1]
+
+	// clone the client and set endpoint
+	regionSess := *sess
+	regionSess.Service = sess.Service.Clone()
+	if err = regionSess.Service.SetServiceURL(endpoint.String()); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting the VPC endpoint of region %s: %s", region, err)
+	}
+	return &regionSess, nil
+}
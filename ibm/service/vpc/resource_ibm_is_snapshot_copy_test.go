@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMISSnapshotCopy_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	scgname := fmt.Sprintf("tf-snap-cons-grp-name-%d", acctest.RandIntRange(10, 100))
+	snapname := fmt.Sprintf("tf-snap-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotCopyConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname, "us-east"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_copy", "target_region", "us-east"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_copy", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_copy", "snapshots.0.name", "dr-"+snapname),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_copy", "snapshots.0.lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrPair("ibm_is_snapshot_copy.testacc_copy", "snapshots.0.source_snapshot_crn", "ibm_is_snapshot_consistency_group.testacc_scg", "snapshot_reference.0.crn"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_copy.testacc_copy", "snapshots.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotCopyConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname, region string) string {
+	return testAccCheckIBMISSnapshotConsistencyGroupSourceConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname) + fmt.Sprintf(`
+	resource "ibm_is_snapshot_copy" "testacc_copy" {
+		source_consistency_group = ibm_is_snapshot_consistency_group.testacc_scg.id
+		target_region            = "%s"
+		name_prefix              = "dr-"
+	}
+	`, region)
+}
+
+// testAccCheckIBMISSnapshotConsistencyGroupSourceConfig returns an instance
+// and a consistency group with the snapshot of its boot volume.
+func testAccCheckIBMISSnapshotConsistencyGroupSourceConfig(vpcname, subnetname, sshname, publicKey, name, snapname, scgname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+
+	resource "ibm_is_snapshot_consistency_group" "testacc_scg" {
+		delete_snapshots_on_delete = true
+		snapshots {
+			name          = "%s"
+			source_volume = ibm_is_instance.testacc_instance.volume_attachments[0].volume_id
+		}
+		name = "%s"
+	}
+	`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, snapname, scgname)
+}
@@ -248,7 +248,7 @@ func keyCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
 		oldList, newList := d.GetChange(isKeyTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc SSH Key (%s) tags: %s", d.Id(), err)
@@ -257,7 +257,7 @@ func keyCreate(context context.Context, d *schema.ResourceData, meta interface{}
 
 	if _, ok := d.GetOk(isKeyAccessTags); ok {
 		oldList, newList := d.GetChange(isKeyAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of vpc SSH Key (%s) access tags: %s", d.Id(), err)
@@ -407,7 +407,7 @@ func keyUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isKeyTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc SSH Key (%s) tags: %s", id, err)
@@ -424,7 +424,7 @@ func keyUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isKeyAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc SSH Key (%s) access tags: %s", id, err)
@@ -400,7 +400,7 @@ func subnetCreate(context context.Context, d *schema.ResourceData, meta interfac
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -409,7 +409,7 @@ func subnetCreate(context context.Context, d *schema.ResourceData, meta interfac
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
 		oldList, newList := d.GetChange(isSubnetTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource subnet (%s) tags: %s", d.Id(), err)
@@ -418,7 +418,7 @@ func subnetCreate(context context.Context, d *schema.ResourceData, meta interfac
 
 	if _, ok := d.GetOk(isSubnetAccessTags); ok {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource subnet (%s) access tags: %s", d.Id(), err)
@@ -428,7 +428,7 @@ func subnetCreate(context context.Context, d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -440,7 +440,7 @@ func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -627,7 +627,7 @@ func resourceIBMISSubnetUpdate(context context.Context, d *schema.ResourceData,
 
 	if d.HasChange(isSubnetTags) {
 		oldList, newList := d.GetChange(isSubnetTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
@@ -636,7 +636,7 @@ func resourceIBMISSubnetUpdate(context context.Context, d *schema.ResourceData,
 
 	if d.HasChange(isSubnetAccessTags) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource subnet (%s) access tags: %s", d.Id(), err)
@@ -687,7 +687,7 @@ func subnetUpdate(context context.Context, d *schema.ResourceData, meta interfac
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -706,7 +706,7 @@ func subnetUpdate(context context.Context, d *schema.ResourceData, meta interfac
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -802,7 +802,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetAvailable failed: %s", err.Error()), "ibm_is_subnet", "delete")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -816,7 +816,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		if response != nil && response.StatusCode == 409 {
 			log.Printf("[DEBUG] Delete subnet response status code: 409 conflict, provider will try again. %s", err)
-			_, err = isWaitForSubnetDeleteRetry(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForSubnetDeleteRetry(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSubnetWithContext on retry failed: %s", err.Error()), "ibm_is_subnet", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -828,7 +828,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 			return tfErr.GetDiag()
 		}
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetDeleted failed: %s", err.Error()), "ibm_is_subnet", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -838,7 +838,7 @@ func subnetDelete(context context.Context, d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleteRetry(ctx context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("[DEBUG] Retrying subnet (%s) delete", id)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isSubnetInUse},
@@ -863,10 +863,10 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -878,7 +878,7 @@ func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
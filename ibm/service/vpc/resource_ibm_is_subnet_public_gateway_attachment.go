@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -138,7 +139,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentCreate(context context.Context, d
 		return tfErr.GetDiag()
 	}
 	d.SetId(subnet)
-	_, err = isWaitForSubnetPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetPublicGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetPublicGatewayAvailable failed: %s", err.Error()), "ibm_is_subnet_public_gateway_attachment", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -306,7 +307,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForSubnetPublicGatewayDelete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetPublicGatewayDelete(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSubnetPublicGatewayDelete failed: %s", err.Error()), "ibm_is_subnet_public_gateway_attachment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -316,7 +317,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 	return nil
 }
 
-func isWaitForSubnetPublicGatewayAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetPublicGatewayAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) public gateway attachment to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -328,7 +329,7 @@ func isWaitForSubnetPublicGatewayAvailable(subnetC *vpcv1.VpcV1, id string, time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSubnetPublicGatewayRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -353,7 +354,7 @@ func isSubnetPublicGatewayRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.
 	}
 }
 
-func isWaitForSubnetPublicGatewayDelete(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetPublicGatewayDelete(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) public gateway attachment to be detached.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -365,7 +366,7 @@ func isWaitForSubnetPublicGatewayDelete(subnetC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isSubnetPublicGatewayDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -187,7 +188,7 @@ func resourceIBMISReservedIPCreate(context context.Context, d *schema.ResourceDa
 
 	// Set id for the reserved IP as combination of subnet ID and reserved IP ID
 	d.SetId(fmt.Sprintf("%s/%s", subnetID, *rip.ID))
-	_, err = isWaitForReservedIpAvailable(sess, subnetID, *rip.ID, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForReservedIpAvailable(context, sess, subnetID, *rip.ID, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservedIpAvailable failed: %s", err.Error()), "ibm_is_subnet_reserved_ip", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -385,7 +386,7 @@ func resourceIBMISReservedIPUpdate(context context.Context, d *schema.ResourceDa
 			return tfErr.GetDiag()
 		}
 
-		_, err = isWaitForReservedIpAvailable(sess, subnetID, reservedIPID, d.Timeout(schema.TimeoutCreate), d)
+		_, err = isWaitForReservedIpAvailable(context, sess, subnetID, reservedIPID, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForReservedIpAvailable failed: %s", err.Error()), "ibm_is_subnet_reserved_ip", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -494,7 +495,7 @@ func getReservedIpWithoutContext(d *schema.ResourceData, meta interface{}) (*vpc
 	return reservedIP, nil
 }
 
-func isWaitForReservedIpAvailable(sess *vpcv1.VpcV1, subnetid, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForReservedIpAvailable(ctx context.Context, sess *vpcv1.VpcV1, subnetid, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for reseved ip (%s/%s) to be available.", subnetid, id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"pending"},
@@ -504,7 +505,7 @@ func isWaitForReservedIpAvailable(sess *vpcv1.VpcV1, subnetid, id string, timeou
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.ResourceData) resource.StateRefreshFunc {
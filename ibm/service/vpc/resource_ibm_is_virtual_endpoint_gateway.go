@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -387,7 +388,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(context context.Context, d *schem
 
 	d.SetId(*endpointGateway.ID)
 
-	_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVirtualEndpointGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		if d.Get(targetResourceTypeFmt).(string) == "private_path_service_gateway" {
 			isAccessPending := false
@@ -417,7 +418,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(context context.Context, d *schem
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of VPE (%s) tags: %s", d.Id(), err)
@@ -426,7 +427,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(context context.Context, d *schem
 
 	if _, ok := d.GetOk(isVirtualEndpointGatewayAccessTags); ok {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of VPE (%s) access tags: %s", d.Id(), err)
@@ -480,7 +481,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schem
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualEndpointGatewayAvailable failed: %s", err.Error()), "ibm_is_virtual_endpoint_gateway", "update").GetDiag()
 				}
@@ -506,7 +507,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schem
 					return flex.TerraformErrorf(err, fmt.Sprintf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response), "ibm_is_virtual_endpoint_gateway", "update").GetDiag()
 
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualEndpointGatewayAvailable failed: %s", err.Error()), "ibm_is_virtual_endpoint_gateway", "update").GetDiag()
 				}
@@ -523,7 +524,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schem
 		}
 		if d.HasChange(isVirtualEndpointGatewayTags) {
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 			if err != nil {
 				log.Printf(
 					"Error on update of VPE (%s) tags: %s", d.Id(), err)
@@ -533,7 +534,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(context context.Context, d *schem
 
 		if d.HasChange(isVirtualEndpointGatewayAccessTags) {
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
 			if err != nil {
 				log.Printf(
 					"Error on update of VPE (%s) access tags: %s", d.Id(), err)
@@ -612,7 +613,7 @@ func flattenDataSourceSecurityGroups(securityGroupList []vpcv1.SecurityGroupRefe
 	return securitygroupList
 }
 
-func isWaitForVirtualEndpointGatewayAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualEndpointGatewayAvailable(ctx context.Context, sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual endpoint gateway (%s) to be available.", endPointGatewayId)
 
 	stateConf := &resource.StateChangeConf{
@@ -624,10 +625,10 @@ func isWaitForVirtualEndpointGatewayAvailable(sess *vpcv1.VpcV1, endPointGateway
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
-func isWaitForVirtualEndpointGatewayForPPSGAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualEndpointGatewayForPPSGAvailable(ctx context.Context, sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual endpoint gateway (%s) to be available.", endPointGatewayId)
 	// When the target is PPSG, pending is a valid state when the endpoint gateway binding is not permitted within the terraform configuration.
 	stateConf := &resource.StateChangeConf{
@@ -640,7 +641,7 @@ func isWaitForVirtualEndpointGatewayForPPSGAvailable(sess *vpcv1.VpcV1, endPoint
 		ContinuousTargetOccurence: 6,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId string) resource.StateRefreshFunc {
@@ -674,7 +675,7 @@ func resourceIBMisVirtualEndpointGatewayDelete(context context.Context, d *schem
 		log.Printf("Delete Endpoint Gateway failed: %v", response)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_virtual_endpoint_gateway", "delete", "sep-id-parts").GetDiag()
 	}
-	_, err = isWaitForEGWDelete(sess, d, d.Id())
+	_, err = isWaitForEGWDelete(context, sess, d, d.Id())
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteEndpointGateway failed: %s", err.Error()), "ibm_is_virtual_endpoint_gateway", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -683,7 +684,7 @@ func resourceIBMisVirtualEndpointGatewayDelete(context context.Context, d *schem
 	return nil
 }
 
-func isWaitForEGWDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForEGWDelete(ctx context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"deleting", "stable"},
@@ -709,7 +710,7 @@ func isWaitForEGWDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id strin
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -485,7 +485,7 @@ func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *sche
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
 		oldList, newList := d.GetChange("tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *virtualNetworkInterface.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vni (%s) tags: %s", d.Id(), err)
@@ -493,7 +493,7 @@ func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *sche
 	}
 	if _, ok := d.GetOk("access_tags"); ok {
 		oldList, newList := d.GetChange("access_tags")
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *virtualNetworkInterface.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vni (%s) access tags: %s", d.Id(), err)
@@ -698,7 +698,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 
 	if d.HasChange("tags") {
 		oldList, newList := d.GetChange("tags")
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vni (%s) tags: %s", d.Id(), err)
@@ -707,7 +707,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 
 	if d.HasChange("access_tags") {
 		oldList, newList := d.GetChange("access_tags")
-		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
+		err := flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vni (%s) access tags: %s", d.Id(), err)
@@ -934,7 +934,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, vniId, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_virtual_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -955,7 +955,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, vniId, d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualNetworkInterfaceAvailable(context, sess, vniId, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceAvailable failed: %s", err.Error()), "ibm_is_virtual_network_interface", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -996,7 +996,7 @@ func resourceIBMIsVirtualNetworkInterfaceDelete(context context.Context, d *sche
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVirtualNetworkInterfaceDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete), vni)
+	_, err = isWaitForVirtualNetworkInterfaceDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete), vni)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVirtualNetworkInterfaceDeleted failed: %s", err.Error()), "ibm_is_virtual_network_interface", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1151,7 +1151,7 @@ func resourceIBMIsVirtualNetworkInterfaceVirtualNetworkInterfaceTargetShareMount
 	return modelMap, nil
 }
 
-func isWaitForVirtualNetworkInterfaceAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVirtualNetworkInterfaceAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VirtualNetworkInterface (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1163,7 +1163,7 @@ func isWaitForVirtualNetworkInterfaceAvailable(client *vpcv1.VpcV1, id string, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVirtualNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1181,7 +1181,7 @@ func isVirtualNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, id string) resour
 		return vni, *vni.LifecycleState, nil
 	}
 }
-func isWaitForVirtualNetworkInterfaceDeleted(client *vpcv1.VpcV1, id string, timeout time.Duration, vni *vpcv1.VirtualNetworkInterface) (interface{}, error) {
+func isWaitForVirtualNetworkInterfaceDeleted(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, vni *vpcv1.VirtualNetworkInterface) (interface{}, error) {
 	log.Printf("Waiting for VirtualNetworkInterface (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1193,7 +1193,7 @@ func isWaitForVirtualNetworkInterfaceDeleted(client *vpcv1.VpcV1, id string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVirtualNetworkInterfaceDeleteRefreshFunc(client *vpcv1.VpcV1, vnir *vpcv1.VirtualNetworkInterface, id string) resource.StateRefreshFunc {
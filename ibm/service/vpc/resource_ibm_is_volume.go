@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -87,6 +88,7 @@ func ResourceIBMISVolume() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			resourceIBMISVolumeQuotaPreflight,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -464,7 +466,7 @@ func resourceIBMISVolumeCreate(context context.Context, d *schema.ResourceData,
 		return err
 	}
 
-	return resourceIBMISVolumeRead(context, d, meta)
+	return append(isQuotaPreflightWarnings(meta, "ibm_is_volume"), resourceIBMISVolumeRead(context, d, meta)...)
 }
 
 func volCreate(context context.Context, d *schema.ResourceData, meta interface{}, volName, profile, zone string) diag.Diagnostics {
@@ -571,7 +573,7 @@ func volCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -580,7 +582,7 @@ func volCreate(context context.Context, d *schema.ResourceData, meta interface{}
 
 	if _, ok := d.GetOk(isVolumeAccessTags); ok {
 		oldList, newList := d.GetChange(isVolumeAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc volume (%s) access tags: %s", d.Id(), err)
@@ -873,7 +875,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 		}
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc volume (%s) access tags: %s", id, err)
@@ -923,7 +925,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -949,7 +951,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -978,7 +980,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1026,7 +1028,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(context, sess, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1062,7 +1064,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 		}
 		eTag = response.Headers.Get("ETag")
 		options.IfMatch = &eTag
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1116,7 +1118,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForInstanceAvailable(context, sess, *insId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1142,7 +1144,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
-		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1183,7 +1185,7 @@ func volUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 					return tfErr.GetDiag()
 				}
-				_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+				_, err = isWaitForVolumeAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 				if err != nil {
 					tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed: %s", err.Error()), "ibm_is_volume", "update")
 					log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1239,7 +1241,7 @@ func volDelete(context context.Context, d *schema.ResourceData, meta interface{}
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 				return tfErr.GetDiag()
 			}
-			_, err = isWaitForInstanceVolumeDetached(sess, d, d.Id(), *volAtt.ID)
+			_, err = isWaitForInstanceVolumeDetached(context, sess, d, d.Id(), *volAtt.ID)
 			if err != nil {
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed: %s", err.Error()), "ibm_is_volume", "delete")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1258,7 +1260,7 @@ func volDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed: %s", err.Error()), "ibm_is_volume", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1268,7 +1270,7 @@ func volDelete(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(ctx context.Context, vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1280,7 +1282,7 @@ func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration)
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1325,7 +1327,7 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
-func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1337,7 +1339,7 @@ func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Durat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -744,7 +744,7 @@ func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
-	_, err = isWaitForVPCAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVPCAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPCAvailable failed: %s", err.Error()), "ibm_is_vpc", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -821,7 +821,7 @@ func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
 		oldList, newList := d.GetChange(isVPCTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
@@ -829,7 +829,7 @@ func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	}
 	if _, ok := d.GetOk(isVPCAccessTags); ok {
 		oldList, newList := d.GetChange(isVPCAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
@@ -838,7 +838,7 @@ func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -850,7 +850,7 @@ func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
@@ -1400,7 +1400,7 @@ func vpcUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isVPCTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc (%s) tags: %s", d.Id(), err)
@@ -1417,7 +1417,7 @@ func vpcUpdate(context context.Context, d *schema.ResourceData, meta interface{}
 			return tfErr.GetDiag()
 		}
 		oldList, newList := d.GetChange(isVPCAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource VPC (%s) access tags: %s", d.Id(), err)
@@ -1741,7 +1741,7 @@ func vpcDelete(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPCDeleted failed: %s", err.Error()), "ibm_is_vpc", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1751,7 +1751,7 @@ func vpcDelete(context context.Context, d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -1763,7 +1763,7 @@ func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
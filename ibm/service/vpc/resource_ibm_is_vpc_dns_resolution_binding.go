@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
@@ -306,7 +307,7 @@ func resourceIBMIsVPCDnsResolutionBindingCreate(context context.Context, d *sche
 		return tfErr.GetDiag()
 	}
 	d.SetId(MakeTerraformVPCDNSID(spokeVPCID, *vpcdnsResolutionBinding.ID))
-	intf, err := isWaitForVpcDnsCreated(sess, spokeVPCID, *vpcdnsResolutionBinding.ID, d.Timeout(schema.TimeoutCreate))
+	intf, err := isWaitForVpcDnsCreated(context, sess, spokeVPCID, *vpcdnsResolutionBinding.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -490,7 +491,7 @@ func resourceIBMIsVPCDnsResolutionBindingDelete(context context.Context, d *sche
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVpcDnsDeleted(sess, vpcId, id, d.Timeout(schema.TimeoutDelete), dns)
+	_, err = isWaitForVpcDnsDeleted(context, sess, vpcId, id, d.Timeout(schema.TimeoutDelete), dns)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVpcDnsDeleted failed: %s", err.Error()), "ibm_is_vpc_dns_resolution_binding", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -516,7 +517,7 @@ func ParseVPCDNSTerraformID(s string) (string, string, error) {
 	return segments[0], segments[1], nil
 }
 
-func isWaitForVpcDnsDeleted(sess *vpcv1.VpcV1, vpcid, id string, timeout time.Duration, dns *vpcv1.VpcdnsResolutionBinding) (interface{}, error) {
+func isWaitForVpcDnsDeleted(ctx context.Context, sess *vpcv1.VpcV1, vpcid, id string, timeout time.Duration, dns *vpcv1.VpcdnsResolutionBinding) (interface{}, error) {
 	log.Printf("Waiting for vpc dns (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -528,7 +529,7 @@ func isWaitForVpcDnsDeleted(sess *vpcv1.VpcV1, vpcid, id string, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVpcDnsDeleteRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string, dns *vpcv1.VpcdnsResolutionBinding) resource.StateRefreshFunc {
@@ -552,7 +553,7 @@ func isVpcDnsDeleteRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string, dns *vpcv1.V
 	}
 }
 
-func isWaitForVpcDnsCreated(sess *vpcv1.VpcV1, vpcid, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpcDnsCreated(ctx context.Context, sess *vpcv1.VpcV1, vpcid, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpc dns (%s) to be created.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -564,7 +565,7 @@ func isWaitForVpcDnsCreated(sess *vpcv1.VpcV1, vpcid, id string, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVpcDnsCreateRefreshFunc(sess *vpcv1.VpcV1, vpcid, id string) resource.StateRefreshFunc {
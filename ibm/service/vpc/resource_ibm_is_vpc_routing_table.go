@@ -338,7 +338,7 @@ func resourceIBMISVPCRoutingTableCreate(context context.Context, d *schema.Resou
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(rtTags); ok || v != "" {
 		oldList, newList := d.GetChange(rtTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routeTable.CRN, "", rtUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *routeTable.CRN, "", rtUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource routing table (%s) tags: %s", d.Id(), err)
@@ -347,7 +347,7 @@ func resourceIBMISVPCRoutingTableCreate(context context.Context, d *schema.Resou
 
 	if _, ok := d.GetOk(rtAccessTags); ok {
 		oldList, newList := d.GetChange(rtAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routeTable.CRN, "", rtAccessTags)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *routeTable.CRN, "", rtAccessTags)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource routing table (%s) access tags: %s", d.Id(), err)
@@ -534,7 +534,7 @@ func resourceIBMISVPCRoutingTableUpdate(context context.Context, d *schema.Resou
 
 	if d.HasChange(rtAccessTags) {
 		oldList, newList := d.GetChange(rtAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routingTableGet.CRN, "", rtAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *routingTableGet.CRN, "", rtAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource routing table (%s) access tags: %s", d.Id(), err)
@@ -543,7 +543,7 @@ func resourceIBMISVPCRoutingTableUpdate(context context.Context, d *schema.Resou
 
 	if d.HasChange(rtTags) {
 		oldList, newList := d.GetChange(rtTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routingTableGet.CRN, "", rtUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *routingTableGet.CRN, "", rtUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource routing table (%s) tags: %s", d.Id(), err)
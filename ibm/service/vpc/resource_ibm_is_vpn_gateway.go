@@ -10,6 +10,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -429,7 +430,7 @@ func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface
 	d.SetId(*vpnGateway.ID)
 	log.Printf("[INFO] VPNGateway : %s", *vpnGateway.ID)
 
-	_, err = isWaitForVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVpnGatewayAvailable(context, sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVpnGatewayAvailable failed: %s", err.Error()), "ibm_is_vpn_gateway", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -439,7 +440,7 @@ func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
 		oldList, newList := d.GetChange(isVPNGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
@@ -448,7 +449,7 @@ func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface
 
 	if _, ok := d.GetOk(isVPNGatewayAccessTags); ok {
 		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource VPN Gateway (%s) access tags: %s", d.Id(), err)
@@ -458,7 +459,7 @@ func vpngwCreate(context context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
-func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayAvailable(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -470,7 +471,7 @@ func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -707,7 +708,7 @@ func vpngwUpdate(context context.Context, d *schema.ResourceData, meta interface
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
 		oldList, newList := d.GetChange(isVPNGatewayTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpc Vpn Gateway (%s) tags: %s", id, err)
@@ -726,7 +727,7 @@ func vpngwUpdate(context context.Context, d *schema.ResourceData, meta interface
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
 		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource VPC VPN Gateway  (%s) access tags: %s", d.Id(), err)
@@ -797,7 +798,7 @@ func vpngwDelete(context context.Context, d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
-	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVpnGatewayDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVpnGatewayDeleted failed: %s", err.Error()), "ibm_is_vpn_gateway", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -807,7 +808,7 @@ func vpngwDelete(context context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
-func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayDeleted(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -819,7 +820,7 @@ func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1646,3 +1646,91 @@ func testAccCheckIBMISVPNGatewayConnectionCIDRConfig(vpc, subnet1, subnet2, vpnn
 		}
 		`, name)
 }
+
+func TestAccIBMISVPNGatewayConnection_waitForTunnelUp(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname1 := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname1 := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(100, 200))
+	vpnname1 := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(100, 200))
+	name1 := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(100, 200))
+
+	vpcname2 := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname2 := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(100, 200))
+	vpnname2 := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(100, 200))
+	name2 := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionWaitForTunnelUpConfig(vpcname1, subnetname1, vpnname1, name1, vpcname2, subnetname2, vpnname2, name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "status", "up"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "tunnels.0.status", "up"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "tunnels.1.status", "up"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection2", "status", "up"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMISVPNGatewayConnectionWaitForTunnelUpConfig returns two
+// route mode VPN gateways whose connections peer with each other, so that
+// their tunnels come up.
+func testAccCheckIBMISVPNGatewayConnectionWaitForTunnelUpConfig(vpc1, subnet1, vpnname1, name1, vpc2, subnet2, vpnname2, name2 string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc1" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet1" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc1.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway1" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet1.id
+		mode = "route"
+	}
+	resource "ibm_is_vpc" "testacc_vpc2" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet2" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc2.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway2" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet2.id
+		mode = "route"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection1" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway1.id
+		peer_address = ibm_is_vpn_gateway.testacc_VPNGateway2.public_ip_address
+		preshared_key = "VPNDemoPassword"
+		admin_state_up = true
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection2" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway2.id
+		peer_address = ibm_is_vpn_gateway.testacc_VPNGateway1.public_ip_address
+		preshared_key = "VPNDemoPassword"
+		admin_state_up = true
+		wait_for_tunnel_up {
+			timeout = 900
+		}
+	}
+	`, vpc1, subnet1, acc.ISZoneName, acc.ISCIDR, vpnname1, vpc2, subnet2, acc.ISZoneName, acc.ISCIDR, vpnname2, name1, name2)
+}
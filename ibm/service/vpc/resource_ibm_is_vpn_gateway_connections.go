@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -44,6 +47,10 @@ const (
 	isVPNGatewayConnectionResourcetype              = "resource_type"
 	isVPNGatewayConnectionCreatedat                 = "created_at"
 	isVPNGatewayConnectionStatusreasons             = "status_reasons"
+	isVPNGatewayConnectionWaitForTunnelUp           = "wait_for_tunnel_up"
+	isVPNGatewayConnectionWaitForTunnelUpTimeout    = "timeout"
+	isVPNGatewayConnectionStatusUp                  = "up"
+	isVPNGatewayConnectionStatusDown                = "down"
 )
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
@@ -342,6 +349,24 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 				Description: "The mode of the VPN gateway",
 			},
 
+			isVPNGatewayConnectionWaitForTunnelUp: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Waits for the tunnels of the VPN gateway connection to be up after the connection is created or updated",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPNGatewayConnectionWaitForTunnelUpTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntBetween(1, 3600),
+							Description:  "The time in seconds to wait for the tunnels to be up",
+						},
+					},
+				},
+			},
+
 			isVPNGatewayConnectionTunnels: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -449,6 +474,11 @@ func resourceIBMISVPNGatewayConnectionCreate(context context.Context, d *schema.
 	if err != nil {
 		return err
 	}
+	if d.Id() != "" {
+		if err := waitForVPNGatewayConnectionTunnelUp(context, d, meta, "create"); err != nil {
+			return err
+		}
+	}
 	return resourceIBMISVPNGatewayConnectionRead(context, d, meta)
 }
 
@@ -745,6 +775,9 @@ func resourceIBMISVPNGatewayConnectionUpdate(context context.Context, d *schema.
 	if diagErr != nil {
 		return diagErr
 	}
+	if diagErr = waitForVPNGatewayConnectionTunnelUp(context, d, meta, "update"); diagErr != nil {
+		return diagErr
+	}
 	return resourceIBMISVPNGatewayConnectionRead(context, d, meta)
 }
 
@@ -1039,7 +1072,7 @@ func vpngwconDelete(context context.Context, d *schema.ResourceData, meta interf
 		return tfErr.GetDiag()
 	}
 
-	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayConnectionDeleted(context, sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVPNGatewayConnectionDeleted failed: %s", err.Error()), "ibm_is_vpn_gateway_connection", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1050,7 +1083,7 @@ func vpngwconDelete(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayConnectionDeleted(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
 	stateConf := &resource.StateChangeConf{
@@ -1062,7 +1095,7 @@ func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(ctx, stateConf)
 }
 
 func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -1134,6 +1167,153 @@ func resourceVPNGatewayConnectionFlattenLifecycleReasons(statusReasons []vpcv1.V
 	return statusReasonsList
 }
 
+// vpnGatewayConnectionTunnelStatus is the status of a VPN gateway connection
+// and of its tunnels. Policy mode connections have no tunnels.
+type vpnGatewayConnectionTunnelStatus struct {
+	status        string
+	statusReasons []vpcv1.VPNGatewayConnectionStatusReason
+	tunnels       []vpcv1.VPNGatewayConnectionStaticRouteModeTunnel
+}
+
+// up reports whether traffic can flow through the connection, which is when
+// all its tunnels are up, or when the connection is up for policy mode
+// connections.
+func (s *vpnGatewayConnectionTunnelStatus) up() bool {
+	if len(s.tunnels) == 0 {
+		return s.status == isVPNGatewayConnectionStatusUp
+	}
+	for _, tunnel := range s.tunnels {
+		if tunnel.Status == nil || *tunnel.Status != isVPNGatewayConnectionStatusUp {
+			return false
+		}
+	}
+	return true
+}
+
+// reasons describes why the connection or its tunnels are down with the
+// peer and IKE status reasons reported by the gateway members.
+func (s *vpnGatewayConnectionTunnelStatus) reasons() string {
+	reasons := []string{}
+	for _, reason := range s.statusReasons {
+		reasons = append(reasons, fmt.Sprintf("connection is %s: %s: %s", s.status, flex.StringValue(reason.Code), flex.StringValue(reason.Message)))
+	}
+	for _, tunnel := range s.tunnels {
+		if tunnel.Status != nil && *tunnel.Status == isVPNGatewayConnectionStatusUp {
+			continue
+		}
+		address := ""
+		if tunnel.PublicIP != nil {
+			address = flex.StringValue(tunnel.PublicIP.Address)
+		}
+		if len(tunnel.StatusReasons) == 0 {
+			reasons = append(reasons, fmt.Sprintf("tunnel %s is %s", address, flex.StringValue(tunnel.Status)))
+		}
+		for _, reason := range tunnel.StatusReasons {
+			reasons = append(reasons, fmt.Sprintf("tunnel %s is %s: %s: %s", address, flex.StringValue(tunnel.Status), flex.StringValue(reason.Code), flex.StringValue(reason.Message)))
+		}
+	}
+	if len(reasons) == 0 {
+		return fmt.Sprintf("connection is %s", s.status)
+	}
+	return strings.Join(reasons, "\n")
+}
+
+func getVPNGatewayConnectionTunnelStatus(context context.Context, sess *vpcv1.VpcV1, gID, gConnID string) (*vpnGatewayConnectionTunnelStatus, error) {
+	options := &vpcv1.GetVPNGatewayConnectionOptions{
+		VPNGatewayID: &gID,
+		ID:           &gConnID,
+	}
+	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnectionWithContext(context, options)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting VPN gateway connection %s: %s\n%s", gConnID, err, response)
+	}
+	status := &vpnGatewayConnectionTunnelStatus{}
+	switch vpnGatewayConnection := vpnGatewayConnectionIntf.(type) {
+	case *vpcv1.VPNGatewayConnection:
+		status.status = flex.StringValue(vpnGatewayConnection.Status)
+		status.statusReasons = vpnGatewayConnection.StatusReasons
+		status.tunnels = vpnGatewayConnection.Tunnels
+	case *vpcv1.VPNGatewayConnectionRouteMode:
+		status.status = flex.StringValue(vpnGatewayConnection.Status)
+		status.statusReasons = vpnGatewayConnection.StatusReasons
+		status.tunnels = vpnGatewayConnection.Tunnels
+	case *vpcv1.VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode:
+		status.status = flex.StringValue(vpnGatewayConnection.Status)
+		status.statusReasons = vpnGatewayConnection.StatusReasons
+		status.tunnels = vpnGatewayConnection.Tunnels
+	case *vpcv1.VPNGatewayConnectionPolicyMode:
+		status.status = flex.StringValue(vpnGatewayConnection.Status)
+		status.statusReasons = vpnGatewayConnection.StatusReasons
+	default:
+		return nil, fmt.Errorf("[ERROR] Unrecognized vpcv1.VPNGatewayConnectionIntf subtype encountered")
+	}
+	return status, nil
+}
+
+// waitForVPNGatewayConnectionTunnelUp blocks until the tunnels of the
+// connection are up when wait_for_tunnel_up is set. When they are not in
+// time, the operation fails with the status reasons of the tunnels.
+func waitForVPNGatewayConnectionTunnelUp(context context.Context, d *schema.ResourceData, meta interface{}, operation string) diag.Diagnostics {
+	waitForTunnelUp := d.Get(isVPNGatewayConnectionWaitForTunnelUp).([]interface{})
+	if len(waitForTunnelUp) == 0 || waitForTunnelUp[0] == nil {
+		return nil
+	}
+	wait := waitForTunnelUp[0].(map[string]interface{})
+	if !d.Get(isVPNGatewayConnectionAdminStateup).(bool) {
+		err := fmt.Errorf("the tunnels of a VPN gateway connection do not come up when admin_state_up is false")
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway_connection", operation, "wait-for-tunnel-up").GetDiag()
+	}
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway_connection", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpn_gateway_connection", operation, "sep-id-parts").GetDiag()
+	}
+	timeout := time.Duration(wait[isVPNGatewayConnectionWaitForTunnelUpTimeout].(int)) * time.Second
+	_, err = isWaitForVPNGatewayConnectionTunnelUp(context, sess, parts[0], parts[1], timeout)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("wait_for_tunnel_up failed: %s", err.Error()), "ibm_is_vpn_gateway_connection", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func isWaitForVPNGatewayConnectionTunnelUp(context context.Context, sess *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the tunnels of VPN gateway connection (%s) to be up.", gConnID)
+
+	var last *vpnGatewayConnectionTunnelStatus
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNGatewayConnectionStatusDown},
+		Target:  []string{isVPNGatewayConnectionStatusUp},
+		Refresh: func() (interface{}, string, error) {
+			status, err := getVPNGatewayConnectionTunnelStatus(context, sess, gID, gConnID)
+			if err != nil {
+				return nil, "", err
+			}
+			last = status
+			if status.up() {
+				return status, isVPNGatewayConnectionStatusUp, nil
+			}
+			return status, isVPNGatewayConnectionStatusDown, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	result, err := conns.WaitForStateContext(context, stateConf)
+	if err != nil && last != nil {
+		return result, fmt.Errorf("[ERROR] The tunnels of VPN gateway connection %s are not up: %s\n%s", gConnID, err, last.reasons())
+	}
+	return result, err
+}
+
 // helper functions
 
 func resourceIBMIsVPNGatewayConnectionMapToVPNGatewayConnectionPolicyModeLocalPrototype(modelMap map[string]interface{}) (*vpcv1.VPNGatewayConnectionPolicyModeLocalPrototype, error) {
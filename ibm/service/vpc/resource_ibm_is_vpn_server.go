@@ -570,7 +570,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 
 	if _, ok := d.GetOk(isVPNServerAccessTags); ok {
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
@@ -608,7 +608,7 @@ func isWaitForVPNServerStable(context context.Context, sess *vpcv1.VpcV1, d *sch
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -949,7 +949,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 
 	if d.HasChange(isVPNServerAccessTags) {
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
+		err = flex.UpdateGlobalTagsUsingCRN(context, oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
 			log.Printf(
 				"Error on update of resource vpn server (%s) access tags: %s", d.Id(), err)
@@ -1056,7 +1056,7 @@ func isWaitForVPNServerDeleted(context context.Context, sess *vpcv1.VpcV1, d *sc
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return conns.WaitForStateContext(context, stateConf)
 }
 
 func resourceVPNServerFlattenLifecycleReasons(lifecycleReasons []vpcv1.VPNServerLifecycleReason) (lifecycleReasonsList []map[string]interface{}) {
@@ -1,16 +1,26 @@
 package main
 
 import (
+	"context"
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 func main() {
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
-	})
+
+	serverFactory, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf5server.Serve("registry.terraform.io/IBM-Cloud/ibm", serverFactory)
+	conns.ShutdownTracing()
+	if err != nil {
+		log.Fatal(err)
+	}
 }